@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 	"time"
@@ -13,7 +14,8 @@ import (
 	"github.com/attendance_report_app/backend/internal/infrastructure/database"
 	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/repository"
 	"github.com/attendance_report_app/backend/internal/infrastructure/jwt"
-	"github.com/attendance_report_app/backend/internal/infrastructure/slack"
+	"github.com/attendance_report_app/backend/internal/infrastructure/notifier"
+	"github.com/attendance_report_app/backend/internal/infrastructure/webhook"
 	"github.com/attendance_report_app/backend/internal/interface/handler"
 	"github.com/attendance_report_app/backend/internal/interface/middleware"
 	"github.com/attendance_report_app/backend/internal/interface/router"
@@ -34,24 +36,102 @@ func main() {
 
 	userRepo := repository.NewUserRepository(db)
 	attendanceRepo := repository.NewAttendanceRepository(db)
+	payrollRunRepo := repository.NewPayrollRunRepository(db)
+	holidayRepo := repository.NewHolidayRepository(db)
+	allowanceRepo := repository.NewAllowanceRepository(db)
+	bonusRepo := repository.NewBonusRepository(db)
+	goalRepo := repository.NewGoalRepository(db)
+	monthlySummaryRepo := repository.NewMonthlySummaryRepository(db)
+	dailyReportRepo := repository.NewDailyReportRepository(db)
+	reportTemplateRepo := repository.NewReportTemplateRepository(db)
+	reportCommentRepo := repository.NewReportCommentRepository(db)
+	reportReactionRepo := repository.NewReportReactionRepository(db)
+	reportReadRepo := repository.NewReportReadRepository(db)
+	reportTagRepo := repository.NewReportTagRepository(db)
+	jobRunRepo := repository.NewJobRunRepository(db)
+	jobLockRepo := repository.NewJobLockRepository(db)
+	outboxRepo := repository.NewOutboxRepository(db)
+	notificationChannelRepo := repository.NewNotificationChannelRepository(db)
+	notificationRouteRepo := repository.NewNotificationRouteRepository(db)
+	notificationPreferenceRepo := repository.NewNotificationPreferenceRepository(db)
+	sentReminderRepo := repository.NewSentReminderRepository(db)
+	slackAccountRepo := repository.NewSlackAccountRepository(db)
+	clockInRepo := repository.NewClockInRepository(db)
+	webhookSubscriptionRepo := repository.NewWebhookSubscriptionRepository(db)
+	webhookDeliveryRepo := repository.NewWebhookDeliveryRepository(db)
 
 	tokenService := jwt.NewTokenService(
 		os.Getenv("JWT_SECRET"),
 		7*24*time.Hour, // 7 days for development
 	)
 
-	slackService := slack.NewSlackService(os.Getenv("SLACK_WEBHOOK_URL"))
-
-	userUseCase := usecase.NewUserUseCase(userRepo, tokenService)
-	attendanceUseCase := usecase.NewAttendanceUseCase(attendanceRepo, userRepo, slackService)
-	dailyReportUseCase := usecase.NewDailyReportUseCase(attendanceRepo, userRepo)
-	adminUseCase := usecase.NewAdminUseCase(userRepo, attendanceRepo)
+	// Notifications are queued in the transaction of the change they report and delivered
+	// by the outbox dispatcher; every instance runs one. Events no admin has routed to a
+	// channel go to SLACK_WEBHOOK_URL. Slack channels given by ID and Slack DMs are posted
+	// with SLACK_BOT_TOKEN.
+	outboxService := usecase.NewOutboxService(outboxRepo)
+	notifiers := notifier.NewNotifiers(notifier.NewSMTPConfigFromEnv(), notifier.NewSlackConfigFromEnv())
+	notificationService := usecase.NewNotificationService(notificationChannelRepo, notificationRouteRepo, notificationPreferenceRepo, userRepo, slackAccountRepo, outboxService, notifiers, os.Getenv("SLACK_WEBHOOK_URL"))
+	// Webhook deliveries go through the outbox as well, so that failed ones are retried
+	webhookService := usecase.NewWebhookService(webhookSubscriptionRepo, webhookDeliveryRepo, outboxService, webhook.NewSender())
+	outboxService.Start(context.Background(), 10*time.Second)
+
+	payrollCalculator := usecase.NewPayrollCalculator(userRepo, attendanceRepo, holidayRepo, allowanceRepo, bonusRepo)
+	monthlySummaryService := usecase.NewMonthlySummaryService(monthlySummaryRepo, userRepo, payrollCalculator)
+	reportSearchService := usecase.NewReportSearchService(dailyReportRepo, os.Getenv("SEARCH_INDEX_PATH"))
+	if err := reportSearchService.Load(context.Background()); err != nil {
+		log.Fatal("Failed to load search index:", err)
+	}
+	dailyReportService := usecase.NewDailyReportService(dailyReportRepo, attendanceRepo, reportTagRepo, userRepo, reportSearchService, notificationService)
+
+	userUseCase := usecase.NewUserUseCase(userRepo, goalRepo, tokenService, webhookService, monthlySummaryService)
+	attendanceUseCase := usecase.NewAttendanceUseCase(attendanceRepo, userRepo, payrollRunRepo, dailyReportRepo, monthlySummaryService, dailyReportService, notificationService, webhookService)
+	dailyReportUseCase := usecase.NewDailyReportUseCase(dailyReportRepo, reportTemplateRepo, reportReadRepo, reportTagRepo, userRepo, dailyReportService, reportSearchService)
+	reportTemplateUseCase := usecase.NewReportTemplateUseCase(reportTemplateRepo)
+	reportCommentUseCase := usecase.NewReportCommentUseCase(dailyReportRepo, reportCommentRepo, reportReactionRepo, userRepo, notificationService)
+	reportDigestUseCase := usecase.NewReportDigestUseCase(dailyReportRepo, attendanceRepo, userRepo, notificationService)
+	reminderUseCase := usecase.NewReminderUseCase(userRepo, attendanceRepo, dailyReportRepo, holidayRepo, sentReminderRepo, notificationService, os.Getenv("REMINDER_CUTOFF"))
+
+	// Jobs can always be triggered by admins; SCHEDULER_ENABLED=true also runs them on their schedules
+	jobScheduler := usecase.NewJobScheduler(jobRunRepo, jobLockRepo)
+	if err := usecase.RegisterJobs(jobScheduler, os.Getenv, reminderUseCase, os.Getenv("REMINDER_CUTOFF"), reportDigestUseCase); err != nil {
+		log.Fatal("Failed to register jobs:", err)
+	}
+	schedulerEnabled := os.Getenv("SCHEDULER_ENABLED") == "true"
+	if schedulerEnabled {
+		jobScheduler.Start(context.Background())
+	}
+	jobUseCase := usecase.NewJobUseCase(jobScheduler, jobRunRepo, schedulerEnabled)
+	outboxUseCase := usecase.NewOutboxUseCase(outboxRepo, outboxService)
+	notificationUseCase := usecase.NewNotificationUseCase(notificationChannelRepo, notificationRouteRepo, notificationPreferenceRepo, userRepo, notificationService, os.Getenv("SLACK_WEBHOOK_URL") != "")
+	slackCommandUseCase := usecase.NewSlackCommandUseCase(slackAccountRepo, clockInRepo, userRepo, attendanceUseCase)
+	webhookUseCase := usecase.NewWebhookUseCase(webhookSubscriptionRepo, webhookDeliveryRepo, webhookService)
+	adminUseCase := usecase.NewAdminUseCase(userRepo, attendanceRepo, payrollCalculator, monthlySummaryService)
+	payrollRunUseCase := usecase.NewPayrollRunUseCase(payrollRunRepo, payrollCalculator)
+	calendarUseCase := usecase.NewCalendarUseCase(holidayRepo, monthlySummaryService)
+	compensationUseCase := usecase.NewCompensationUseCase(allowanceRepo, bonusRepo, userRepo, monthlySummaryService)
+	earningsUseCase := usecase.NewEarningsUseCase(userRepo, payrollRunRepo, payrollCalculator, monthlySummaryService)
+	goalUseCase := usecase.NewGoalUseCase(goalRepo, userRepo, attendanceRepo, holidayRepo, payrollRunRepo, payrollCalculator)
 
 	authHandler := handler.NewAuthHandler(userUseCase)
 	userHandler := handler.NewUserHandler(userUseCase, txManager)
 	attendanceHandler := handler.NewAttendanceHandler(attendanceUseCase, txManager)
-	dailyReportHandler := handler.NewDailyReportHandler(dailyReportUseCase)
+	dailyReportHandler := handler.NewDailyReportHandler(dailyReportUseCase, txManager)
 	adminHandler := handler.NewAdminHandler(adminUseCase, attendanceUseCase)
+	payrollHandler := handler.NewPayrollHandler(payrollRunUseCase, txManager)
+	calendarHandler := handler.NewCalendarHandler(calendarUseCase, txManager)
+	compensationHandler := handler.NewCompensationHandler(compensationUseCase, txManager)
+	earningsHandler := handler.NewEarningsHandler(earningsUseCase)
+	goalHandler := handler.NewGoalHandler(goalUseCase, txManager)
+	reportTemplateHandler := handler.NewReportTemplateHandler(reportTemplateUseCase, txManager)
+	reportCommentHandler := handler.NewReportCommentHandler(reportCommentUseCase, txManager)
+	reportDigestHandler := handler.NewReportDigestHandler(reportDigestUseCase)
+	reminderHandler := handler.NewReminderHandler(reminderUseCase)
+	jobHandler := handler.NewJobHandler(jobUseCase)
+	outboxHandler := handler.NewOutboxHandler(outboxUseCase, txManager)
+	notificationHandler := handler.NewNotificationHandler(notificationUseCase, txManager)
+	slackHandler := handler.NewSlackHandler(slackCommandUseCase, txManager, os.Getenv("SLACK_SIGNING_SECRET"))
+	webhookHandler := handler.NewWebhookHandler(webhookUseCase, txManager)
 
 	authMiddleware := middleware.NewAuthMiddleware(os.Getenv("JWT_SECRET"))
 
@@ -61,6 +141,20 @@ func main() {
 		attendanceHandler,
 		dailyReportHandler,
 		adminHandler,
+		payrollHandler,
+		calendarHandler,
+		compensationHandler,
+		earningsHandler,
+		goalHandler,
+		reportTemplateHandler,
+		reportCommentHandler,
+		reportDigestHandler,
+		reminderHandler,
+		jobHandler,
+		outboxHandler,
+		notificationHandler,
+		slackHandler,
+		webhookHandler,
 		authMiddleware,
 	)
 
@@ -0,0 +1,72 @@
+// Command digest posts the weekly or monthly report digest of every user to the channels
+// the REPORT_DIGEST notification is routed to (SLACK_WEBHOOK_URL by default). By default it
+// posts the period that has just ended, so it is meant to be run from cron at the start of
+// each week or month:
+//
+//	# every Monday at 9:00 and on the 1st of every month at 9:00
+//	0 9 * * 1 cd /app && go run cmd/digest/main.go -period week
+//	0 9 1 * * cd /app && go run cmd/digest/main.go -period month
+//
+// Pass -date to post another period, e.g. -period month -date 2024-04-01. With
+// SCHEDULER_ENABLED=true the API runs the same posts as the weekly-digest and monthly-digest jobs.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/joho/godotenv"
+	"gorm.io/gorm"
+
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/application/usecase"
+	"github.com/attendance_report_app/backend/internal/infrastructure/database"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/notifier"
+)
+
+func main() {
+	period := flag.String("period", "week", "week or month")
+	date := flag.String("date", "", "any day of the period to post (YYYY-MM-DD, defaults to the previous period)")
+	flag.Parse()
+
+	if err := godotenv.Load(); err != nil {
+		log.Println("No .env file found")
+	}
+
+	config := database.NewConfigFromEnv()
+	db, err := database.Connect(config)
+	if err != nil {
+		log.Fatal("Failed to connect to database:", err)
+	}
+
+	digestUseCase := usecase.NewReportDigestUseCase(
+		repository.NewDailyReportRepository(db),
+		repository.NewAttendanceRepository(db),
+		repository.NewUserRepository(db),
+		newNotificationService(db),
+	)
+
+	result, err := digestUseCase.PostDigests(context.Background(), &request.PostReportDigestsRequest{Period: *period, Date: *date})
+	if err != nil {
+		log.Fatal("Failed to post digests:", err)
+	}
+
+	log.Printf("Posted %d digests for %s to %s", result.Posted, result.StartDate.Format("2006-01-02"), result.EndDate.Format("2006-01-02"))
+}
+
+// newNotificationService sends through the channels admins configured, falling back to SLACK_WEBHOOK_URL
+func newNotificationService(db *gorm.DB) usecase.NotificationService {
+	return usecase.NewNotificationService(
+		repository.NewNotificationChannelRepository(db),
+		repository.NewNotificationRouteRepository(db),
+		repository.NewNotificationPreferenceRepository(db),
+		repository.NewUserRepository(db),
+		repository.NewSlackAccountRepository(db),
+		usecase.NewOutboxService(repository.NewOutboxRepository(db)),
+		notifier.NewNotifiers(notifier.NewSMTPConfigFromEnv(), notifier.NewSlackConfigFromEnv()),
+		os.Getenv("SLACK_WEBHOOK_URL"),
+	)
+}
@@ -2,10 +2,13 @@ package main
 
 import (
 	"log"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 	"gorm.io/gorm"
 
+	"github.com/attendance_report_app/backend/internal/domain/entity"
 	"github.com/attendance_report_app/backend/internal/infrastructure/database"
 	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/model"
 )
@@ -37,8 +40,200 @@ func main() {
 }
 
 func migrate(db *gorm.DB) error {
-	return db.AutoMigrate(
+	hadReportTags := db.Migrator().HasTable(&model.ReportTag{})
+	hadOpenMonth := db.Migrator().HasColumn(&model.PayrollRun{}, "open_month")
+
+	if err := db.AutoMigrate(
 		&model.User{},
 		&model.Attendance{},
-	)
+		&model.Holiday{},
+		&model.PayrollRun{},
+		&model.PayrollLine{},
+		&model.PayrollLineItem{},
+		&model.PayrollRunAudit{},
+		&model.Allowance{},
+		&model.UserAllowance{},
+		&model.Bonus{},
+		&model.Goal{},
+		&model.MonthlySummary{},
+		&model.MonthlySummaryBuild{},
+		&model.SentReminder{},
+		&model.ReportTemplate{},
+		&model.DailyReport{},
+		&model.DailyReportRevision{},
+		&model.ReportComment{},
+		&model.ReportReaction{},
+		&model.ReportRead{},
+		&model.ReportTag{},
+		&model.ReportMention{},
+		&model.JobRun{},
+		&model.JobLock{},
+		&model.OutboxMessage{},
+		&model.NotificationChannel{},
+		&model.NotificationRoute{},
+		&model.NotificationPreference{},
+		&model.SlackAccount{},
+		&model.SlackLinkCode{},
+		&model.ClockIn{},
+		&model.WebhookSubscription{},
+		&model.WebhookDelivery{},
+	); err != nil {
+		return err
+	}
+
+	if err := moveAttendanceReports(db); err != nil {
+		return err
+	}
+	if !hadOpenMonth {
+		if err := backfillOpenMonths(db); err != nil {
+			return err
+		}
+	}
+	if !hadReportTags {
+		return backfillReportTags(db)
+	}
+	return nil
+}
+
+// backfillOpenMonths marks the runs that are still open in their month. Should a month have
+// several from before the constraint existed, only the newest is marked.
+func backfillOpenMonths(db *gorm.DB) error {
+	var runs []model.PayrollRun
+	if err := db.Where("status <> ?", string(entity.PayrollRunStatusReopened)).Order("id DESC").Find(&runs).Error; err != nil {
+		return err
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		marked := make(map[string]bool)
+		for _, run := range runs {
+			if marked[run.Month] {
+				log.Printf("Payroll run %d is not the only open run for %s", run.Id, run.Month)
+				continue
+			}
+			marked[run.Month] = true
+			if err := tx.Model(&model.PayrollRun{}).Where("id = ?", run.Id).Update("open_month", run.Month).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// backfillReportTags parses the hashtags and mentions of reports written before they were
+// tracked. The mentions are stored as already notified so that nobody gets pinged for old reports.
+func backfillReportTags(db *gorm.DB) error {
+	var reports []model.DailyReport
+	if err := db.Find(&reports).Error; err != nil {
+		return err
+	}
+	var users []model.User
+	if err := db.Find(&users).Error; err != nil {
+		return err
+	}
+
+	now := time.Now()
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, report := range reports {
+			tags, handles := entity.ParseReportText(report.ToEntity().Content())
+			for _, tag := range tags {
+				if err := tx.Omit("Report").Create(&model.ReportTag{ReportId: report.Id, Tag: tag}).Error; err != nil {
+					return err
+				}
+			}
+
+			mentioned := make(map[int]bool)
+			for _, handle := range handles {
+				for _, user := range users {
+					if !mentioned[user.Id] && user.ToEntity().MatchesMention(handle) {
+						mentioned[user.Id] = true
+						mention := &model.ReportMention{ReportId: report.Id, UserId: user.Id, NotifiedAt: &now}
+						if err := tx.Omit("Report", "User").Create(mention).Error; err != nil {
+							return err
+						}
+						break
+					}
+				}
+			}
+		}
+		log.Printf("Indexed tags and mentions of %d daily reports", len(reports))
+		return nil
+	})
+}
+
+// moveAttendanceReports copies the report text that used to be stored on attendances into
+// daily_reports and then drops the old column. Records of the same day are merged into one
+// published report, linked to the first attendance of that day. Days that already have a
+// daily report are left alone.
+func moveAttendanceReports(db *gorm.DB) error {
+	if !db.Migrator().HasColumn(&model.Attendance{}, "report") {
+		return nil
+	}
+
+	var rows []struct {
+		Id        int
+		UserId    int
+		Date      time.Time
+		Report    string
+		CreatedAt time.Time
+	}
+	if err := db.Table("attendances").
+		Select("id, user_id, date, report, created_at").
+		Where("report IS NOT NULL AND report <> ''").
+		Order("user_id, date, start_time, id").
+		Scan(&rows).Error; err != nil {
+		return err
+	}
+
+	type dayKey struct {
+		userId int
+		date   string
+	}
+	reports := make(map[dayKey]*model.DailyReport)
+	texts := make(map[dayKey][]string)
+	var keys []dayKey
+	for _, row := range rows {
+		date := time.Date(row.Date.Year(), row.Date.Month(), row.Date.Day(), 0, 0, 0, 0, row.Date.Location())
+		key := dayKey{userId: row.UserId, date: date.Format("2006-01-02")}
+		if _, ok := reports[key]; !ok {
+			attendanceId := row.Id
+			publishedAt := row.CreatedAt
+			reports[key] = &model.DailyReport{
+				UserId:       row.UserId,
+				AttendanceId: &attendanceId,
+				Date:         date,
+				Status:       "PUBLISHED",
+				Version:      1,
+				PublishedAt:  &publishedAt,
+				CreatedAt:    row.CreatedAt,
+			}
+			keys = append(keys, key)
+		}
+		texts[key] = append(texts[key], strings.TrimSpace(row.Report))
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		moved := 0
+		for _, key := range keys {
+			var count int64
+			if err := tx.Model(&model.DailyReport{}).
+				Where("user_id = ? AND date = ?", key.userId, key.date).
+				Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				continue
+			}
+
+			report := reports[key]
+			report.Body = strings.Join(texts[key], "\n\n")
+			report.Content = report.Body
+			if err := tx.Omit("User", "Attendance", "Template").Create(report).Error; err != nil {
+				return err
+			}
+			moved++
+		}
+		log.Printf("Moved %d attendance reports to daily_reports", moved)
+
+		return tx.Migrator().DropColumn(&model.Attendance{}, "report")
+	})
 }
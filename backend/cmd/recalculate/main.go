@@ -0,0 +1,84 @@
+// Command recalculate rebuilds the materialized monthly summaries.
+//
+//	go run cmd/recalculate/main.go -from 2024-01 -to 2024-12
+//	go run cmd/recalculate/main.go -from 2024-04 -user 12
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"time"
+
+	"github.com/joho/godotenv"
+
+	"github.com/attendance_report_app/backend/internal/application/transaction"
+	"github.com/attendance_report_app/backend/internal/application/usecase"
+	"github.com/attendance_report_app/backend/internal/infrastructure/database"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/repository"
+)
+
+func main() {
+	from := flag.String("from", "", "first month to rebuild (YYYY-MM, required)")
+	to := flag.String("to", "", "last month to rebuild (YYYY-MM, defaults to -from)")
+	userID := flag.Int("user", 0, "rebuild a single user only")
+	flag.Parse()
+
+	if *from == "" {
+		log.Fatal("-from is required")
+	}
+	if *to == "" {
+		to = from
+	}
+
+	fromMonth, err := usecase.ParseMonth(*from)
+	if err != nil {
+		log.Fatal(err)
+	}
+	toMonth, err := usecase.ParseMonth(*to)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if toMonth.Before(fromMonth) {
+		log.Fatal("-to must not be before -from")
+	}
+
+	if err := godotenv.Load(); err != nil {
+		log.Println("No .env file found")
+	}
+
+	config := database.NewConfigFromEnv()
+	db, err := database.Connect(config)
+	if err != nil {
+		log.Fatal("Failed to connect to database:", err)
+	}
+
+	txManager := transaction.NewManager(db)
+
+	userRepo := repository.NewUserRepository(db)
+	attendanceRepo := repository.NewAttendanceRepository(db)
+	holidayRepo := repository.NewHolidayRepository(db)
+	payrollCalculator := usecase.NewPayrollCalculator(userRepo, attendanceRepo, holidayRepo,
+		repository.NewAllowanceRepository(db), repository.NewBonusRepository(db))
+	summaryService := usecase.NewMonthlySummaryService(repository.NewMonthlySummaryRepository(db), userRepo, payrollCalculator)
+
+	// Each month is rebuilt in its own transaction
+	for month := fromMonth; !month.After(toMonth); month = month.AddDate(0, 1, 0) {
+		err := txManager.ExecuteInTx(context.Background(), func(ctx context.Context) error {
+			if *userID > 0 {
+				_, err := summaryService.RefreshUserMonth(ctx, *userID, month)
+				return err
+			}
+			summaries, err := summaryService.RebuildMonth(ctx, month)
+			if err == nil {
+				log.Printf("%s: rebuilt %d summaries", month.Format("2006-01"), len(summaries))
+			}
+			return err
+		})
+		if err != nil {
+			log.Fatalf("Failed to rebuild %s: %v", month.Format("2006-01"), err)
+		}
+	}
+
+	log.Printf("Recalculation completed at %s", time.Now().Format(time.RFC3339))
+}
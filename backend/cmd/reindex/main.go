@@ -0,0 +1,50 @@
+// Command reindex rebuilds the full-text search index of daily reports and writes it to
+// SEARCH_INDEX_PATH. Running API processes pick it up on restart; to rebuild a running
+// process in place, use POST /api/admin/reports/search/rebuild.
+//
+//	go run cmd/reindex/main.go
+//	go run cmd/reindex/main.go -out /var/lib/attendance/search_index.gob
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"github.com/joho/godotenv"
+
+	"github.com/attendance_report_app/backend/internal/application/usecase"
+	"github.com/attendance_report_app/backend/internal/infrastructure/database"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/repository"
+)
+
+func main() {
+	if err := godotenv.Load(); err != nil {
+		log.Println("No .env file found")
+	}
+
+	out := flag.String("out", os.Getenv("SEARCH_INDEX_PATH"), "snapshot file to write (defaults to SEARCH_INDEX_PATH)")
+	flag.Parse()
+
+	if *out == "" {
+		log.Fatal("-out or SEARCH_INDEX_PATH is required")
+	}
+
+	config := database.NewConfigFromEnv()
+	db, err := database.Connect(config)
+	if err != nil {
+		log.Fatal("Failed to connect to database:", err)
+	}
+
+	searchService := usecase.NewReportSearchService(repository.NewDailyReportRepository(db), *out)
+
+	start := time.Now()
+	documents, err := searchService.Rebuild(context.Background())
+	if err != nil {
+		log.Fatal("Failed to rebuild search index:", err)
+	}
+
+	log.Printf("Indexed %d reports into %s in %s", documents, *out, time.Since(start).Round(time.Millisecond))
+}
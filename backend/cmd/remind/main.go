@@ -0,0 +1,97 @@
+// Command remind reminds users who have not logged today's attendance or daily report and
+// posts a summary of who is missing for admins, through the channels the REMINDER and
+// MISSING_SUMMARY notifications are routed to (SLACK_WEBHOOK_URL by default). Run it from
+// cron every hour at the cutoff's minute (REMINDER_CUTOFF, 18:00 by default): users are due
+// once both the cutoff and their shift end have passed, and each user is reminded once a day.
+// Each run also checks yesterday, for shifts that ended after its last run. It does nothing
+// for a day before its cutoff and on holidays and weekends:
+//
+//	0 * * * * cd /app && go run cmd/remind/main.go
+//
+// Pass -date to check another day and -dry-run to only print who is missing. With
+// SCHEDULER_ENABLED=true the API runs the same reminders as the reminders job. Reminders for
+// users in their quiet hours are queued and sent later by the API's outbox dispatcher.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/joho/godotenv"
+	"gorm.io/gorm"
+
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/application/usecase"
+	"github.com/attendance_report_app/backend/internal/infrastructure/database"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/notifier"
+)
+
+func main() {
+	date := flag.String("date", "", "day to check (YYYY-MM-DD, defaults to today)")
+	cutoff := flag.String("cutoff", "", "time the day must be logged by (HH:MM, defaults to REMINDER_CUTOFF)")
+	dryRun := flag.Bool("dry-run", false, "list the missing users without notifying anyone")
+	flag.Parse()
+
+	if err := godotenv.Load(); err != nil {
+		log.Println("No .env file found")
+	}
+
+	config := database.NewConfigFromEnv()
+	db, err := database.Connect(config)
+	if err != nil {
+		log.Fatal("Failed to connect to database:", err)
+	}
+
+	reminderUseCase := usecase.NewReminderUseCase(
+		repository.NewUserRepository(db),
+		repository.NewAttendanceRepository(db),
+		repository.NewDailyReportRepository(db),
+		repository.NewHolidayRepository(db),
+		repository.NewSentReminderRepository(db),
+		newNotificationService(db),
+		os.Getenv("REMINDER_CUTOFF"),
+	)
+
+	req := &request.RemindersRequest{Date: *date, Cutoff: *cutoff}
+	var result *dto.MissingEntriesResponse
+	if *dryRun {
+		result, err = reminderUseCase.GetMissingEntries(context.Background(), req)
+	} else {
+		result, err = reminderUseCase.SendReminders(context.Background(), req)
+	}
+	if err != nil {
+		log.Fatal("Failed to check missing entries:", err)
+	}
+
+	if result.Previous != nil {
+		logMissingEntries(result.Previous)
+	}
+	logMissingEntries(result)
+}
+
+func logMissingEntries(result *dto.MissingEntriesResponse) {
+	for _, entry := range result.Entries {
+		log.Printf("%s (%d): attendance missing=%t, report missing=%t", entry.UserName, entry.UserId, entry.MissingAttendance, entry.MissingReport)
+	}
+	if result.Notified != nil {
+		log.Printf("Reminded %d of %d users missing on %s", *result.Notified, len(result.Entries), result.Date.Format("2006-01-02"))
+	}
+}
+
+// newNotificationService sends through the channels admins configured, falling back to SLACK_WEBHOOK_URL
+func newNotificationService(db *gorm.DB) usecase.NotificationService {
+	return usecase.NewNotificationService(
+		repository.NewNotificationChannelRepository(db),
+		repository.NewNotificationRouteRepository(db),
+		repository.NewNotificationPreferenceRepository(db),
+		repository.NewUserRepository(db),
+		repository.NewSlackAccountRepository(db),
+		usecase.NewOutboxService(repository.NewOutboxRepository(db)),
+		notifier.NewNotifiers(notifier.NewSMTPConfigFromEnv(), notifier.NewSlackConfigFromEnv()),
+		os.Getenv("SLACK_WEBHOOK_URL"),
+	)
+}
@@ -0,0 +1,84 @@
+// Command slackreplay posts a recorded Slack slash-command payload to the API, signed with
+// SLACK_SIGNING_SECRET as Slack would sign it, and prints the reply. It exercises /kintai
+// without a Slack workspace:
+//
+//	go run cmd/slackreplay/main.go cmd/slackreplay/testdata/kintai_in.txt
+//	go run cmd/slackreplay/main.go -text "out 45 資料作成" cmd/slackreplay/testdata/kintai_out.txt
+//
+// The payloads in testdata are URL-encoded form bodies as Slack posts them; record new ones
+// from the requests Slack sends. -user and -text override the payload's user_id and text.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/joho/godotenv"
+
+	"github.com/attendance_report_app/backend/internal/infrastructure/slack"
+)
+
+func main() {
+	endpoint := flag.String("url", "http://localhost:8080/api/slack/commands", "slash command endpoint")
+	user := flag.String("user", "", "Slack user ID to send the command as")
+	text := flag.String("text", "", "command text, e.g. \"out 60 作業内容\"")
+	flag.Parse()
+	if flag.NArg() != 1 {
+		log.Fatal("Usage: slackreplay [-url URL] [-user ID] [-text TEXT] PAYLOAD_FILE")
+	}
+
+	if err := godotenv.Load(); err != nil {
+		log.Println("No .env file found")
+	}
+	secret := os.Getenv("SLACK_SIGNING_SECRET")
+	if secret == "" {
+		log.Fatal("SLACK_SIGNING_SECRET is not set")
+	}
+
+	recorded, err := os.ReadFile(flag.Arg(0))
+	if err != nil {
+		log.Fatal("Failed to read payload:", err)
+	}
+	form, err := url.ParseQuery(string(bytes.TrimSpace(recorded)))
+	if err != nil {
+		log.Fatal("Failed to parse payload:", err)
+	}
+	if *user != "" {
+		form.Set("user_id", *user)
+	}
+	if *text != "" {
+		form.Set("text", *text)
+	}
+	body := []byte(form.Encode())
+
+	// Recorded signatures expire after a few minutes, so the payload is signed again
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req, err := http.NewRequest(http.MethodPost, *endpoint, bytes.NewReader(body))
+	if err != nil {
+		log.Fatal("Failed to create request:", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set(slack.TimestampHeader, timestamp)
+	req.Header.Set(slack.SignatureHeader, slack.Sign(secret, timestamp, body))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		log.Fatal("Failed to send command:", err)
+	}
+	defer resp.Body.Close()
+
+	reply, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Fatal("Failed to read reply:", err)
+	}
+	fmt.Println(resp.Status)
+	fmt.Println(string(reply))
+}
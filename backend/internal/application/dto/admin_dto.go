@@ -1,28 +1,118 @@
 package dto
 
+import (
+	"fmt"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
 type DashboardResponse struct {
-	TotalHours      float64                 `json:"totalHours"`
-	TotalSalary     int                     `json:"totalSalary"`
-	ActiveEmployees int                     `json:"activeEmployees"`
-	EmployeeData    []DashboardEmployeeData `json:"employeeData"`
+	From                    string                  `json:"from"` // YYYY-MM-DD
+	To                      string                  `json:"to"`   // YYYY-MM-DD
+	TotalHours              float64                 `json:"totalHours"`
+	TotalSalary             int                     `json:"totalSalary"`
+	ActiveEmployees         int                     `json:"activeEmployees"` // headcount at the end of the period, excluding terminated users
+	AverageHoursPerEmployee float64                 `json:"averageHoursPerEmployee"`
+	Comparison              DashboardComparison     `json:"comparison"`
+	WeeklyTrend             []DashboardWeek         `json:"weeklyTrend"`
+	EmployeeData            []DashboardEmployeeData `json:"employeeData"`
 }
 
 type DashboardEmployeeData struct {
+	ID          int     `json:"id"`
 	Name        string  `json:"name"`
 	TotalHours  float64 `json:"totalHours"`
 	TotalSalary int     `json:"totalSalary"`
 }
 
+// DashboardComparison compares the period with the preceding period of the same
+// length (the previous month when a month is requested)
+type DashboardComparison struct {
+	PreviousFrom            string  `json:"previousFrom"`
+	PreviousTo              string  `json:"previousTo"`
+	PreviousTotalHours      float64 `json:"previousTotalHours"`
+	PreviousTotalSalary     int     `json:"previousTotalSalary"`
+	PreviousActiveEmployees int     `json:"previousActiveEmployees"`
+	HoursChangeRate         float64 `json:"hoursChangeRate"`  // percent; 0 when the previous period is empty
+	SalaryChangeRate        float64 `json:"salaryChangeRate"` // percent; 0 when the previous period is empty
+}
+
+// DashboardWeek is one Monday-to-Sunday week of the trend series, clipped to the period
+type DashboardWeek struct {
+	WeekStart       string  `json:"weekStart"` // YYYY-MM-DD
+	WeekEnd         string  `json:"weekEnd"`   // YYYY-MM-DD
+	TotalHours      float64 `json:"totalHours"`
+	WorkedDays      int     `json:"workedDays"` // employee-days with attendance
+	ActiveEmployees int     `json:"activeEmployees"`
+}
+
 type PayrollResponse struct {
 	TotalPayroll int               `json:"totalPayroll"`
 	PayrollData  []PayrollEmployee `json:"payrollData"`
 }
 
 type PayrollEmployee struct {
-	ID          string  `json:"id"`
-	Name        string  `json:"name"`
-	PayType     string  `json:"payType"`
-	PayRate     int     `json:"payRate"`
-	TotalHours  float64 `json:"totalHours"`
-	TotalSalary int     `json:"totalSalary"`
+	ID               string        `json:"id"`
+	Name             string        `json:"name"`
+	PayType          string        `json:"payType"`
+	PayRate          int           `json:"payRate"`
+	TotalHours       float64       `json:"totalHours"`
+	OvertimeHours    float64       `json:"overtimeHours"`
+	WorkedDays       int           `json:"workedDays"`
+	WorkingDays      int           `json:"workingDays"`
+	AbsenceDays      int           `json:"absenceDays"`
+	LateEarlyMinutes int           `json:"lateEarlyMinutes"`
+	TotalSalary      int           `json:"totalSalary"`
+	Items            []PayrollItem `json:"items"`
+}
+
+type PayrollItem struct {
+	Code    string `json:"code"`
+	Name    string `json:"name"`
+	Kind    string `json:"kind"`
+	Amount  int    `json:"amount"`
+	Taxable bool   `json:"taxable"`
+}
+
+func ToPayrollItem(item *entity.PayrollItem) PayrollItem {
+	return PayrollItem{
+		Code:    item.Code,
+		Name:    item.Name,
+		Kind:    string(item.Kind),
+		Amount:  item.Amount,
+		Taxable: item.Taxable,
+	}
+}
+
+func ToPayrollEmployee(line *entity.PayrollLine) PayrollEmployee {
+	items := make([]PayrollItem, len(line.Items))
+	for i, item := range line.Items {
+		items[i] = ToPayrollItem(item)
+	}
+
+	return PayrollEmployee{
+		ID:               fmt.Sprintf("user-%d", line.UserId), // Convert int to string format
+		Name:             line.UserName,
+		PayType:          string(line.PayType),
+		PayRate:          line.PayRate,
+		TotalHours:       line.TotalHours,
+		OvertimeHours:    line.OvertimeHours,
+		WorkedDays:       line.WorkedDays,
+		WorkingDays:      line.WorkingDays,
+		AbsenceDays:      line.AbsenceDays,
+		LateEarlyMinutes: line.LateEarlyMinutes,
+		TotalSalary:      line.TotalSalary,
+		Items:            items,
+	}
+}
+
+func ToPayrollResponse(lines []*entity.PayrollLine) *PayrollResponse {
+	response := &PayrollResponse{
+		PayrollData: make([]PayrollEmployee, len(lines)),
+	}
+	for i, line := range lines {
+		response.PayrollData[i] = ToPayrollEmployee(line)
+		response.TotalPayroll += line.TotalSalary
+	}
+	return response
 }
@@ -13,7 +13,7 @@ type AttendanceResponse struct {
 	StartTime    time.Time `json:"start_time"` // ISO 8601 format
 	EndTime      time.Time `json:"end_time"`   // ISO 8601 format
 	BreakMinutes int       `json:"break_minutes"`
-	Report       string    `json:"report"`
+	Report       string    `json:"report"`     // the day's daily report, rendered as Markdown
 	CreatedAt    time.Time `json:"created_at"` // ISO 8601 format
 	UpdatedAt    time.Time `json:"updated_at"` // ISO 8601 format
 }
@@ -22,27 +22,39 @@ type AttendanceListResponse struct {
 	Attendances []AttendanceResponse `json:"attendances"`
 }
 
-func ToAttendanceResponse(attendance *entity.Attendance) *AttendanceResponse {
-	return &AttendanceResponse{
+// ToAttendanceResponse converts an attendance together with its linked daily report, which may be nil
+func ToAttendanceResponse(attendance *entity.Attendance, report *entity.DailyReport) *AttendanceResponse {
+	response := &AttendanceResponse{
 		Id:           attendance.Id,
 		UserId:       attendance.UserId,
 		Date:         attendance.Date,
 		StartTime:    attendance.StartTime,
 		EndTime:      attendance.EndTime,
 		BreakMinutes: attendance.BreakMinutes,
-		Report:       attendance.Report,
 		CreatedAt:    attendance.CreatedAt,
 		UpdatedAt:    attendance.UpdatedAt,
 	}
+	if report != nil {
+		response.Report = report.Content()
+	}
+	return response
 }
 
-func ToAttendanceListResponse(attendances []*entity.Attendance) *AttendanceListResponse {
+// ToAttendanceListResponse converts attendances, matching each with its linked daily report among reports
+func ToAttendanceListResponse(attendances []*entity.Attendance, reports []*entity.DailyReport) *AttendanceListResponse {
 	response := &AttendanceListResponse{
 		Attendances: make([]AttendanceResponse, len(attendances)),
 	}
 
+	reportsByAttendance := make(map[int]*entity.DailyReport, len(reports))
+	for _, report := range reports {
+		if report.AttendanceId != nil {
+			reportsByAttendance[*report.AttendanceId] = report
+		}
+	}
+
 	for i, attendance := range attendances {
-		response.Attendances[i] = *ToAttendanceResponse(attendance)
+		response.Attendances[i] = *ToAttendanceResponse(attendance, reportsByAttendance[attendance.Id])
 	}
 
 	return response
@@ -18,3 +18,18 @@ type PaginationResponse struct {
 	PerPage    int         `json:"per_page"`
 	TotalPages int         `json:"total_pages"`
 }
+
+// ToPaginationResponse wraps one page of data with its paging information
+func ToPaginationResponse(data interface{}, total int64, page, perPage int) *PaginationResponse {
+	totalPages := 0
+	if perPage > 0 {
+		totalPages = int((total + int64(perPage) - 1) / int64(perPage))
+	}
+	return &PaginationResponse{
+		Data:       data,
+		Total:      total,
+		Page:       page,
+		PerPage:    perPage,
+		TotalPages: totalPages,
+	}
+}
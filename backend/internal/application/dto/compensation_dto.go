@@ -0,0 +1,124 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type AllowanceResponse struct {
+	Id            int       `json:"id"`
+	Name          string    `json:"name"`
+	Taxable       bool      `json:"taxable"`
+	DefaultAmount int       `json:"default_amount"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type AllowancesResponse struct {
+	Allowances []AllowanceResponse `json:"allowances"`
+}
+
+type UserAllowanceResponse struct {
+	Id            int        `json:"id"`
+	UserId        int        `json:"user_id"`
+	AllowanceId   int        `json:"allowance_id"`
+	AllowanceName string     `json:"allowance_name"`
+	Taxable       bool       `json:"taxable"`
+	Amount        int        `json:"amount"`
+	EffectiveFrom time.Time  `json:"effective_from"`
+	EffectiveTo   *time.Time `json:"effective_to,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+type UserAllowancesResponse struct {
+	UserAllowances []UserAllowanceResponse `json:"user_allowances"`
+}
+
+type BonusResponse struct {
+	Id          int       `json:"id"`
+	UserId      int       `json:"user_id"`
+	Name        string    `json:"name"`
+	Amount      int       `json:"amount"`
+	PaymentDate time.Time `json:"payment_date"`
+	Taxable     bool      `json:"taxable"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type BonusesResponse struct {
+	Bonuses []BonusResponse `json:"bonuses"`
+}
+
+func ToAllowanceResponse(allowance *entity.Allowance) *AllowanceResponse {
+	return &AllowanceResponse{
+		Id:            allowance.Id,
+		Name:          allowance.Name,
+		Taxable:       allowance.Taxable,
+		DefaultAmount: allowance.DefaultAmount,
+		CreatedAt:     allowance.CreatedAt,
+		UpdatedAt:     allowance.UpdatedAt,
+	}
+}
+
+func ToAllowancesResponse(allowances []*entity.Allowance) *AllowancesResponse {
+	response := &AllowancesResponse{
+		Allowances: make([]AllowanceResponse, len(allowances)),
+	}
+	for i, allowance := range allowances {
+		response.Allowances[i] = *ToAllowanceResponse(allowance)
+	}
+	return response
+}
+
+func ToUserAllowanceResponse(userAllowance *entity.UserAllowance) *UserAllowanceResponse {
+	response := &UserAllowanceResponse{
+		Id:            userAllowance.Id,
+		UserId:        userAllowance.UserId,
+		AllowanceId:   userAllowance.AllowanceId,
+		Amount:        userAllowance.Amount,
+		EffectiveFrom: userAllowance.EffectiveFrom,
+		EffectiveTo:   userAllowance.EffectiveTo,
+		CreatedAt:     userAllowance.CreatedAt,
+		UpdatedAt:     userAllowance.UpdatedAt,
+	}
+	if userAllowance.Allowance != nil {
+		response.AllowanceName = userAllowance.Allowance.Name
+		response.Taxable = userAllowance.Allowance.Taxable
+	}
+	return response
+}
+
+func ToUserAllowancesResponse(userAllowances []*entity.UserAllowance) *UserAllowancesResponse {
+	response := &UserAllowancesResponse{
+		UserAllowances: make([]UserAllowanceResponse, len(userAllowances)),
+	}
+	for i, userAllowance := range userAllowances {
+		response.UserAllowances[i] = *ToUserAllowanceResponse(userAllowance)
+	}
+	return response
+}
+
+func ToBonusResponse(bonus *entity.Bonus) *BonusResponse {
+	return &BonusResponse{
+		Id:          bonus.Id,
+		UserId:      bonus.UserId,
+		Name:        bonus.Name,
+		Amount:      bonus.Amount,
+		PaymentDate: bonus.PaymentDate,
+		Taxable:     bonus.Taxable,
+		CreatedAt:   bonus.CreatedAt,
+		UpdatedAt:   bonus.UpdatedAt,
+	}
+}
+
+func ToBonusesResponse(bonuses []*entity.Bonus) *BonusesResponse {
+	response := &BonusesResponse{
+		Bonuses: make([]BonusResponse, len(bonuses)),
+	}
+	for i, bonus := range bonuses {
+		response.Bonuses[i] = *ToBonusResponse(bonus)
+	}
+	return response
+}
@@ -1,30 +1,245 @@
 package dto
 
-import "time"
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type ReportSectionResponse struct {
+	Heading string `json:"heading"`
+	Body    string `json:"body"`
+}
 
 type DailyReportResponse struct {
-	Id       int       `json:"id"`
-	UserId   int       `json:"user_id"`
-	Date     time.Time `json:"date"`
-	Report   string    `json:"report"`
-	UserName string    `json:"user_name"`
+	Id           int       `json:"id"`
+	UserId       int       `json:"user_id"`
+	AttendanceId *int      `json:"attendance_id,omitempty"`
+	Date         time.Time `json:"date"`
+	// Report is the whole report rendered as Markdown
+	Report      string                  `json:"report"`
+	TemplateId  *int                    `json:"template_id,omitempty"`
+	Sections    []ReportSectionResponse `json:"sections"`
+	Body        string                  `json:"body"`
+	Status      string                  `json:"status"`
+	Visibility  string                  `json:"visibility"`
+	Version     int                     `json:"version"`
+	PublishedAt *time.Time              `json:"published_at,omitempty"`
+	UserName    string                  `json:"user_name"`
+	Department  string                  `json:"department"`
+	Tags        []string                `json:"tags"`
+	Mentions    []string                `json:"mentions"`
+	// Counts are filled in when reports are listed or read, not when they are written
+	CommentCount  int `json:"comment_count"`
+	ReactionCount int `json:"reaction_count"`
+	// Read is whether the caller has read the report; omitted where it is not looked up
+	Read      *bool     `json:"read,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 type DailyReportsResponse struct {
 	Reports []DailyReportResponse `json:"reports"`
 }
 
-func ToDailyReportResponse(id int, userId int, date time.Time, report string, userName string) *DailyReportResponse {
+// DailyReportsPageResponse is one page of reports with the number of unread reports
+// matching the same filters
+type DailyReportsPageResponse struct {
+	PaginationResponse
+	UnreadCount int64 `json:"unread_count"`
+}
+
+type ReportReaderResponse struct {
+	UserId     int       `json:"user_id"`
+	UserName   string    `json:"user_name"`
+	Department string    `json:"department"`
+	ReadAt     time.Time `json:"read_at"`
+}
+
+type ReportReadersResponse struct {
+	Readers []ReportReaderResponse `json:"readers"`
+}
+
+type TagCountResponse struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"` // published reports using the tag
+}
+
+type TagCloudResponse struct {
+	Tags []TagCountResponse `json:"tags"`
+}
+
+func ToTagCloudResponse(counts []*entity.TagCount) *TagCloudResponse {
+	response := &TagCloudResponse{
+		Tags: make([]TagCountResponse, len(counts)),
+	}
+	for i, count := range counts {
+		response.Tags[i] = TagCountResponse{Tag: count.Tag, Count: count.Count}
+	}
+	return response
+}
+
+type MarkAllReadResponse struct {
+	Marked int64 `json:"marked"` // reports newly marked as read
+}
+
+func ToReportReadersResponse(reads []*entity.ReportRead) *ReportReadersResponse {
+	response := &ReportReadersResponse{
+		Readers: make([]ReportReaderResponse, len(reads)),
+	}
+	for i, read := range reads {
+		response.Readers[i] = ReportReaderResponse{
+			UserId:     read.UserId,
+			UserName:   read.UserName,
+			Department: read.Department,
+			ReadAt:     read.ReadAt,
+		}
+	}
+	return response
+}
+
+// DailyReportRevisionResponse is an earlier version of a report
+type DailyReportRevisionResponse struct {
+	Version   int                     `json:"version"`
+	Report    string                  `json:"report"`
+	Sections  []ReportSectionResponse `json:"sections"`
+	Body      string                  `json:"body"`
+	Status    string                  `json:"status"`
+	EditedBy  int                     `json:"edited_by"`
+	CreatedAt time.Time               `json:"created_at"` // when this version was replaced
+}
+
+// DailyReportHistoryResponse lists a report's current version followed by the earlier ones
+type DailyReportHistoryResponse struct {
+	Current   DailyReportResponse           `json:"current"`
+	Revisions []DailyReportRevisionResponse `json:"revisions"`
+}
+
+func ToDailyReportResponse(report *entity.DailyReport) *DailyReportResponse {
 	return &DailyReportResponse{
-		Id:       id,
-		UserId:   userId,
-		Date:     date,
-		Report:   report,
-		UserName: userName,
+		Id:            report.Id,
+		UserId:        report.UserId,
+		AttendanceId:  report.AttendanceId,
+		Date:          report.Date,
+		Report:        report.Content(),
+		TemplateId:    report.TemplateId,
+		Sections:      toReportSectionResponses(report.Sections),
+		Body:          report.Body,
+		Status:        string(report.Status),
+		Visibility:    string(report.Visibility),
+		Version:       report.Version,
+		PublishedAt:   report.PublishedAt,
+		UserName:      report.UserName,
+		Department:    report.Department,
+		Tags:          nonNil(report.Tags()),
+		Mentions:      nonNil(report.Mentions()),
+		CommentCount:  report.CommentCount,
+		ReactionCount: report.ReactionCount,
+		Read:          report.Read,
+		CreatedAt:     report.CreatedAt,
+		UpdatedAt:     report.UpdatedAt,
 	}
 }
-func ToDailyReportsResponse(reports []DailyReportResponse) *DailyReportsResponse {
+
+// nonNil keeps empty lists as [] rather than null in JSON
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+func ToDailyReportsResponse(reports []*entity.DailyReport) *DailyReportsResponse {
+	responses := make([]DailyReportResponse, len(reports))
+	for i, report := range reports {
+		responses[i] = *ToDailyReportResponse(report)
+	}
 	return &DailyReportsResponse{
-		Reports: reports,
+		Reports: responses,
+	}
+}
+
+func ToDailyReportHistoryResponse(report *entity.DailyReport, revisions []*entity.DailyReportRevision) *DailyReportHistoryResponse {
+	response := &DailyReportHistoryResponse{
+		Current:   *ToDailyReportResponse(report),
+		Revisions: make([]DailyReportRevisionResponse, len(revisions)),
+	}
+	for i, revision := range revisions {
+		content := (&entity.DailyReport{Sections: revision.Sections, Body: revision.Body}).Content()
+		response.Revisions[i] = DailyReportRevisionResponse{
+			Version:   revision.Version,
+			Report:    content,
+			Sections:  toReportSectionResponses(revision.Sections),
+			Body:      revision.Body,
+			Status:    string(revision.Status),
+			EditedBy:  revision.EditedBy,
+			CreatedAt: revision.CreatedAt,
+		}
+	}
+	return response
+}
+
+func toReportSectionResponses(sections []entity.ReportSection) []ReportSectionResponse {
+	responses := make([]ReportSectionResponse, len(sections))
+	for i, section := range sections {
+		responses[i] = ReportSectionResponse{Heading: section.Heading, Body: section.Body}
+	}
+	return responses
+}
+
+// DailyReportSearchResult is a report matching a search, with the matches highlighted in Snippet
+type DailyReportSearchResult struct {
+	DailyReportResponse
+	Snippet string  `json:"snippet"` // HTML-escaped excerpt with matches wrapped in <mark>
+	Score   float64 `json:"score"`
+}
+
+type SearchIndexResponse struct {
+	Documents int `json:"documents"`
+}
+
+type TemplateSectionResponse struct {
+	Heading  string `json:"heading"`
+	Hint     string `json:"hint"`
+	Required bool   `json:"required"`
+}
+
+type ReportTemplateResponse struct {
+	Id          int                       `json:"id"`
+	Name        string                    `json:"name"`
+	Description string                    `json:"description"`
+	Sections    []TemplateSectionResponse `json:"sections"`
+	Active      bool                      `json:"active"`
+	CreatedAt   time.Time                 `json:"created_at"`
+	UpdatedAt   time.Time                 `json:"updated_at"`
+}
+
+type ReportTemplatesResponse struct {
+	Templates []ReportTemplateResponse `json:"templates"`
+}
+
+func ToReportTemplateResponse(template *entity.ReportTemplate) *ReportTemplateResponse {
+	sections := make([]TemplateSectionResponse, len(template.Sections))
+	for i, section := range template.Sections {
+		sections[i] = TemplateSectionResponse{Heading: section.Heading, Hint: section.Hint, Required: section.Required}
+	}
+	return &ReportTemplateResponse{
+		Id:          template.Id,
+		Name:        template.Name,
+		Description: template.Description,
+		Sections:    sections,
+		Active:      template.Active,
+		CreatedAt:   template.CreatedAt,
+		UpdatedAt:   template.UpdatedAt,
+	}
+}
+
+func ToReportTemplatesResponse(templates []*entity.ReportTemplate) *ReportTemplatesResponse {
+	response := &ReportTemplatesResponse{
+		Templates: make([]ReportTemplateResponse, len(templates)),
+	}
+	for i, template := range templates {
+		response.Templates[i] = *ToReportTemplateResponse(template)
 	}
+	return response
 }
@@ -0,0 +1,68 @@
+package dto
+
+import "github.com/attendance_report_app/backend/internal/domain/entity"
+
+type EarningsResponse struct {
+	From          string          `json:"from"` // YYYY-MM
+	To            string          `json:"to"`   // YYYY-MM
+	TotalHours    float64         `json:"total_hours"`
+	TotalEarnings int             `json:"total_earnings"`
+	Goal          int             `json:"goal"` // monthly goal
+	Months        []MonthEarnings `json:"months"`
+}
+
+type MonthEarnings struct {
+	Month        string        `json:"month"` // YYYY-MM
+	Finalized    bool          `json:"finalized"`
+	TotalHours   float64       `json:"total_hours"`
+	WorkedDays   int           `json:"worked_days"`
+	EstimatedPay int           `json:"estimated_pay"`
+	GoalProgress float64       `json:"goal_progress"` // percentage of the monthly goal, 0 when no goal is set
+	Items        []PayrollItem `json:"items"`         // empty for months read from the monthly summary
+}
+
+// ToMonthEarnings converts a payroll line; a nil line means the user was not employed that month
+func ToMonthEarnings(month string, line *entity.PayrollLine, finalized bool, goal int) MonthEarnings {
+	earnings := MonthEarnings{
+		Month:     month,
+		Finalized: finalized,
+		Items:     []PayrollItem{},
+	}
+	if line == nil {
+		return earnings
+	}
+
+	earnings.TotalHours = line.TotalHours
+	earnings.WorkedDays = line.WorkedDays
+	earnings.EstimatedPay = line.TotalSalary
+	earnings.GoalProgress = GoalProgress(line.TotalSalary, goal)
+	for _, item := range line.Items {
+		earnings.Items = append(earnings.Items, ToPayrollItem(item))
+	}
+	return earnings
+}
+
+// ToMonthEarningsFromSummary converts a materialized summary; a nil summary means the user was not employed that month
+func ToMonthEarningsFromSummary(month string, summary *entity.MonthlySummary, goal int) MonthEarnings {
+	earnings := MonthEarnings{
+		Month: month,
+		Items: []PayrollItem{},
+	}
+	if summary == nil {
+		return earnings
+	}
+
+	earnings.TotalHours = summary.TotalHours
+	earnings.WorkedDays = summary.WorkedDays
+	earnings.EstimatedPay = summary.EstimatedPay
+	earnings.GoalProgress = GoalProgress(summary.EstimatedPay, goal)
+	return earnings
+}
+
+// GoalProgress returns amount as a percentage of goal, rounded to one decimal place
+func GoalProgress(amount int, goal int) float64 {
+	if goal <= 0 {
+		return 0
+	}
+	return float64(amount*1000/goal) / 10
+}
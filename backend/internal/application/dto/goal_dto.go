@@ -0,0 +1,61 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type GoalResponse struct {
+	Id         int        `json:"id"`
+	Type       string     `json:"type"`
+	Period     string     `json:"period"`
+	Target     int        `json:"target"`
+	ActiveFrom time.Time  `json:"active_from"`
+	ActiveTo   *time.Time `json:"active_to,omitempty"`
+}
+
+type GoalsResponse struct {
+	Active  []GoalResponse `json:"active"`
+	History []GoalResponse `json:"history"` // all goals including superseded ones, newest first
+}
+
+type GoalProgressResponse struct {
+	AsOf  string             `json:"as_of"` // YYYY-MM-DD
+	Goals []GoalProgressItem `json:"goals"`
+}
+
+type GoalProgressItem struct {
+	Goal              GoalResponse `json:"goal"`
+	PeriodStart       string       `json:"period_start"` // YYYY-MM-DD
+	PeriodEnd         string       `json:"period_end"`   // YYYY-MM-DD
+	Actual            float64      `json:"actual"`
+	ProjectedMonthEnd float64      `json:"projected_month_end"`
+	Progress          float64      `json:"progress"`           // actual as a percentage of the target
+	ProjectedProgress float64      `json:"projected_progress"` // projected month-end figure as a percentage of the target
+}
+
+func ToGoalResponse(goal *entity.Goal) *GoalResponse {
+	return &GoalResponse{
+		Id:         goal.Id,
+		Type:       string(goal.Type),
+		Period:     string(goal.Period),
+		Target:     goal.Target,
+		ActiveFrom: goal.ActiveFrom,
+		ActiveTo:   goal.ActiveTo,
+	}
+}
+
+func ToGoalsResponse(active []*entity.Goal, history []*entity.Goal) *GoalsResponse {
+	response := &GoalsResponse{
+		Active:  make([]GoalResponse, len(active)),
+		History: make([]GoalResponse, len(history)),
+	}
+	for i, goal := range active {
+		response.Active[i] = *ToGoalResponse(goal)
+	}
+	for i, goal := range history {
+		response.History[i] = *ToGoalResponse(goal)
+	}
+	return response
+}
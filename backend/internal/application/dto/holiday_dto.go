@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type HolidayResponse struct {
+	Id        int       `json:"id"`
+	Date      time.Time `json:"date"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type HolidaysResponse struct {
+	Holidays []HolidayResponse `json:"holidays"`
+}
+
+func ToHolidayResponse(holiday *entity.Holiday) *HolidayResponse {
+	return &HolidayResponse{
+		Id:        holiday.Id,
+		Date:      holiday.Date,
+		Name:      holiday.Name,
+		CreatedAt: holiday.CreatedAt,
+		UpdatedAt: holiday.UpdatedAt,
+	}
+}
+
+func ToHolidaysResponse(holidays []*entity.Holiday) *HolidaysResponse {
+	response := &HolidaysResponse{
+		Holidays: make([]HolidayResponse, len(holidays)),
+	}
+	for i, holiday := range holidays {
+		response.Holidays[i] = *ToHolidayResponse(holiday)
+	}
+	return response
+}
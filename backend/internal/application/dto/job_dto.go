@@ -0,0 +1,67 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type JobRunResponse struct {
+	Id          int        `json:"id"`
+	JobName     string     `json:"job_name"`
+	Trigger     string     `json:"trigger"`
+	ScheduledAt *time.Time `json:"scheduled_at,omitempty"`
+	TriggeredBy *int       `json:"triggered_by,omitempty"`
+	Instance    string     `json:"instance"`
+	Status      string     `json:"status"`
+	Output      string     `json:"output"`
+	Error       string     `json:"error,omitempty"`
+	StartedAt   time.Time  `json:"started_at"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty"`
+	DurationMs  int64      `json:"duration_ms"`
+}
+
+type JobRunsResponse struct {
+	Runs []JobRunResponse `json:"runs"`
+}
+
+type JobResponse struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Schedule    string          `json:"schedule"` // empty for manual-only jobs
+	NextRunAt   *time.Time      `json:"next_run_at,omitempty"`
+	LastRun     *JobRunResponse `json:"last_run,omitempty"`
+}
+
+type JobsResponse struct {
+	// SchedulerEnabled is false when this instance only runs jobs on demand
+	SchedulerEnabled bool          `json:"scheduler_enabled"`
+	Jobs             []JobResponse `json:"jobs"`
+}
+
+func ToJobRunResponse(run *entity.JobRun) *JobRunResponse {
+	return &JobRunResponse{
+		Id:          run.Id,
+		JobName:     run.JobName,
+		Trigger:     string(run.Trigger),
+		ScheduledAt: run.ScheduledAt,
+		TriggeredBy: run.TriggeredBy,
+		Instance:    run.Instance,
+		Status:      string(run.Status),
+		Output:      run.Output,
+		Error:       run.Error,
+		StartedAt:   run.StartedAt,
+		FinishedAt:  run.FinishedAt,
+		DurationMs:  run.Duration(time.Now()).Milliseconds(),
+	}
+}
+
+func ToJobRunsResponse(runs []*entity.JobRun) *JobRunsResponse {
+	response := &JobRunsResponse{
+		Runs: make([]JobRunResponse, len(runs)),
+	}
+	for i, run := range runs {
+		response.Runs[i] = *ToJobRunResponse(run)
+	}
+	return response
+}
@@ -0,0 +1,83 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type NotificationChannelResponse struct {
+	Id        int       `json:"id"`
+	Name      string    `json:"name"`
+	Type      string    `json:"type"`
+	Target    string    `json:"target"`
+	Enabled   bool      `json:"enabled"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type NotificationChannelsResponse struct {
+	Channels []NotificationChannelResponse `json:"channels"`
+}
+
+type NotificationEventResponse struct {
+	Event       string `json:"event"`
+	Description string `json:"description"`
+	// Fallback tells whether the event goes to the default Slack webhook while ChannelIds is empty
+	Fallback   bool  `json:"fallback"`
+	ChannelIds []int `json:"channel_ids"`
+	// DepartmentChannelIds lists the channels that only get notifications about users of a department
+	DepartmentChannelIds map[string][]int `json:"department_channel_ids"`
+}
+
+type NotificationEventsResponse struct {
+	// DefaultChannel tells whether SLACK_WEBHOOK_URL is set
+	DefaultChannel bool                        `json:"default_channel"`
+	Events         []NotificationEventResponse `json:"events"`
+}
+
+type NotificationPreferenceResponse struct {
+	Event       string `json:"event"`
+	Description string `json:"description"`
+	Channel     string `json:"channel"`
+	OptOut      bool   `json:"opt_out"`
+	QuietStart  string `json:"quiet_start"`
+	QuietEnd    string `json:"quiet_end"`
+}
+
+type NotificationPreferencesResponse struct {
+	Preferences []NotificationPreferenceResponse `json:"preferences"`
+}
+
+func ToNotificationPreferenceResponse(preference *entity.NotificationPreference, description string) *NotificationPreferenceResponse {
+	return &NotificationPreferenceResponse{
+		Event:       string(preference.Event),
+		Description: description,
+		Channel:     string(preference.Channel),
+		OptOut:      preference.OptOut,
+		QuietStart:  preference.QuietStart,
+		QuietEnd:    preference.QuietEnd,
+	}
+}
+
+func ToNotificationChannelResponse(channel *entity.NotificationChannel) *NotificationChannelResponse {
+	return &NotificationChannelResponse{
+		Id:        channel.Id,
+		Name:      channel.Name,
+		Type:      string(channel.Type),
+		Target:    channel.Target,
+		Enabled:   channel.Enabled,
+		CreatedAt: channel.CreatedAt,
+		UpdatedAt: channel.UpdatedAt,
+	}
+}
+
+func ToNotificationChannelsResponse(channels []*entity.NotificationChannel) *NotificationChannelsResponse {
+	response := &NotificationChannelsResponse{
+		Channels: make([]NotificationChannelResponse, len(channels)),
+	}
+	for i, channel := range channels {
+		response.Channels[i] = *ToNotificationChannelResponse(channel)
+	}
+	return response
+}
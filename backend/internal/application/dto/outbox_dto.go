@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type OutboxMessageResponse struct {
+	Id             int             `json:"id"`
+	IdempotencyKey string          `json:"idempotency_key"`
+	Kind           string          `json:"kind"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  time.Time       `json:"next_attempt_at"`
+	LastError      string          `json:"last_error,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	SentAt         *time.Time      `json:"sent_at,omitempty"`
+}
+
+func ToOutboxMessageResponse(message *entity.OutboxMessage) *OutboxMessageResponse {
+	return &OutboxMessageResponse{
+		Id:             message.Id,
+		IdempotencyKey: message.IdempotencyKey,
+		Kind:           message.Kind,
+		Payload:        json.RawMessage(message.Payload),
+		Status:         string(message.Status),
+		Attempts:       message.Attempts,
+		NextAttemptAt:  message.NextAttemptAt,
+		LastError:      message.LastError,
+		CreatedAt:      message.CreatedAt,
+		SentAt:         message.SentAt,
+	}
+}
+
+func ToOutboxMessageResponses(messages []*entity.OutboxMessage) []OutboxMessageResponse {
+	responses := make([]OutboxMessageResponse, len(messages))
+	for i, message := range messages {
+		responses[i] = *ToOutboxMessageResponse(message)
+	}
+	return responses
+}
@@ -0,0 +1,142 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type PayrollRunResponse struct {
+	ID           int                       `json:"id"`
+	Month        string                    `json:"month"`
+	Status       string                    `json:"status"`
+	TotalPayroll int                       `json:"totalPayroll"`
+	CreatedBy    int                       `json:"createdBy"`
+	FinalizedBy  *int                      `json:"finalizedBy,omitempty"`
+	FinalizedAt  *time.Time                `json:"finalizedAt,omitempty"`
+	ReopenedBy   *int                      `json:"reopenedBy,omitempty"`
+	ReopenedAt   *time.Time                `json:"reopenedAt,omitempty"`
+	ReopenReason string                    `json:"reopenReason,omitempty"`
+	CreatedAt    time.Time                 `json:"createdAt"`
+	UpdatedAt    time.Time                 `json:"updatedAt"`
+	PayrollData  []PayrollEmployee         `json:"payrollData,omitempty"`
+	Audits       []PayrollRunAuditResponse `json:"audits,omitempty"`
+}
+
+type PayrollRunAuditResponse struct {
+	Action    string    `json:"action"`
+	ActorID   int       `json:"actorId"`
+	Reason    string    `json:"reason,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// PayslipResponse is one employee's payslip (給与明細) within a payroll run
+type PayslipResponse struct {
+	RunID              int           `json:"runId"`
+	Month              string        `json:"month"`
+	Status             string        `json:"status"`
+	UserID             int           `json:"userId"`
+	Name               string        `json:"name"`
+	PayType            string        `json:"payType"`
+	PayRate            int           `json:"payRate"`
+	TotalHours         float64       `json:"totalHours"`
+	OvertimeHours      float64       `json:"overtimeHours"`
+	WorkedDays         int           `json:"workedDays"`
+	WorkingDays        int           `json:"workingDays"`
+	AbsenceDays        int           `json:"absenceDays"`
+	LateEarlyMinutes   int           `json:"lateEarlyMinutes"`
+	Earnings           []PayrollItem `json:"earnings"`
+	Deductions         []PayrollItem `json:"deductions"`
+	TaxableEarnings    int           `json:"taxableEarnings"`
+	NonTaxableEarnings int           `json:"nonTaxableEarnings"`
+	TotalDeductions    int           `json:"totalDeductions"`
+	NetPay             int           `json:"netPay"`
+}
+
+type PayrollRunsResponse struct {
+	Runs []PayrollRunResponse `json:"runs"`
+}
+
+func ToPayrollRunResponse(run *entity.PayrollRun) *PayrollRunResponse {
+	return &PayrollRunResponse{
+		ID:           run.Id,
+		Month:        run.Month,
+		Status:       string(run.Status),
+		TotalPayroll: run.TotalPayroll,
+		CreatedBy:    run.CreatedBy,
+		FinalizedBy:  run.FinalizedBy,
+		FinalizedAt:  run.FinalizedAt,
+		ReopenedBy:   run.ReopenedBy,
+		ReopenedAt:   run.ReopenedAt,
+		ReopenReason: run.ReopenReason,
+		CreatedAt:    run.CreatedAt,
+		UpdatedAt:    run.UpdatedAt,
+	}
+}
+
+// ToPayrollRunDetailResponse includes the run's lines and audit trail.
+// For draft runs the lines are live figures and TotalPayroll is recomputed from them.
+func ToPayrollRunDetailResponse(run *entity.PayrollRun, lines []*entity.PayrollLine, audits []*entity.PayrollRunAudit) *PayrollRunResponse {
+	response := ToPayrollRunResponse(run)
+
+	payroll := ToPayrollResponse(lines)
+	response.PayrollData = payroll.PayrollData
+	if run.IsDraft() {
+		response.TotalPayroll = payroll.TotalPayroll
+	}
+
+	response.Audits = make([]PayrollRunAuditResponse, len(audits))
+	for i, audit := range audits {
+		response.Audits[i] = PayrollRunAuditResponse{
+			Action:    string(audit.Action),
+			ActorID:   audit.ActorId,
+			Reason:    audit.Reason,
+			CreatedAt: audit.CreatedAt,
+		}
+	}
+
+	return response
+}
+
+func ToPayrollRunsResponse(runs []*entity.PayrollRun) *PayrollRunsResponse {
+	response := &PayrollRunsResponse{
+		Runs: make([]PayrollRunResponse, len(runs)),
+	}
+	for i, run := range runs {
+		response.Runs[i] = *ToPayrollRunResponse(run)
+	}
+	return response
+}
+
+func ToPayslipResponse(run *entity.PayrollRun, line *entity.PayrollLine) *PayslipResponse {
+	taxable, nonTaxable := line.Earnings()
+	response := &PayslipResponse{
+		RunID:              run.Id,
+		Month:              run.Month,
+		Status:             string(run.Status),
+		UserID:             line.UserId,
+		Name:               line.UserName,
+		PayType:            string(line.PayType),
+		PayRate:            line.PayRate,
+		TotalHours:         line.TotalHours,
+		OvertimeHours:      line.OvertimeHours,
+		WorkedDays:         line.WorkedDays,
+		WorkingDays:        line.WorkingDays,
+		AbsenceDays:        line.AbsenceDays,
+		LateEarlyMinutes:   line.LateEarlyMinutes,
+		Earnings:           []PayrollItem{},
+		Deductions:         []PayrollItem{},
+		TaxableEarnings:    taxable,
+		NonTaxableEarnings: nonTaxable,
+		TotalDeductions:    line.Deductions(),
+		NetPay:             line.TotalSalary,
+	}
+	for _, item := range line.Items {
+		if item.Kind == entity.PayrollItemKindDeduction {
+			response.Deductions = append(response.Deductions, ToPayrollItem(item))
+		} else {
+			response.Earnings = append(response.Earnings, ToPayrollItem(item))
+		}
+	}
+	return response
+}
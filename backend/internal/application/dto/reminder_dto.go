@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type MissingEntryResponse struct {
+	UserId            int    `json:"user_id"`
+	UserName          string `json:"user_name"`
+	Department        string `json:"department"`
+	MissingAttendance bool   `json:"missing_attendance"`
+	MissingReport     bool   `json:"missing_report"`
+}
+
+type MissingEntriesResponse struct {
+	Date    time.Time              `json:"date"`
+	Cutoff  string                 `json:"cutoff"`
+	Entries []MissingEntryResponse `json:"entries"`
+	// Notified is the number of users reminded; omitted when only listing
+	Notified *int `json:"notified,omitempty"`
+	// Previous is the previous day, which is checked again when reminders are sent for today
+	// without a date or cutoff, so that shifts ending after its last run are reminded
+	Previous *MissingEntriesResponse `json:"previous,omitempty"`
+}
+
+func ToMissingEntriesResponse(date time.Time, cutoff string, entries []*entity.MissingEntry) *MissingEntriesResponse {
+	response := &MissingEntriesResponse{
+		Date:    date,
+		Cutoff:  cutoff,
+		Entries: make([]MissingEntryResponse, len(entries)),
+	}
+	for i, entry := range entries {
+		response.Entries[i] = MissingEntryResponse{
+			UserId:            entry.User.Id,
+			UserName:          entry.User.Name,
+			Department:        entry.User.Department,
+			MissingAttendance: entry.MissingAttendance,
+			MissingReport:     entry.MissingReport,
+		}
+	}
+	return response
+}
@@ -0,0 +1,101 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type ReportCommentResponse struct {
+	Id        int       `json:"id"`
+	ReportId  int       `json:"report_id"`
+	UserId    int       `json:"user_id"`
+	UserName  string    `json:"user_name"`
+	ParentId  *int      `json:"parent_id,omitempty"`
+	Body      string    `json:"body"`
+	Edited    bool      `json:"edited"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	// Replies are only set on top-level comments, oldest first
+	Replies []ReportCommentResponse `json:"replies,omitempty"`
+}
+
+// ReportCommentsResponse lists the top-level comments of a report with their replies
+type ReportCommentsResponse struct {
+	Comments []ReportCommentResponse `json:"comments"`
+	Total    int                     `json:"total"` // comments and replies
+}
+
+func ToReportCommentResponse(comment *entity.ReportComment) *ReportCommentResponse {
+	return &ReportCommentResponse{
+		Id:        comment.Id,
+		ReportId:  comment.ReportId,
+		UserId:    comment.UserId,
+		UserName:  comment.UserName,
+		ParentId:  comment.ParentId,
+		Body:      comment.Body,
+		Edited:    comment.UpdatedAt.Sub(comment.CreatedAt) > time.Second,
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+	}
+}
+
+// ToReportCommentsResponse nests replies under their parent; comments must be sorted oldest first
+func ToReportCommentsResponse(comments []*entity.ReportComment) *ReportCommentsResponse {
+	response := &ReportCommentsResponse{
+		Comments: []ReportCommentResponse{},
+		Total:    len(comments),
+	}
+	positions := make(map[int]int)
+	for _, comment := range comments {
+		if comment.IsReply() {
+			continue
+		}
+		positions[comment.Id] = len(response.Comments)
+		response.Comments = append(response.Comments, *ToReportCommentResponse(comment))
+	}
+	for _, comment := range comments {
+		if !comment.IsReply() {
+			continue
+		}
+		if i, ok := positions[*comment.ParentId]; ok {
+			response.Comments[i].Replies = append(response.Comments[i].Replies, *ToReportCommentResponse(comment))
+		}
+	}
+	return response
+}
+
+// ReactionSummaryResponse groups the reactions with one emoji
+type ReactionSummaryResponse struct {
+	Emoji   string   `json:"emoji"`
+	Count   int      `json:"count"`
+	Reacted bool     `json:"reacted"` // whether the current user is among them
+	Users   []string `json:"users"`
+}
+
+type ReportReactionsResponse struct {
+	Reactions []ReactionSummaryResponse `json:"reactions"`
+}
+
+// ToReportReactionsResponse groups reactions by emoji in the order each emoji was first used
+func ToReportReactionsResponse(reactions []*entity.ReportReaction, userID int) *ReportReactionsResponse {
+	response := &ReportReactionsResponse{
+		Reactions: []ReactionSummaryResponse{},
+	}
+	positions := make(map[string]int)
+	for _, reaction := range reactions {
+		i, ok := positions[reaction.Emoji]
+		if !ok {
+			i = len(response.Reactions)
+			positions[reaction.Emoji] = i
+			response.Reactions = append(response.Reactions, ReactionSummaryResponse{Emoji: reaction.Emoji, Users: []string{}})
+		}
+		summary := &response.Reactions[i]
+		summary.Count++
+		summary.Users = append(summary.Users, reaction.UserName)
+		if reaction.UserId == userID {
+			summary.Reacted = true
+		}
+	}
+	return response
+}
@@ -0,0 +1,59 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type DigestDayResponse struct {
+	Date        time.Time            `json:"date"`
+	WorkMinutes int                  `json:"work_minutes"`
+	Report      *DailyReportResponse `json:"report,omitempty"`
+}
+
+type ReportDigestResponse struct {
+	UserId           int                 `json:"user_id"`
+	UserName         string              `json:"user_name"`
+	Department       string              `json:"department"`
+	Period           string              `json:"period"`
+	StartDate        time.Time           `json:"start_date"`
+	EndDate          time.Time           `json:"end_date"`
+	WorkedDays       int                 `json:"worked_days"`
+	TotalWorkMinutes int                 `json:"total_work_minutes"`
+	ReportCount      int                 `json:"report_count"`
+	Days             []DigestDayResponse `json:"days"`
+	TopTags          []TagCountResponse  `json:"top_tags"`
+	// Rendered holds the Markdown or HTML document when that format was requested. The HTML
+	// document only frames the reports: their Markdown is escaped and kept as written, not rendered.
+	Rendered string `json:"rendered,omitempty"`
+}
+
+type PostReportDigestsResponse struct {
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	Posted    int       `json:"posted"` // users whose digest was posted
+}
+
+func ToReportDigestResponse(digest *entity.ReportDigest) *ReportDigestResponse {
+	response := &ReportDigestResponse{
+		UserId:           digest.UserId,
+		UserName:         digest.UserName,
+		Department:       digest.Department,
+		Period:           string(digest.Period),
+		StartDate:        digest.StartDate,
+		EndDate:          digest.EndDate,
+		WorkedDays:       digest.WorkedDays(),
+		TotalWorkMinutes: digest.TotalWorkMinutes(),
+		ReportCount:      digest.ReportCount(),
+		Days:             make([]DigestDayResponse, len(digest.Days)),
+		TopTags:          ToTagCloudResponse(digest.TopTags).Tags,
+	}
+	for i, day := range digest.Days {
+		response.Days[i] = DigestDayResponse{Date: day.Date, WorkMinutes: day.WorkMinutes}
+		if day.Report != nil {
+			response.Days[i].Report = ToDailyReportResponse(day.Report)
+		}
+	}
+	return response
+}
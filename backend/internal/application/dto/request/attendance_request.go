@@ -7,7 +7,8 @@ type CreateAttendanceRequest struct {
 	StartTime    string `json:"start_time"` // ISO 8601 format
 	EndTime      string `json:"end_time"`   // ISO 8601 format
 	BreakMinutes int    `json:"break_minutes"`
-	Report       string `json:"report"`
+	// Report is published as the body of the day's daily report; optional, since reports can also be written separately
+	Report string `json:"report,omitempty"`
 }
 
 func (c *CreateAttendanceRequest) Validate() error {
@@ -23,9 +24,6 @@ func (c *CreateAttendanceRequest) Validate() error {
 	if c.BreakMinutes < 0 {
 		return errors.New("break minutes cannot be negative")
 	}
-	if c.Report == "" {
-		return errors.New("report cannot be empty")
-	}
 	return nil
 }
 
@@ -0,0 +1,98 @@
+package request
+
+import "errors"
+
+type CreateAllowanceRequest struct {
+	Name          string `json:"name"`
+	Taxable       *bool  `json:"taxable"` // defaults to true
+	DefaultAmount int    `json:"default_amount"`
+}
+
+func (c *CreateAllowanceRequest) Validate() error {
+	if c.Name == "" {
+		return errors.New("name cannot be empty")
+	}
+	if len(c.Name) > 255 {
+		return errors.New("name must be 255 characters or less")
+	}
+	if c.DefaultAmount < 0 {
+		return errors.New("default amount cannot be negative")
+	}
+	return nil
+}
+
+type UpdateAllowanceRequest struct {
+	Name          *string `json:"name,omitempty"`
+	Taxable       *bool   `json:"taxable,omitempty"`
+	DefaultAmount *int    `json:"default_amount,omitempty"`
+}
+
+func (u *UpdateAllowanceRequest) Validate() error {
+	if u.Name != nil && *u.Name == "" {
+		return errors.New("name cannot be empty")
+	}
+	if u.DefaultAmount != nil && *u.DefaultAmount < 0 {
+		return errors.New("default amount cannot be negative")
+	}
+	return nil
+}
+
+type AssignAllowanceRequest struct {
+	AllowanceId   int     `json:"allowance_id"`
+	Amount        *int    `json:"amount,omitempty"` // defaults to the allowance's default amount
+	EffectiveFrom string  `json:"effective_from"`   // YYYY-MM-DD format
+	EffectiveTo   *string `json:"effective_to,omitempty"`
+}
+
+func (a *AssignAllowanceRequest) Validate() error {
+	if a.AllowanceId <= 0 {
+		return errors.New("allowance ID is required")
+	}
+	if a.Amount != nil && *a.Amount < 0 {
+		return errors.New("amount cannot be negative")
+	}
+	if a.EffectiveFrom == "" {
+		return errors.New("effective from date cannot be empty")
+	}
+	return nil
+}
+
+type UpdateUserAllowanceRequest struct {
+	Amount        *int    `json:"amount,omitempty"`
+	EffectiveFrom *string `json:"effective_from,omitempty"`
+	EffectiveTo   *string `json:"effective_to,omitempty"` // an empty string makes the assignment open-ended
+}
+
+func (u *UpdateUserAllowanceRequest) Validate() error {
+	if u.Amount != nil && *u.Amount < 0 {
+		return errors.New("amount cannot be negative")
+	}
+	if u.EffectiveFrom != nil && *u.EffectiveFrom == "" {
+		return errors.New("effective from date cannot be empty")
+	}
+	return nil
+}
+
+type CreateBonusRequest struct {
+	UserId      int    `json:"user_id"`
+	Name        string `json:"name"`
+	Amount      int    `json:"amount"`
+	PaymentDate string `json:"payment_date"` // YYYY-MM-DD format
+	Taxable     *bool  `json:"taxable"`      // defaults to true
+}
+
+func (c *CreateBonusRequest) Validate() error {
+	if c.UserId <= 0 {
+		return errors.New("user ID is required")
+	}
+	if c.Name == "" {
+		return errors.New("name cannot be empty")
+	}
+	if c.Amount <= 0 {
+		return errors.New("amount must be greater than zero")
+	}
+	if c.PaymentDate == "" {
+		return errors.New("payment date cannot be empty")
+	}
+	return nil
+}
@@ -1,6 +1,222 @@
 package request
 
-// GetDailyReportsRequest represents the request for getting daily reports
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	DefaultDailyReportsPerPage = 20
+	MaxDailyReportsPerPage     = 100
+)
+
+// GetDailyReportsRequest represents the query parameters for listing daily reports
 type GetDailyReportsRequest struct {
-	// No specific parameters as API returns all reports sorted by date descending
-}
\ No newline at end of file
+	Page       int    `form:"page"`
+	PerPage    int    `form:"per_page"`
+	UserId     int    `form:"user_id"`
+	Department string `form:"department"`
+	From       string `form:"from"` // YYYY-MM-DD format, inclusive
+	To         string `form:"to"`   // YYYY-MM-DD format, inclusive
+	Keyword    string `form:"keyword"`
+	// Status filters the user's own reports (DRAFT or PUBLISHED); other users' drafts are never listed
+	Status string `form:"status"`
+	// Unread keeps only reports by others that the caller has not read yet
+	Unread bool `form:"unread"`
+	// Tag keeps only reports with the hashtag; the leading # is optional
+	Tag string `form:"tag"`
+}
+
+// Validate checks the parameters and fills in the paging defaults
+func (g *GetDailyReportsRequest) Validate() error {
+	if g.Page < 0 {
+		return errors.New("page must be greater than zero")
+	}
+	if g.PerPage < 0 {
+		return errors.New("per_page must be greater than zero")
+	}
+	if g.PerPage > MaxDailyReportsPerPage {
+		return fmt.Errorf("per_page cannot exceed %d", MaxDailyReportsPerPage)
+	}
+	if g.UserId < 0 {
+		return errors.New("invalid user id")
+	}
+	if g.From != "" {
+		if _, err := time.Parse("2006-01-02", g.From); err != nil {
+			return errors.New("invalid from date format")
+		}
+	}
+	if g.To != "" {
+		if _, err := time.Parse("2006-01-02", g.To); err != nil {
+			return errors.New("invalid to date format")
+		}
+	}
+	if g.Status != "" && g.Status != "DRAFT" && g.Status != "PUBLISHED" {
+		return errors.New("invalid status")
+	}
+	if g.Page == 0 {
+		g.Page = 1
+	}
+	if g.PerPage == 0 {
+		g.PerPage = DefaultDailyReportsPerPage
+	}
+	return nil
+}
+
+// MaxSearchQueryLength limits the search query in characters
+const MaxSearchQueryLength = 100
+
+// SearchDailyReportsRequest represents the query parameters for full-text search
+type SearchDailyReportsRequest struct {
+	Q       string `form:"q"`
+	Page    int    `form:"page"`
+	PerPage int    `form:"per_page"`
+}
+
+// Validate checks the parameters and fills in the paging defaults
+func (s *SearchDailyReportsRequest) Validate() error {
+	if strings.TrimSpace(s.Q) == "" {
+		return errors.New("search query cannot be empty")
+	}
+	if utf8.RuneCountInString(s.Q) > MaxSearchQueryLength {
+		return fmt.Errorf("search query cannot exceed %d characters", MaxSearchQueryLength)
+	}
+	if s.Page < 0 {
+		return errors.New("page must be greater than zero")
+	}
+	if s.PerPage < 0 {
+		return errors.New("per_page must be greater than zero")
+	}
+	if s.PerPage > MaxDailyReportsPerPage {
+		return fmt.Errorf("per_page cannot exceed %d", MaxDailyReportsPerPage)
+	}
+	if s.Page == 0 {
+		s.Page = 1
+	}
+	if s.PerPage == 0 {
+		s.PerPage = DefaultDailyReportsPerPage
+	}
+	return nil
+}
+
+const (
+	DefaultTagCloudLimit = 50
+	MaxTagCloudLimit     = 200
+)
+
+// GetTagCloudRequest represents the query parameters for the tag cloud
+type GetTagCloudRequest struct {
+	From       string `form:"from"` // YYYY-MM-DD format, inclusive
+	To         string `form:"to"`   // YYYY-MM-DD format, inclusive
+	Department string `form:"department"`
+	Limit      int    `form:"limit"`
+}
+
+// Validate checks the parameters and fills in the default limit
+func (g *GetTagCloudRequest) Validate() error {
+	if g.From != "" {
+		if _, err := time.Parse("2006-01-02", g.From); err != nil {
+			return errors.New("invalid from date format")
+		}
+	}
+	if g.To != "" {
+		if _, err := time.Parse("2006-01-02", g.To); err != nil {
+			return errors.New("invalid to date format")
+		}
+	}
+	if g.Limit < 0 {
+		return errors.New("limit must be greater than zero")
+	}
+	if g.Limit > MaxTagCloudLimit {
+		return fmt.Errorf("limit cannot exceed %d", MaxTagCloudLimit)
+	}
+	if g.Limit == 0 {
+		g.Limit = DefaultTagCloudLimit
+	}
+	return nil
+}
+
+type ReportSectionRequest struct {
+	Heading string `json:"heading"`
+	Body    string `json:"body"`
+}
+
+type CreateDailyReportRequest struct {
+	Date       string                 `json:"date"` // YYYY-MM-DD format
+	TemplateId *int                   `json:"template_id,omitempty"`
+	Sections   []ReportSectionRequest `json:"sections,omitempty"`
+	Body       string                 `json:"body"`             // Markdown
+	Status     string                 `json:"status,omitempty"` // DRAFT (default) or PUBLISHED
+	// Visibility is COMPANY (default), DEPARTMENT, MANAGERS or PRIVATE
+	Visibility string `json:"visibility,omitempty"`
+}
+
+func (c *CreateDailyReportRequest) Validate() error {
+	if c.Date == "" {
+		return errors.New("date cannot be empty")
+	}
+	if c.Status == "" {
+		c.Status = "DRAFT"
+	}
+	if c.Visibility == "" {
+		c.Visibility = "COMPANY"
+	}
+	return nil
+}
+
+// UpdateDailyReportRequest replaces the given parts of a report. Sections must keep their headings.
+type UpdateDailyReportRequest struct {
+	Sections   []ReportSectionRequest `json:"sections,omitempty"`
+	Body       *string                `json:"body,omitempty"`
+	Status     *string                `json:"status,omitempty"` // only DRAFT -> PUBLISHED is allowed
+	Visibility *string                `json:"visibility,omitempty"`
+}
+
+func (u *UpdateDailyReportRequest) Validate() error {
+	if u.Sections == nil && u.Body == nil && u.Status == nil && u.Visibility == nil {
+		return errors.New("nothing to update")
+	}
+	if u.Status != nil && *u.Status == "" {
+		return errors.New("status cannot be empty")
+	}
+	return nil
+}
+
+type TemplateSectionRequest struct {
+	Heading  string `json:"heading"`
+	Hint     string `json:"hint,omitempty"`
+	Required bool   `json:"required"`
+}
+
+type CreateReportTemplateRequest struct {
+	Name        string                   `json:"name"`
+	Description string                   `json:"description,omitempty"`
+	Sections    []TemplateSectionRequest `json:"sections"`
+}
+
+func (c *CreateReportTemplateRequest) Validate() error {
+	if c.Name == "" {
+		return errors.New("name cannot be empty")
+	}
+	if len(c.Sections) == 0 {
+		return errors.New("sections cannot be empty")
+	}
+	return nil
+}
+
+type UpdateReportTemplateRequest struct {
+	Name        *string                  `json:"name,omitempty"`
+	Description *string                  `json:"description,omitempty"`
+	Sections    []TemplateSectionRequest `json:"sections,omitempty"`
+	Active      *bool                    `json:"active,omitempty"`
+}
+
+func (u *UpdateReportTemplateRequest) Validate() error {
+	if u.Name != nil && *u.Name == "" {
+		return errors.New("name cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package request
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// MaxEarningsMonths limits the range of a single earnings query
+const MaxEarningsMonths = 24
+
+// GetEarningsRequest represents the query parameters for the user's own earnings
+type GetEarningsRequest struct {
+	From string `form:"from"` // YYYY-MM, defaults to the current month
+	To   string `form:"to"`   // YYYY-MM, inclusive; defaults to the current month
+}
+
+func (g *GetEarningsRequest) Validate() error {
+	currentMonth := time.Now().Format("2006-01")
+	if g.From == "" {
+		g.From = currentMonth
+	}
+	if g.To == "" {
+		g.To = currentMonth
+	}
+	from, err := time.Parse("2006-01", g.From)
+	if err != nil {
+		return errors.New("invalid from month format")
+	}
+	to, err := time.Parse("2006-01", g.To)
+	if err != nil {
+		return errors.New("invalid to month format")
+	}
+	if to.Before(from) {
+		return errors.New("from month must not be after to month")
+	}
+	if from.AddDate(0, MaxEarningsMonths, 0).Before(to.AddDate(0, 1, 0)) {
+		return fmt.Errorf("period cannot exceed %d months", MaxEarningsMonths)
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package request
+
+import "errors"
+
+type SetGoalRequest struct {
+	Type   string `json:"type"`   // HOURS or INCOME
+	Period string `json:"period"` // MONTH or YEAR
+	Target int    `json:"target"` // hours or yen
+}
+
+func (s *SetGoalRequest) Validate() error {
+	if s.Type == "" {
+		return errors.New("type cannot be empty")
+	}
+	if s.Period == "" {
+		return errors.New("period cannot be empty")
+	}
+	if s.Target < 0 {
+		return errors.New("target cannot be negative")
+	}
+	return nil
+}
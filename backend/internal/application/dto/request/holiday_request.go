@@ -0,0 +1,21 @@
+package request
+
+import "errors"
+
+type CreateHolidayRequest struct {
+	Date string `json:"date"` // YYYY-MM-DD format
+	Name string `json:"name"`
+}
+
+func (c *CreateHolidayRequest) Validate() error {
+	if c.Date == "" {
+		return errors.New("date cannot be empty")
+	}
+	if c.Name == "" {
+		return errors.New("name cannot be empty")
+	}
+	if len(c.Name) > 255 {
+		return errors.New("name must be 255 characters or less")
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package request
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type CreateNotificationChannelRequest struct {
+	Name string `json:"name"`
+	// Type is SLACK, TEAMS, DISCORD or EMAIL
+	Type string `json:"type"`
+	// Target is the webhook URL, or comma-separated email addresses for EMAIL channels
+	Target  string `json:"target"`
+	Enabled *bool  `json:"enabled,omitempty"` // true by default
+}
+
+func (c *CreateNotificationChannelRequest) Validate() error {
+	if strings.TrimSpace(c.Name) == "" {
+		return errors.New("name cannot be empty")
+	}
+	if err := entity.NotificationChannelType(c.Type).Validate(); err != nil {
+		return err
+	}
+	if strings.TrimSpace(c.Target) == "" {
+		return errors.New("target cannot be empty")
+	}
+	return nil
+}
+
+type UpdateNotificationChannelRequest struct {
+	Name    *string `json:"name,omitempty"`
+	Type    *string `json:"type,omitempty"`
+	Target  *string `json:"target,omitempty"`
+	Enabled *bool   `json:"enabled,omitempty"`
+}
+
+func (u *UpdateNotificationChannelRequest) Validate() error {
+	if u.Name != nil && strings.TrimSpace(*u.Name) == "" {
+		return errors.New("name cannot be empty")
+	}
+	if u.Type != nil {
+		if err := entity.NotificationChannelType(*u.Type).Validate(); err != nil {
+			return err
+		}
+	}
+	if u.Target != nil && strings.TrimSpace(*u.Target) == "" {
+		return errors.New("target cannot be empty")
+	}
+	return nil
+}
+
+// SetNotificationRoutesRequest lists the channels an event is sent to; without any channel
+// the event falls back to the default Slack webhook if it has one
+type SetNotificationRoutesRequest struct {
+	ChannelIds []int `json:"channel_ids"`
+	// DepartmentChannelIds sends notifications about users of a department to these channels
+	// as well, e.g. {"営業": [3]}
+	DepartmentChannelIds map[string][]int `json:"department_channel_ids,omitempty"`
+}
+
+func (s *SetNotificationRoutesRequest) Validate() error {
+	if err := validateChannelIds(s.ChannelIds); err != nil {
+		return err
+	}
+	for department, channelIds := range s.DepartmentChannelIds {
+		if strings.TrimSpace(department) == "" {
+			return errors.New("department cannot be empty")
+		}
+		if err := validateChannelIds(channelIds); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateChannelIds(channelIds []int) error {
+	seen := make(map[int]bool, len(channelIds))
+	for _, id := range channelIds {
+		if id <= 0 {
+			return errors.New("invalid channel id")
+		}
+		if seen[id] {
+			return errors.New("duplicate channel id")
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+// UpdateNotificationPreferenceRequest replaces the user's setting for one event
+type UpdateNotificationPreferenceRequest struct {
+	// Channel is DEFAULT (the channels admins chose), EMAIL or SLACK_DM; empty means DEFAULT.
+	// SLACK_DM needs a linked Slack account and acts like DEFAULT without one.
+	Channel string `json:"channel"`
+	OptOut  bool   `json:"opt_out"`
+	// QuietStart and QuietEnd ("HH:MM") are both set or both empty
+	QuietStart string `json:"quiet_start"`
+	QuietEnd   string `json:"quiet_end"`
+}
+
+func (u *UpdateNotificationPreferenceRequest) Validate() error {
+	if u.Channel != "" {
+		if err := entity.PreferredChannel(u.Channel).Validate(); err != nil {
+			return err
+		}
+	}
+	if (u.QuietStart == "") != (u.QuietEnd == "") {
+		return errors.New("quiet_start and quiet_end must be set together")
+	}
+	if u.QuietStart == "" {
+		return nil
+	}
+	start, err := entity.ParseClock(u.QuietStart)
+	if err != nil {
+		return errors.New("quiet_start must be HH:MM")
+	}
+	end, err := entity.ParseClock(u.QuietEnd)
+	if err != nil {
+		return errors.New("quiet_end must be HH:MM")
+	}
+	if start == end {
+		return errors.New("quiet hours must not start and end at the same time")
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package request
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+// Paging limits for the outbox messages
+const (
+	DefaultOutboxMessagesPerPage = 20
+	MaxOutboxMessagesPerPage     = 100
+)
+
+// GetOutboxMessagesRequest represents the query parameters for listing outbox messages
+type GetOutboxMessagesRequest struct {
+	// Status is PENDING, SENT or DEAD; DEAD by default
+	Status  string `form:"status"`
+	Page    int    `form:"page"`
+	PerPage int    `form:"per_page"`
+}
+
+// Validate checks the parameters and fills in the defaults
+func (g *GetOutboxMessagesRequest) Validate() error {
+	if g.Status == "" {
+		g.Status = string(entity.OutboxStatusDead)
+	}
+	if err := entity.OutboxStatus(g.Status).Validate(); err != nil {
+		return err
+	}
+	if g.Page < 0 {
+		return errors.New("page must be greater than zero")
+	}
+	if g.PerPage < 0 {
+		return errors.New("per_page must be greater than zero")
+	}
+	if g.PerPage > MaxOutboxMessagesPerPage {
+		return fmt.Errorf("per_page cannot exceed %d", MaxOutboxMessagesPerPage)
+	}
+	if g.Page == 0 {
+		g.Page = 1
+	}
+	if g.PerPage == 0 {
+		g.PerPage = DefaultOutboxMessagesPerPage
+	}
+	return nil
+}
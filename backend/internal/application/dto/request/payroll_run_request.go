@@ -0,0 +1,28 @@
+package request
+
+import "errors"
+
+type CreatePayrollRunRequest struct {
+	Month string `json:"month"` // YYYY-MM format
+}
+
+func (c *CreatePayrollRunRequest) Validate() error {
+	if c.Month == "" {
+		return errors.New("month cannot be empty")
+	}
+	return nil
+}
+
+type ReopenPayrollRunRequest struct {
+	Reason string `json:"reason"`
+}
+
+func (r *ReopenPayrollRunRequest) Validate() error {
+	if r.Reason == "" {
+		return errors.New("reason cannot be empty")
+	}
+	if len(r.Reason) > 500 {
+		return errors.New("reason must be 500 characters or less")
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package request
+
+import (
+	"errors"
+	"time"
+)
+
+// RemindersRequest selects the day and cutoff to check for missing attendance and reports.
+// It is read from the query string when listing and from the body when sending.
+type RemindersRequest struct {
+	Date   string `form:"date" json:"date"`     // YYYY-MM-DD, defaults to today
+	Cutoff string `form:"cutoff" json:"cutoff"` // HH:MM, defaults to REMINDER_CUTOFF
+}
+
+func (r *RemindersRequest) Validate() error {
+	if r.Date == "" {
+		r.Date = time.Now().Format("2006-01-02")
+	}
+	if _, err := time.Parse("2006-01-02", r.Date); err != nil {
+		return errors.New("invalid date format")
+	}
+	if r.Cutoff != "" {
+		if _, err := time.Parse("15:04", r.Cutoff); err != nil {
+			return errors.New("invalid cutoff format")
+		}
+	}
+	return nil
+}
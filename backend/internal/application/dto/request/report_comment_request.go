@@ -0,0 +1,44 @@
+package request
+
+import (
+	"errors"
+	"strings"
+)
+
+type CreateReportCommentRequest struct {
+	Body string `json:"body"` // Markdown
+	// ParentId makes the comment a reply; replies to a reply join the thread of its parent
+	ParentId *int `json:"parent_id,omitempty"`
+}
+
+func (c *CreateReportCommentRequest) Validate() error {
+	if strings.TrimSpace(c.Body) == "" {
+		return errors.New("body cannot be empty")
+	}
+	if c.ParentId != nil && *c.ParentId <= 0 {
+		return errors.New("invalid parent id")
+	}
+	return nil
+}
+
+type UpdateReportCommentRequest struct {
+	Body string `json:"body"`
+}
+
+func (u *UpdateReportCommentRequest) Validate() error {
+	if strings.TrimSpace(u.Body) == "" {
+		return errors.New("body cannot be empty")
+	}
+	return nil
+}
+
+type ReportReactionRequest struct {
+	Emoji string `json:"emoji"` // unicode emoji or a shortcode such as :+1:
+}
+
+func (r *ReportReactionRequest) Validate() error {
+	if strings.TrimSpace(r.Emoji) == "" {
+		return errors.New("emoji cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package request
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// GetReportDigestRequest represents the query parameters for a report digest
+type GetReportDigestRequest struct {
+	Period string `form:"period"` // week (default) or month
+	Date   string `form:"date"`   // YYYY-MM-DD, any day of the period; defaults to today
+	// Format is json (default), markdown or html. The html format lays out the summary and the
+	// daily table; report bodies are escaped Markdown source, not converted to HTML.
+	Format string `form:"format"`
+}
+
+// Validate checks the parameters and fills in the defaults
+func (g *GetReportDigestRequest) Validate() error {
+	g.Period = strings.ToUpper(g.Period)
+	if g.Period == "" {
+		g.Period = "WEEK"
+	}
+	if g.Period != "WEEK" && g.Period != "MONTH" {
+		return errors.New("period must be week or month")
+	}
+	if g.Date == "" {
+		g.Date = time.Now().Format("2006-01-02")
+	}
+	if _, err := time.Parse("2006-01-02", g.Date); err != nil {
+		return errors.New("invalid date format")
+	}
+	g.Format = strings.ToLower(g.Format)
+	if g.Format == "" {
+		g.Format = "json"
+	}
+	if g.Format != "json" && g.Format != "markdown" && g.Format != "html" {
+		return errors.New("format must be json, markdown or html")
+	}
+	return nil
+}
+
+// PostReportDigestsRequest asks for the digests of a period to be posted to Slack
+type PostReportDigestsRequest struct {
+	Period string `json:"period"` // week (default) or month
+	Date   string `json:"date"`   // YYYY-MM-DD, any day of the period; defaults to the previous period
+}
+
+func (p *PostReportDigestsRequest) Validate() error {
+	p.Period = strings.ToUpper(p.Period)
+	if p.Period == "" {
+		p.Period = "WEEK"
+	}
+	if p.Period != "WEEK" && p.Period != "MONTH" {
+		return errors.New("period must be week or month")
+	}
+	if p.Date != "" {
+		if _, err := time.Parse("2006-01-02", p.Date); err != nil {
+			return errors.New("invalid date format")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package request
+
+import (
+	"errors"
+	"strings"
+)
+
+// SlackCommandRequest is the form Slack posts when a user runs a slash command
+type SlackCommandRequest struct {
+	TeamId   string `form:"team_id"`
+	UserId   string `form:"user_id"`
+	UserName string `form:"user_name"`
+	Command  string `form:"command"` // e.g. /kintai
+	Text     string `form:"text"`    // everything after the command, e.g. "out 60 今日の作業"
+}
+
+func (s *SlackCommandRequest) Validate() error {
+	if s.TeamId == "" || s.UserId == "" {
+		return errors.New("team_id and user_id cannot be empty")
+	}
+	return nil
+}
+
+// LinkSlackAccountRequest redeems the code "/kintai link" replied with in Slack
+type LinkSlackAccountRequest struct {
+	Code string `json:"code"`
+}
+
+func (l *LinkSlackAccountRequest) Validate() error {
+	if strings.TrimSpace(l.Code) == "" {
+		return errors.New("code cannot be empty")
+	}
+	return nil
+}
+
+// SetSlackAccountRequest links a user to a Slack member without the linking flow, e.g. so that
+// notifications can @-mention users who never use /kintai
+type SetSlackAccountRequest struct {
+	TeamId      string `json:"team_id"`
+	SlackUserId string `json:"slack_user_id"` // member ID, shown in the Slack profile menu
+}
+
+func (s *SetSlackAccountRequest) Validate() error {
+	if strings.TrimSpace(s.TeamId) == "" {
+		return errors.New("team_id cannot be empty")
+	}
+	if strings.TrimSpace(s.SlackUserId) == "" {
+		return errors.New("slack_user_id cannot be empty")
+	}
+	return nil
+}
@@ -21,6 +21,19 @@ type CreateUserRequest struct {
 	Role     string `json:"role"`
 	PayType  string `json:"pay_type"`
 	PayRate  int    `json:"pay_rate"`
+
+	Department string `json:"department,omitempty"`
+
+	// Overtime hours included in the salary; required for MONTHLY_FIXED_OVERTIME
+	FixedOvertimeHours int `json:"fixed_overtime_hours,omitempty"`
+
+	// Employment and schedule settings (all optional)
+	HireDate              *string `json:"hire_date,omitempty"`        // YYYY-MM-DD format
+	TerminationDate       *string `json:"termination_date,omitempty"` // YYYY-MM-DD format
+	ProrationPolicy       *string `json:"proration_policy,omitempty"`
+	ScheduledStartTime    *string `json:"scheduled_start_time,omitempty"` // HH:MM format
+	ScheduledEndTime      *string `json:"scheduled_end_time,omitempty"`   // HH:MM format
+	ScheduledBreakMinutes *int    `json:"scheduled_break_minutes,omitempty"`
 }
 
 func (c *CreateUserRequest) Validate() error {
@@ -42,6 +55,12 @@ func (c *CreateUserRequest) Validate() error {
 	if c.PayRate <= 0 {
 		return errors.New("pay rate must be greater than zero")
 	}
+	if c.FixedOvertimeHours < 0 {
+		return errors.New("fixed overtime hours cannot be negative")
+	}
+	if c.ScheduledBreakMinutes != nil && *c.ScheduledBreakMinutes < 0 {
+		return errors.New("scheduled break minutes cannot be negative")
+	}
 	return nil
 }
 
@@ -52,6 +71,19 @@ type UpdateUserRequest struct {
 	PayType *string `json:"pay_type,omitempty"`
 	PayRate *int    `json:"pay_rate,omitempty"`
 	Goal    *int    `json:"goal,omitempty"`
+
+	// An empty string removes the user from their department
+	Department *string `json:"department,omitempty"`
+
+	FixedOvertimeHours *int `json:"fixed_overtime_hours,omitempty"`
+
+	// An empty string clears the date or schedule
+	HireDate              *string `json:"hire_date,omitempty"`        // YYYY-MM-DD format
+	TerminationDate       *string `json:"termination_date,omitempty"` // YYYY-MM-DD format
+	ProrationPolicy       *string `json:"proration_policy,omitempty"`
+	ScheduledStartTime    *string `json:"scheduled_start_time,omitempty"` // HH:MM format
+	ScheduledEndTime      *string `json:"scheduled_end_time,omitempty"`   // HH:MM format
+	ScheduledBreakMinutes *int    `json:"scheduled_break_minutes,omitempty"`
 }
 
 func (u *UpdateUserRequest) Validate() error {
@@ -73,9 +105,30 @@ func (u *UpdateUserRequest) Validate() error {
 	if u.Goal != nil && *u.Goal < 0 {
 		return errors.New("goal must be greater than or equal to zero")
 	}
+	if u.FixedOvertimeHours != nil && *u.FixedOvertimeHours < 0 {
+		return errors.New("fixed overtime hours cannot be negative")
+	}
+	if u.ProrationPolicy != nil && *u.ProrationPolicy == "" {
+		return errors.New("proration policy cannot be empty")
+	}
+	if u.ScheduledBreakMinutes != nil && *u.ScheduledBreakMinutes < 0 {
+		return errors.New("scheduled break minutes cannot be negative")
+	}
 	return nil
 }
 
+// HasEmploymentChanges reports whether the request touches employment or schedule settings
+func (u *UpdateUserRequest) HasEmploymentChanges() bool {
+	return u.HireDate != nil || u.TerminationDate != nil || u.ProrationPolicy != nil ||
+		u.ScheduledStartTime != nil || u.ScheduledEndTime != nil || u.ScheduledBreakMinutes != nil
+}
+
+// HasPayrollChanges reports whether the request touches anything the user's payroll is calculated from
+func (u *UpdateUserRequest) HasPayrollChanges() bool {
+	return u.Role != nil || u.PayType != nil || u.PayRate != nil || u.FixedOvertimeHours != nil ||
+		u.HasEmploymentChanges()
+}
+
 type ChangePasswordRequest struct {
 	CurrentPassword string `json:"current_password"`
 	NewPassword     string `json:"new_password"`
@@ -0,0 +1,116 @@
+package request
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+// Paging limits for the webhook delivery log
+const (
+	DefaultWebhookDeliveriesPerPage = 20
+	MaxWebhookDeliveriesPerPage     = 100
+)
+
+type CreateWebhookSubscriptionRequest struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+	// Secret signs the deliveries; a random one is generated if it is empty
+	Secret string `json:"secret,omitempty"`
+	// Events lists the events to receive, e.g. ["attendance.created", "user.updated"]
+	Events  []string `json:"events"`
+	Enabled *bool    `json:"enabled,omitempty"` // true by default
+}
+
+func (c *CreateWebhookSubscriptionRequest) Validate() error {
+	if strings.TrimSpace(c.Name) == "" {
+		return errors.New("name cannot be empty")
+	}
+	if err := entity.ValidateWebhookURL(strings.TrimSpace(c.URL)); err != nil {
+		return err
+	}
+	if c.Secret != "" {
+		if err := entity.ValidateWebhookSecret(c.Secret); err != nil {
+			return err
+		}
+	}
+	return validateWebhookEvents(c.Events)
+}
+
+type UpdateWebhookSubscriptionRequest struct {
+	Name    *string  `json:"name,omitempty"`
+	URL     *string  `json:"url,omitempty"`
+	Secret  *string  `json:"secret,omitempty"`
+	Events  []string `json:"events,omitempty"`
+	Enabled *bool    `json:"enabled,omitempty"`
+	// RotateSecret replaces the secret with a random one
+	RotateSecret bool `json:"rotate_secret,omitempty"`
+}
+
+func (u *UpdateWebhookSubscriptionRequest) Validate() error {
+	if u.Name != nil && strings.TrimSpace(*u.Name) == "" {
+		return errors.New("name cannot be empty")
+	}
+	if u.URL != nil {
+		if err := entity.ValidateWebhookURL(strings.TrimSpace(*u.URL)); err != nil {
+			return err
+		}
+	}
+	if u.Secret != nil {
+		if u.RotateSecret {
+			return errors.New("secret and rotate_secret cannot be used together")
+		}
+		if err := entity.ValidateWebhookSecret(*u.Secret); err != nil {
+			return err
+		}
+	}
+	if u.Events != nil {
+		return validateWebhookEvents(u.Events)
+	}
+	return nil
+}
+
+func validateWebhookEvents(events []string) error {
+	if len(events) == 0 {
+		return errors.New("events cannot be empty")
+	}
+	seen := make(map[string]bool, len(events))
+	for _, event := range events {
+		if err := entity.WebhookEvent(event).Validate(); err != nil {
+			return fmt.Errorf("invalid webhook event: %s", event)
+		}
+		if seen[event] {
+			return fmt.Errorf("duplicate webhook event: %s", event)
+		}
+		seen[event] = true
+	}
+	return nil
+}
+
+// GetWebhookDeliveriesRequest represents the query parameters for listing a subscription's deliveries
+type GetWebhookDeliveriesRequest struct {
+	Page    int `form:"page"`
+	PerPage int `form:"per_page"`
+}
+
+// Validate checks the parameters and fills in the defaults
+func (g *GetWebhookDeliveriesRequest) Validate() error {
+	if g.Page < 0 {
+		return errors.New("page must be greater than zero")
+	}
+	if g.PerPage < 0 {
+		return errors.New("per_page must be greater than zero")
+	}
+	if g.PerPage > MaxWebhookDeliveriesPerPage {
+		return fmt.Errorf("per_page cannot exceed %d", MaxWebhookDeliveriesPerPage)
+	}
+	if g.Page == 0 {
+		g.Page = 1
+	}
+	if g.PerPage == 0 {
+		g.PerPage = DefaultWebhookDeliveriesPerPage
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+// SlackCommandResponse is the reply to a slash command
+type SlackCommandResponse struct {
+	// ResponseType is "ephemeral" for replies only the user who ran the command sees
+	ResponseType string `json:"response_type"`
+	Text         string `json:"text"`
+}
+
+func NewEphemeralSlackResponse(text string) *SlackCommandResponse {
+	return &SlackCommandResponse{ResponseType: "ephemeral", Text: text}
+}
+
+type SlackAccountResponse struct {
+	Linked      bool       `json:"linked"`
+	TeamId      string     `json:"team_id,omitempty"`
+	SlackUserId string     `json:"slack_user_id,omitempty"`
+	LinkedAt    *time.Time `json:"linked_at,omitempty"`
+}
+
+// ToSlackAccountResponse converts the user's linked account, which may be nil
+func ToSlackAccountResponse(account *entity.SlackAccount) *SlackAccountResponse {
+	if account == nil {
+		return &SlackAccountResponse{}
+	}
+	return &SlackAccountResponse{
+		Linked:      true,
+		TeamId:      account.TeamId,
+		SlackUserId: account.SlackUserId,
+		LinkedAt:    &account.CreatedAt,
+	}
+}
@@ -1,20 +1,28 @@
 package dto
 
 import (
-	"time"
 	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"time"
 )
 
 type UserResponse struct {
-	ID        int       `json:"id"`
-	Email     string    `json:"email"`
-	Name      string    `json:"name"`
-	Role      string    `json:"role"`
-	PayType   string    `json:"pay_type"`
-	PayRate   int       `json:"pay_rate"`
-	Goal      int       `json:"goal"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID                    int        `json:"id"`
+	Email                 string     `json:"email"`
+	Name                  string     `json:"name"`
+	Role                  string     `json:"role"`
+	PayType               string     `json:"pay_type"`
+	PayRate               int        `json:"pay_rate"`
+	Goal                  int        `json:"goal"`
+	Department            string     `json:"department"`
+	FixedOvertimeHours    int        `json:"fixed_overtime_hours"`
+	HireDate              *time.Time `json:"hire_date,omitempty"`
+	TerminationDate       *time.Time `json:"termination_date,omitempty"`
+	ProrationPolicy       string     `json:"proration_policy"`
+	ScheduledStartTime    string     `json:"scheduled_start_time,omitempty"`
+	ScheduledEndTime      string     `json:"scheduled_end_time,omitempty"`
+	ScheduledBreakMinutes int        `json:"scheduled_break_minutes"`
+	CreatedAt             time.Time  `json:"created_at"`
+	UpdatedAt             time.Time  `json:"updated_at"`
 }
 
 type LoginResponse struct {
@@ -28,15 +36,23 @@ type UsersResponse struct {
 
 func ToUserResponse(user *entity.User) *UserResponse {
 	return &UserResponse{
-		ID:        user.Id,
-		Email:     user.Email,
-		Name:      user.Name,
-		Role:      string(user.Role),
-		PayType:   string(user.PayType),
-		PayRate:   user.PayRate,
-		Goal:      user.Goal,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
+		ID:                    user.Id,
+		Email:                 user.Email,
+		Name:                  user.Name,
+		Role:                  string(user.Role),
+		PayType:               string(user.PayType),
+		PayRate:               user.PayRate,
+		Goal:                  user.Goal,
+		Department:            user.Department,
+		FixedOvertimeHours:    user.FixedOvertimeHours,
+		HireDate:              user.HireDate,
+		TerminationDate:       user.TerminationDate,
+		ProrationPolicy:       string(user.ProrationPolicy),
+		ScheduledStartTime:    user.ScheduledStartTime,
+		ScheduledEndTime:      user.ScheduledEndTime,
+		ScheduledBreakMinutes: user.ScheduledBreakMinutes,
+		CreatedAt:             user.CreatedAt,
+		UpdatedAt:             user.UpdatedAt,
 	}
 }
 
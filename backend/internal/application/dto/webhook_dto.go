@@ -0,0 +1,95 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type WebhookSubscriptionResponse struct {
+	Id     int      `json:"id"`
+	Name   string   `json:"name"`
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+	// Secret is only returned when the subscription is created or its secret is changed
+	Secret    string    `json:"secret,omitempty"`
+	Enabled   bool      `json:"enabled"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type WebhookSubscriptionsResponse struct {
+	Subscriptions []WebhookSubscriptionResponse `json:"subscriptions"`
+	// Events lists the events subscriptions can filter on
+	Events []string `json:"events"`
+}
+
+type WebhookDeliveryResponse struct {
+	Id             int       `json:"id"`
+	SubscriptionId int       `json:"subscription_id"`
+	EventId        string    `json:"event_id"`
+	Event          string    `json:"event"`
+	Succeeded      bool      `json:"succeeded"`
+	StatusCode     int       `json:"status_code"`
+	Response       string    `json:"response,omitempty"`
+	Error          string    `json:"error,omitempty"`
+	DurationMs     int       `json:"duration_ms"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// ToWebhookSubscriptionResponse converts a subscription; withSecret includes its secret
+func ToWebhookSubscriptionResponse(subscription *entity.WebhookSubscription, withSecret bool) *WebhookSubscriptionResponse {
+	response := &WebhookSubscriptionResponse{
+		Id:        subscription.Id,
+		Name:      subscription.Name,
+		URL:       subscription.URL,
+		Events:    make([]string, len(subscription.Events)),
+		Enabled:   subscription.Enabled,
+		CreatedAt: subscription.CreatedAt,
+		UpdatedAt: subscription.UpdatedAt,
+	}
+	for i, event := range subscription.Events {
+		response.Events[i] = string(event)
+	}
+	if withSecret {
+		response.Secret = subscription.Secret
+	}
+	return response
+}
+
+func ToWebhookSubscriptionsResponse(subscriptions []*entity.WebhookSubscription) *WebhookSubscriptionsResponse {
+	response := &WebhookSubscriptionsResponse{
+		Subscriptions: make([]WebhookSubscriptionResponse, len(subscriptions)),
+		Events:        make([]string, len(entity.WebhookEvents)),
+	}
+	for i, subscription := range subscriptions {
+		response.Subscriptions[i] = *ToWebhookSubscriptionResponse(subscription, false)
+	}
+	for i, event := range entity.WebhookEvents {
+		response.Events[i] = string(event)
+	}
+	return response
+}
+
+func ToWebhookDeliveryResponse(delivery *entity.WebhookDelivery) *WebhookDeliveryResponse {
+	return &WebhookDeliveryResponse{
+		Id:             delivery.Id,
+		SubscriptionId: delivery.SubscriptionId,
+		EventId:        delivery.EventId,
+		Event:          string(delivery.Event),
+		Succeeded:      delivery.Succeeded(),
+		StatusCode:     delivery.StatusCode,
+		Response:       delivery.Response,
+		Error:          delivery.Error,
+		DurationMs:     delivery.DurationMs,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
+
+func ToWebhookDeliveryResponses(deliveries []*entity.WebhookDelivery) []WebhookDeliveryResponse {
+	responses := make([]WebhookDeliveryResponse, len(deliveries))
+	for i, delivery := range deliveries {
+		responses[i] = *ToWebhookDeliveryResponse(delivery)
+	}
+	return responses
+}
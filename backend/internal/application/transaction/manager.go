@@ -30,7 +30,9 @@ func (m *managerImpl) ExecuteInTx(ctx context.Context, fn func(ctx context.Conte
 		}
 	}()
 
+	hooks := &afterCommitHooks{}
 	txCtx := context.WithValue(ctx, "tx", tx)
+	txCtx = context.WithValue(txCtx, "afterCommit", hooks)
 
 	if err := fn(txCtx); err != nil {
 		tx.Rollback()
@@ -42,5 +44,23 @@ func (m *managerImpl) ExecuteInTx(ctx context.Context, fn func(ctx context.Conte
 		return err
 	}
 
+	for _, hook := range hooks.fns {
+		hook()
+	}
+
 	return nil
+}
+
+type afterCommitHooks struct {
+	fns []func()
+}
+
+// AfterCommit runs fn once the transaction in ctx has committed, and drops it on rollback.
+// Without a transaction fn runs immediately.
+func AfterCommit(ctx context.Context, fn func()) {
+	if hooks, ok := ctx.Value("afterCommit").(*afterCommitHooks); ok {
+		hooks.fns = append(hooks.fns, fn)
+		return
+	}
+	fn()
 }
\ No newline at end of file
@@ -13,7 +13,8 @@ import (
 type AdminUseCase interface {
 	// GetDashboardData returns aggregated data for admin dashboard (ADMIN only)
 	// NOTE: Caller must verify ADMIN role before calling this method
-	GetDashboardData(ctx context.Context) (*dto.DashboardResponse, error)
+	// The period is a month (YYYY-MM) or a from/to date range; it defaults to the current month
+	GetDashboardData(ctx context.Context, month, from, to *string) (*dto.DashboardResponse, error)
 
 	// GetPayrollData returns payroll data for all employees for a specified month (ADMIN only)
 	// NOTE: Caller must verify ADMIN role before calling this method
@@ -21,143 +22,313 @@ type AdminUseCase interface {
 }
 
 type adminUseCase struct {
-	userRepo       repository.UserRepository
-	attendanceRepo repository.AttendanceRepository
+	userRepo          repository.UserRepository
+	attendanceRepo    repository.AttendanceRepository
+	payrollCalculator PayrollCalculator
+	summaryService    MonthlySummaryService
 }
 
-func NewAdminUseCase(userRepo repository.UserRepository, attendanceRepo repository.AttendanceRepository) AdminUseCase {
+func NewAdminUseCase(userRepo repository.UserRepository, attendanceRepo repository.AttendanceRepository, payrollCalculator PayrollCalculator, summaryService MonthlySummaryService) AdminUseCase {
 	return &adminUseCase{
-		userRepo:       userRepo,
-		attendanceRepo: attendanceRepo,
+		userRepo:          userRepo,
+		attendanceRepo:    attendanceRepo,
+		payrollCalculator: payrollCalculator,
+		summaryService:    summaryService,
 	}
 }
 
 // GetDashboardData returns aggregated data for admin dashboard
-func (u *adminUseCase) GetDashboardData(ctx context.Context) (*dto.DashboardResponse, error) {
+func (u *adminUseCase) GetDashboardData(ctx context.Context, month, from, to *string) (*dto.DashboardResponse, error) {
+	startDate, endDate, previousStart, previousEnd, err := dashboardPeriod(month, from, to)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get all users
 	users, err := u.userRepo.FindAll(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get users: %w", err)
 	}
 
-	// Get all attendances
-	attendances, err := u.attendanceRepo.FindAll(ctx)
+	current, err := u.aggregate(ctx, users, startDate, endDate)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get attendances: %w", err)
+		return nil, err
+	}
+	previous, err := u.aggregate(ctx, users, previousStart, previousEnd)
+	if err != nil {
+		return nil, err
 	}
 
-	// Calculate aggregated data
-	var totalHours float64
-	var totalSalary int
-	activeEmployees := 0
-
-	// Create employee data map
-	employeeDataMap := make(map[int]*dto.DashboardEmployeeData)
-
-	// Initialize employee data for all users
+	employeeData := make([]dto.DashboardEmployeeData, 0, len(current.employees))
 	for _, user := range users {
-		if user.Role == entity.UserRoleUser {
-			activeEmployees++
-			employeeDataMap[user.Id] = &dto.DashboardEmployeeData{
-				Name:        user.Name,
-				TotalHours:  0,
-				TotalSalary: 0,
-			}
+		if empData, exists := current.employees[user.Id]; exists {
+			employeeData = append(employeeData, *empData)
 		}
 	}
 
-	// Calculate hours for each attendance
-	for _, attendance := range attendances {
-		// Calculate working hours using common utility
-		workingHours := CalculateWorkingHours(attendance)
+	averageHours := 0.0
+	if len(current.employees) > 0 {
+		averageHours = current.totalHours / float64(len(current.employees))
+	}
 
-		// Add to employee's total hours
-		if empData, exists := employeeDataMap[attendance.UserId]; exists {
-			empData.TotalHours += workingHours
-			totalHours += workingHours
-		}
+	return &dto.DashboardResponse{
+		From:                    startDate.Format(DateFormat),
+		To:                      endDate.Format(DateFormat),
+		TotalHours:              current.totalHours,
+		TotalSalary:             current.totalSalary,
+		ActiveEmployees:         current.headcount,
+		AverageHoursPerEmployee: averageHours,
+		Comparison: dto.DashboardComparison{
+			PreviousFrom:            previousStart.Format(DateFormat),
+			PreviousTo:              previousEnd.Format(DateFormat),
+			PreviousTotalHours:      previous.totalHours,
+			PreviousTotalSalary:     previous.totalSalary,
+			PreviousActiveEmployees: previous.headcount,
+			HoursChangeRate:         changeRate(current.totalHours, previous.totalHours),
+			SalaryChangeRate:        changeRate(float64(current.totalSalary), float64(previous.totalSalary)),
+		},
+		WeeklyTrend:  current.weeks,
+		EmployeeData: employeeData,
+	}, nil
+}
+
+// dashboardTotals holds the dashboard figures of one period
+type dashboardTotals struct {
+	totalHours  float64
+	totalSalary int
+	headcount   int
+	employees   map[int]*dto.DashboardEmployeeData // employees employed at any time during the period
+	weeks       []dto.DashboardWeek
+}
+
+// aggregate computes hours and salaries for employees employed during [startDate, endDate].
+// Past months fully inside the period are read from the monthly summaries. The current month
+// and partly covered months are calculated live: hours are summed in the database and salaries
+// come from the payroll calculator, counting hourly and daily pay for the hours in the period
+// and prorating monthly salaries by the share of calendar days covered.
+func (u *adminUseCase) aggregate(ctx context.Context, users []*entity.User, startDate, endDate time.Time) (*dashboardTotals, error) {
+	totals := &dashboardTotals{
+		employees: make(map[int]*dto.DashboardEmployeeData),
 	}
 
-	// Calculate salary for each employee
+	// The headcount is taken at the end of the period, or today for a period still in progress
+	headcountDate := endDate
+	if today := truncateDate(time.Now()); today.Before(headcountDate) {
+		headcountDate = today
+	}
+
+	usersById := make(map[int]*entity.User)
 	for _, user := range users {
-		if empData, exists := employeeDataMap[user.Id]; exists {
-			empData.TotalSalary = CalculateSalary(user.PayType, user.PayRate, empData.TotalHours)
-			totalSalary += empData.TotalSalary
+		if user.Role != entity.UserRoleUser {
+			continue // Skip non-employee users (e.g., admins)
+		}
+		if _, _, employed := employmentPeriod(user, startDate, endDate); !employed {
+			continue
+		}
+		usersById[user.Id] = user
+		totals.employees[user.Id] = &dto.DashboardEmployeeData{
+			ID:   user.Id,
+			Name: user.Name,
+		}
+		if user.IsEmployedOn(headcountDate) {
+			totals.headcount++
 		}
 	}
 
-	// Convert map to slice
-	employeeDataSlice := make([]dto.DashboardEmployeeData, 0, len(employeeDataMap))
-	for _, empData := range employeeDataMap {
-		employeeDataSlice = append(employeeDataSlice, *empData)
+	weeklySummaries, err := u.attendanceRepo.SummarizeByUserAndWeek(ctx, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize attendances: %w", err)
 	}
+	totals.weeks = weeklyTrend(weeklySummaries, totals.employees, startDate, endDate)
 
-	return &dto.DashboardResponse{
-		TotalHours:      totalHours,
-		TotalSalary:     totalSalary,
-		ActiveEmployees: activeEmployees,
-		EmployeeData:    employeeDataSlice,
-	}, nil
+	now := time.Now()
+	currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	for monthStart := time.Date(startDate.Year(), startDate.Month(), 1, 0, 0, 0, 0, time.UTC); !monthStart.After(endDate); monthStart = monthStart.AddDate(0, 1, 0) {
+		monthEnd := monthStart.AddDate(0, 1, -1)
+
+		coveredStart, coveredEnd := monthStart, monthEnd
+		if startDate.After(coveredStart) {
+			coveredStart = startDate
+		}
+		if endDate.Before(coveredEnd) {
+			coveredEnd = endDate
+		}
+		fullMonth := coveredStart.Equal(monthStart) && coveredEnd.Equal(monthEnd)
+
+		// Past months are read from the materialized monthly summaries
+		if fullMonth && monthStart.Before(currentMonth) {
+			summaries, err := u.summaryService.MonthSummaries(ctx, monthStart)
+			if err != nil {
+				return nil, err
+			}
+			for _, summary := range summaries {
+				if empData, exists := totals.employees[summary.UserId]; exists {
+					empData.TotalHours += summary.TotalHours
+					empData.TotalSalary += summary.EstimatedPay
+					totals.totalHours += summary.TotalHours
+					totals.totalSalary += summary.EstimatedPay
+				}
+			}
+			continue
+		}
+
+		// The current month and partly covered months are calculated live
+		coveredSummaries, err := u.summariesByUser(ctx, coveredStart, coveredEnd)
+		if err != nil {
+			return nil, err
+		}
+		for userID, summary := range coveredSummaries {
+			if empData, exists := totals.employees[userID]; exists {
+				empData.TotalHours += summary.TotalHours
+				totals.totalHours += summary.TotalHours
+			}
+		}
+
+		lines, err := u.payrollCalculator.CalculateMonth(ctx, monthStart)
+		if err != nil {
+			return nil, err
+		}
+		for _, line := range lines {
+			empData, exists := totals.employees[line.UserId]
+			if !exists {
+				continue
+			}
+
+			salary := line.TotalSalary
+			if !fullMonth {
+				user := usersById[line.UserId]
+				if user.PayType.IsMonthly() {
+					salary = salary * entity.CalendarDays(coveredStart, coveredEnd) / entity.CalendarDays(monthStart, monthEnd)
+				} else if summary, exists := coveredSummaries[line.UserId]; exists {
+					salary = CalculateSalary(user.PayType, user.PayRate, summary.TotalHours, summary.WorkedDays)
+				} else {
+					salary = 0
+				}
+			}
+
+			empData.TotalSalary += salary
+			totals.totalSalary += salary
+		}
+	}
+
+	return totals, nil
 }
 
-// GetPayrollData returns payroll data for all employees for a specified month
-func (u *adminUseCase) GetPayrollData(ctx context.Context, month string) (*dto.PayrollResponse, error) {
-	// Parse month string (YYYY-MM format)
-	monthTime, err := ParseMonth(month)
+func (u *adminUseCase) summariesByUser(ctx context.Context, startDate, endDate time.Time) (map[int]*entity.AttendanceSummary, error) {
+	summaries, err := u.attendanceRepo.SummarizeByUser(ctx, startDate, endDate)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to summarize attendances: %w", err)
+	}
+	summariesByUser := make(map[int]*entity.AttendanceSummary, len(summaries))
+	for _, summary := range summaries {
+		summariesByUser[summary.UserId] = summary
 	}
+	return summariesByUser, nil
+}
 
-	// Get first and last day of the month
-	startDate := monthTime
-	endDate := monthTime.AddDate(0, 1, 0).Add(-time.Second)
+// dashboardPeriod resolves the requested period and the preceding period used for comparison.
+// A month (YYYY-MM) takes precedence over from/to (YYYY-MM-DD); the default is the current month.
+func dashboardPeriod(month, from, to *string) (time.Time, time.Time, time.Time, time.Time, error) {
+	if (from == nil || *from == "") && (to == nil || *to == "") {
+		monthTime := truncateDate(time.Now()).AddDate(0, 0, 1-time.Now().Day())
+		if month != nil && *month != "" {
+			var err error
+			monthTime, err = ParseMonth(*month)
+			if err != nil {
+				return time.Time{}, time.Time{}, time.Time{}, time.Time{}, err
+			}
+		}
+		previousMonth := monthTime.AddDate(0, -1, 0)
+		return monthTime, monthTime.AddDate(0, 1, -1), previousMonth, monthTime.AddDate(0, 0, -1), nil
+	}
 
-	// Get all users
-	users, err := u.userRepo.FindAll(ctx)
+	if from == nil || *from == "" || to == nil || *to == "" {
+		return time.Time{}, time.Time{}, time.Time{}, time.Time{}, fmt.Errorf("both from and to are required for a date range")
+	}
+	startDate, err := ParseDate(*from)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get users: %w", err)
+		return time.Time{}, time.Time{}, time.Time{}, time.Time{}, err
+	}
+	endDate, err := ParseDate(*to)
+	if err != nil {
+		return time.Time{}, time.Time{}, time.Time{}, time.Time{}, err
+	}
+	if endDate.Before(startDate) {
+		return time.Time{}, time.Time{}, time.Time{}, time.Time{}, fmt.Errorf("from date must not be after to date")
+	}
+	if endDate.After(startDate.AddDate(1, 0, 0)) {
+		return time.Time{}, time.Time{}, time.Time{}, time.Time{}, fmt.Errorf("date range cannot exceed one year")
 	}
 
-	var totalPayroll int
-	payrollData := make([]dto.PayrollEmployee, 0)
+	days := entity.CalendarDays(startDate, endDate)
+	return startDate, endDate, startDate.AddDate(0, 0, -days), startDate.AddDate(0, 0, -1), nil
+}
 
-	// Calculate payroll for each user
-	for _, user := range users {
-		if user.Role != entity.UserRoleUser {
-			continue // Skip non-employee users (e.g., admins)
+// weeklyTrend splits the period into Monday-to-Sunday weeks; the first and last weeks are clipped to the period
+func weeklyTrend(summaries []*entity.AttendanceSummary, employees map[int]*dto.DashboardEmployeeData, startDate, endDate time.Time) []dto.DashboardWeek {
+	weeks := make([]dto.DashboardWeek, 0)
+	weekIndex := make(map[string]int)
+	weekStart := startDate
+	for !weekStart.After(endDate) {
+		// Days until Sunday
+		weekEnd := weekStart.AddDate(0, 0, (7-int(weekStart.Weekday()))%7)
+		if weekEnd.After(endDate) {
+			weekEnd = endDate
 		}
 
-		// Get attendances for this user in the specified month
-		attendances, err := u.attendanceRepo.FindByDatePeriod(ctx, user.Id, startDate, endDate)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get attendances for user %d: %w", user.Id, err)
-		}
+		weekIndex[weekStart.Format(DateFormat)] = len(weeks)
+		weeks = append(weeks, dto.DashboardWeek{
+			WeekStart: weekStart.Format(DateFormat),
+			WeekEnd:   weekEnd.Format(DateFormat),
+		})
+		weekStart = weekEnd.AddDate(0, 0, 1)
+	}
 
-		// Calculate total hours for the month
-		var totalHours float64
-		for _, attendance := range attendances {
-			workingHours := CalculateWorkingHours(attendance)
-			totalHours += workingHours
+	for _, summary := range summaries {
+		if _, exists := employees[summary.UserId]; !exists {
+			continue
+		}
+		// The first week of the period starts on startDate rather than on Monday
+		periodStart := truncateDate(summary.PeriodStart)
+		if periodStart.Before(startDate) {
+			periodStart = startDate
 		}
+		i, exists := weekIndex[periodStart.Format(DateFormat)]
+		if !exists {
+			continue
+		}
+		weeks[i].TotalHours += summary.TotalHours
+		weeks[i].WorkedDays += summary.WorkedDays
+		weeks[i].ActiveEmployees++
+	}
+	return weeks
+}
+
+// changeRate returns the change from previous to current in percent, rounded to one decimal place
+func changeRate(current, previous float64) float64 {
+	if previous == 0 {
+		return 0
+	}
+	return roundTenth((current - previous) / previous * 100)
+}
 
-		// Calculate salary
-		salary := CalculateSalary(user.PayType, user.PayRate, totalHours)
+func truncateDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
 
-		totalPayroll += salary
+// GetPayrollData returns payroll data for all employees for a specified month
+func (u *adminUseCase) GetPayrollData(ctx context.Context, month string) (*dto.PayrollResponse, error) {
+	// Parse month string (YYYY-MM format)
+	monthTime, err := ParseMonth(month)
+	if err != nil {
+		return nil, err
+	}
 
-		// Add to payroll data
-		payrollData = append(payrollData, dto.PayrollEmployee{
-			ID:          fmt.Sprintf("user-%d", user.Id), // Convert int to string format
-			Name:        user.Name,
-			PayType:     string(user.PayType),
-			PayRate:     user.PayRate,
-			TotalHours:  totalHours,
-			TotalSalary: salary,
-		})
+	lines, err := u.payrollCalculator.CalculateMonth(ctx, monthTime)
+	if err != nil {
+		return nil, err
 	}
 
-	return &dto.PayrollResponse{
-		TotalPayroll: totalPayroll,
-		PayrollData:  payrollData,
-	}, nil
+	return dto.ToPayrollResponse(lines), nil
 }
@@ -0,0 +1,239 @@
+package usecase_test
+
+// The benchmarks compare the admin payroll and dashboard endpoints, which aggregate attendance
+// in the database and read past months from the monthly summaries, with the implementation
+// they replaced, which loaded attendances into memory (see admin_usecase_legacy_test.go).
+// They need MySQL: the connection settings come from the environment like the API's, and the
+// data is seeded into a separate "<DB_NAME>_bench" database on first use.
+//
+//	go test ./internal/application/usecase -run '^$' -bench . -bench.users 500 -bench.days 730
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/joho/godotenv"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/attendance_report_app/backend/internal/application/usecase"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	domainrepository "github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/database"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/model"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/repository"
+)
+
+var (
+	benchUsers  = flag.Int("bench.users", 300, "number of employees to seed")
+	benchDays   = flag.Int("bench.days", 365, "days of attendance history per employee")
+	benchReseed = flag.Bool("bench.reseed", false, "drop and reseed the benchmark data")
+)
+
+var (
+	benchOnce sync.Once
+	benchConn *gorm.DB
+	benchErr  error
+)
+
+// benchDB connects to the benchmark database and seeds it once per test binary
+func benchDB(b *testing.B) *gorm.DB {
+	b.Helper()
+	benchOnce.Do(func() {
+		_ = godotenv.Load("../../../.env")
+
+		// Never run against the application database
+		config := database.NewConfigFromEnv()
+		config.Database = config.Database + "_bench"
+		config.LogLevel = logger.Silent
+
+		if benchErr = database.CreateDatabaseIfNotExists(config); benchErr != nil {
+			return
+		}
+		if benchConn, benchErr = database.Connect(config); benchErr != nil {
+			return
+		}
+		benchErr = seedBenchData(benchConn, *benchUsers, *benchDays, *benchReseed)
+	})
+	if benchErr != nil {
+		b.Skipf("benchmark database unavailable: %v", benchErr)
+	}
+	return benchConn
+}
+
+func seedBenchData(db *gorm.DB, users, days int, reseed bool) error {
+	if reseed {
+		if err := db.Migrator().DropTable(&model.MonthlySummaryBuild{}, &model.MonthlySummary{}, &model.Attendance{}, &model.User{}); err != nil {
+			return err
+		}
+	}
+	if err := db.AutoMigrate(
+		&model.User{},
+		&model.Attendance{},
+		&model.Holiday{},
+		&model.Allowance{},
+		&model.UserAllowance{},
+		&model.Bonus{},
+		&model.MonthlySummary{},
+		&model.MonthlySummaryBuild{},
+	); err != nil {
+		return err
+	}
+
+	var count int64
+	if err := db.Model(&model.Attendance{}).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	today := time.Now()
+	start := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -days)
+	for i := 0; i < users; i++ {
+		user := model.User{
+			Name:                  fmt.Sprintf("Bench User %d", i),
+			Email:                 fmt.Sprintf("bench%d@example.com", i),
+			Password:              "-",
+			Role:                  string(entity.UserRoleUser),
+			PayType:               string(entity.PayTypeHourly),
+			PayRate:               1200,
+			ProrationPolicy:       string(entity.ProrationPolicyCalendarDays),
+			ScheduledBreakMinutes: 60,
+		}
+		if err := db.Create(&user).Error; err != nil {
+			return err
+		}
+
+		attendances := make([]model.Attendance, 0, days)
+		for d := 0; d <= days; d++ {
+			date := start.AddDate(0, 0, d)
+			if date.Weekday() == time.Saturday || date.Weekday() == time.Sunday {
+				continue
+			}
+			attendances = append(attendances, model.Attendance{
+				UserId:       user.Id,
+				Date:         date,
+				StartTime:    date.Add(9 * time.Hour),
+				EndTime:      date.Add(time.Duration(17*60+(i+d)%120) * time.Minute),
+				BreakMinutes: 60,
+			})
+		}
+		if err := db.Omit("User").CreateInBatches(attendances, 1000).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// perUserAttendanceRepository restores how the payroll calculator loaded attendances before
+// the SQL aggregations: one query per user
+type perUserAttendanceRepository struct {
+	domainrepository.AttendanceRepository
+	userRepo domainrepository.UserRepository
+}
+
+func (r *perUserAttendanceRepository) FindAllByDatePeriod(ctx context.Context, startDate, endDate time.Time) ([]*entity.Attendance, error) {
+	users, err := r.userRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var attendances []*entity.Attendance
+	for _, user := range users {
+		userAttendances, err := r.FindByDatePeriod(ctx, user.Id, startDate, endDate)
+		if err != nil {
+			return nil, err
+		}
+		attendances = append(attendances, userAttendances...)
+	}
+	return attendances, nil
+}
+
+// benchVariants returns the current admin use case, which aggregates in SQL and reads past
+// months from the monthly summaries, and the implementation it replaced, which loads the
+// period's attendances and sums them in memory with per-user payroll queries
+func benchVariants(db *gorm.DB) []struct {
+	name string
+	uc   usecase.AdminUseCase
+} {
+	userRepo := repository.NewUserRepository(db)
+	attendanceRepo := repository.NewAttendanceRepository(db)
+	holidayRepo := repository.NewHolidayRepository(db)
+	allowanceRepo := repository.NewAllowanceRepository(db)
+	bonusRepo := repository.NewBonusRepository(db)
+
+	payrollCalculator := usecase.NewPayrollCalculator(userRepo, attendanceRepo, holidayRepo, allowanceRepo, bonusRepo)
+	summaryService := usecase.NewMonthlySummaryService(repository.NewMonthlySummaryRepository(db), userRepo, payrollCalculator)
+
+	perUserAttendanceRepo := &perUserAttendanceRepository{AttendanceRepository: attendanceRepo, userRepo: userRepo}
+	legacyPayrollCalculator := usecase.NewPayrollCalculator(userRepo, perUserAttendanceRepo, holidayRepo, allowanceRepo, bonusRepo)
+
+	return []struct {
+		name string
+		uc   usecase.AdminUseCase
+	}{
+		{"sql", usecase.NewAdminUseCase(userRepo, attendanceRepo, payrollCalculator, summaryService)},
+		{"in-memory", usecase.NewLegacyAdminUseCase(userRepo, attendanceRepo, legacyPayrollCalculator)},
+	}
+}
+
+func BenchmarkGetPayrollData(b *testing.B) {
+	db := benchDB(b)
+	ctx := context.Background()
+	month := time.Now().AddDate(0, -1, 0).Format("2006-01")
+
+	for _, variant := range benchVariants(db) {
+		b.Run(variant.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := variant.uc.GetPayrollData(ctx, month); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkGetDashboardData(b *testing.B) {
+	db := benchDB(b)
+	ctx := context.Background()
+	// The current month and the range are calculated live; past full months come from the summaries
+	now := time.Now()
+	month := now.Format("2006-01")
+	from := now.AddDate(0, 0, -90).Format("2006-01-02")
+	to := now.Format("2006-01-02")
+
+	// Both variants must report the same figures for the comparison to mean anything
+	variants := benchVariants(db)
+	var totals []string
+	for _, variant := range variants {
+		dashboard, err := variant.uc.GetDashboardData(ctx, nil, &from, &to)
+		if err != nil {
+			b.Fatal(err)
+		}
+		totals = append(totals, fmt.Sprintf("%.2f hours, %d yen", dashboard.TotalHours, dashboard.TotalSalary))
+	}
+	if totals[0] != totals[1] {
+		b.Fatalf("variants disagree: %s = %s, %s = %s", variants[0].name, totals[0], variants[1].name, totals[1])
+	}
+
+	for _, variant := range variants {
+		b.Run(variant.name+"/month", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := variant.uc.GetDashboardData(ctx, &month, nil, nil); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(variant.name+"/90-days", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := variant.uc.GetDashboardData(ctx, nil, &from, &to); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
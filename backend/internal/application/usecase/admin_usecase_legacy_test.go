@@ -0,0 +1,250 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+)
+
+// legacyAdminUseCase is the admin use case as it was before attendance was aggregated in SQL
+// and past months were materialized: the dashboard loads every attendance of the period and
+// sums it in memory, and salaries come from the payroll calculator for every month. The
+// benchmarks compare it with the current implementation.
+type legacyAdminUseCase struct {
+	userRepo          repository.UserRepository
+	attendanceRepo    repository.AttendanceRepository
+	payrollCalculator PayrollCalculator
+}
+
+// NewLegacyAdminUseCase exposes the legacy use case to the benchmarks in usecase_test
+func NewLegacyAdminUseCase(userRepo repository.UserRepository, attendanceRepo repository.AttendanceRepository, payrollCalculator PayrollCalculator) AdminUseCase {
+	return &legacyAdminUseCase{
+		userRepo:          userRepo,
+		attendanceRepo:    attendanceRepo,
+		payrollCalculator: payrollCalculator,
+	}
+}
+
+func (u *legacyAdminUseCase) GetDashboardData(ctx context.Context, month, from, to *string) (*dto.DashboardResponse, error) {
+	startDate, endDate, previousStart, previousEnd, err := dashboardPeriod(month, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get all users
+	users, err := u.userRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+
+	current, err := u.aggregate(ctx, users, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := u.aggregate(ctx, users, previousStart, previousEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	employeeData := make([]dto.DashboardEmployeeData, 0, len(current.employees))
+	for _, user := range users {
+		if empData, exists := current.employees[user.Id]; exists {
+			employeeData = append(employeeData, *empData)
+		}
+	}
+
+	averageHours := 0.0
+	if len(current.employees) > 0 {
+		averageHours = current.totalHours / float64(len(current.employees))
+	}
+
+	return &dto.DashboardResponse{
+		From:                    startDate.Format(DateFormat),
+		To:                      endDate.Format(DateFormat),
+		TotalHours:              current.totalHours,
+		TotalSalary:             current.totalSalary,
+		ActiveEmployees:         current.headcount,
+		AverageHoursPerEmployee: averageHours,
+		Comparison: dto.DashboardComparison{
+			PreviousFrom:            previousStart.Format(DateFormat),
+			PreviousTo:              previousEnd.Format(DateFormat),
+			PreviousTotalHours:      previous.totalHours,
+			PreviousTotalSalary:     previous.totalSalary,
+			PreviousActiveEmployees: previous.headcount,
+			HoursChangeRate:         changeRate(current.totalHours, previous.totalHours),
+			SalaryChangeRate:        changeRate(float64(current.totalSalary), float64(previous.totalSalary)),
+		},
+		WeeklyTrend:  legacyWeeklyTrend(current.attendances, current.employees, startDate, endDate),
+		EmployeeData: employeeData,
+	}, nil
+}
+
+// legacyDashboardTotals holds the dashboard figures of one period
+type legacyDashboardTotals struct {
+	totalHours  float64
+	totalSalary int
+	headcount   int
+	employees   map[int]*dto.DashboardEmployeeData // employees employed at any time during the period
+	attendances []*entity.Attendance
+}
+
+// aggregate computes hours and salaries for employees employed during [startDate, endDate].
+// Salaries come from the payroll calculator for every month overlapping the period. Months
+// only partly inside the period count hourly and daily pay for the attendances in the period
+// and prorate monthly salaries by the share of calendar days covered.
+func (u *legacyAdminUseCase) aggregate(ctx context.Context, users []*entity.User, startDate, endDate time.Time) (*legacyDashboardTotals, error) {
+	attendances, err := u.attendanceRepo.FindAllByDatePeriod(ctx, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attendances: %w", err)
+	}
+
+	totals := &legacyDashboardTotals{
+		employees:   make(map[int]*dto.DashboardEmployeeData),
+		attendances: make([]*entity.Attendance, 0, len(attendances)),
+	}
+
+	// The headcount is taken at the end of the period, or today for a period still in progress
+	headcountDate := endDate
+	if today := truncateDate(time.Now()); today.Before(headcountDate) {
+		headcountDate = today
+	}
+
+	usersById := make(map[int]*entity.User)
+	for _, user := range users {
+		if user.Role != entity.UserRoleUser {
+			continue // Skip non-employee users (e.g., admins)
+		}
+		if _, _, employed := employmentPeriod(user, startDate, endDate); !employed {
+			continue
+		}
+		usersById[user.Id] = user
+		totals.employees[user.Id] = &dto.DashboardEmployeeData{
+			ID:   user.Id,
+			Name: user.Name,
+		}
+		if user.IsEmployedOn(headcountDate) {
+			totals.headcount++
+		}
+	}
+
+	attendancesByUser := make(map[int][]*entity.Attendance)
+	for _, attendance := range attendances {
+		empData, exists := totals.employees[attendance.UserId]
+		if !exists {
+			continue
+		}
+		workingHours := CalculateWorkingHours(attendance)
+		empData.TotalHours += workingHours
+		totals.totalHours += workingHours
+		totals.attendances = append(totals.attendances, attendance)
+		attendancesByUser[attendance.UserId] = append(attendancesByUser[attendance.UserId], attendance)
+	}
+
+	for monthStart := time.Date(startDate.Year(), startDate.Month(), 1, 0, 0, 0, 0, time.UTC); !monthStart.After(endDate); monthStart = monthStart.AddDate(0, 1, 0) {
+		monthEnd := monthStart.AddDate(0, 1, -1)
+		lines, err := u.payrollCalculator.CalculateMonth(ctx, monthStart)
+		if err != nil {
+			return nil, err
+		}
+
+		coveredStart, coveredEnd := monthStart, monthEnd
+		if startDate.After(coveredStart) {
+			coveredStart = startDate
+		}
+		if endDate.Before(coveredEnd) {
+			coveredEnd = endDate
+		}
+		fullMonth := coveredStart.Equal(monthStart) && coveredEnd.Equal(monthEnd)
+
+		for _, line := range lines {
+			empData, exists := totals.employees[line.UserId]
+			if !exists {
+				continue
+			}
+
+			salary := line.TotalSalary
+			if !fullMonth {
+				user := usersById[line.UserId]
+				if user.PayType.IsMonthly() {
+					salary = salary * entity.CalendarDays(coveredStart, coveredEnd) / entity.CalendarDays(monthStart, monthEnd)
+				} else {
+					monthAttendances := legacyAttendancesInRange(attendancesByUser[line.UserId], coveredStart, coveredEnd)
+					hours := 0.0
+					for _, attendance := range monthAttendances {
+						hours += CalculateWorkingHours(attendance)
+					}
+					salary = CalculateSalary(user.PayType, user.PayRate, hours, CountWorkedDays(monthAttendances))
+				}
+			}
+
+			empData.TotalSalary += salary
+			totals.totalSalary += salary
+		}
+	}
+
+	return totals, nil
+}
+
+// legacyWeeklyTrend splits the period into Monday-to-Sunday weeks
+func legacyWeeklyTrend(attendances []*entity.Attendance, employees map[int]*dto.DashboardEmployeeData, startDate, endDate time.Time) []dto.DashboardWeek {
+	weeks := make([]dto.DashboardWeek, 0)
+	weekStart := startDate
+	for !weekStart.After(endDate) {
+		// Days until Sunday
+		weekEnd := weekStart.AddDate(0, 0, (7-int(weekStart.Weekday()))%7)
+		if weekEnd.After(endDate) {
+			weekEnd = endDate
+		}
+
+		week := dto.DashboardWeek{
+			WeekStart: weekStart.Format(DateFormat),
+			WeekEnd:   weekEnd.Format(DateFormat),
+		}
+		workedDays := make(map[string]struct{})
+		activeEmployees := make(map[int]struct{})
+		for _, attendance := range legacyAttendancesInRange(attendances, weekStart, weekEnd) {
+			if _, exists := employees[attendance.UserId]; !exists {
+				continue
+			}
+			week.TotalHours += CalculateWorkingHours(attendance)
+			workedDays[fmt.Sprintf("%d:%s", attendance.UserId, attendance.Date.Format(DateFormat))] = struct{}{}
+			activeEmployees[attendance.UserId] = struct{}{}
+		}
+		week.WorkedDays = len(workedDays)
+		week.ActiveEmployees = len(activeEmployees)
+
+		weeks = append(weeks, week)
+		weekStart = weekEnd.AddDate(0, 0, 1)
+	}
+	return weeks
+}
+
+func legacyAttendancesInRange(attendances []*entity.Attendance, startDate, endDate time.Time) []*entity.Attendance {
+	result := make([]*entity.Attendance, 0)
+	for _, attendance := range attendances {
+		date := truncateDate(attendance.Date)
+		if !date.Before(startDate) && !date.After(endDate) {
+			result = append(result, attendance)
+		}
+	}
+	return result
+}
+
+func (u *legacyAdminUseCase) GetPayrollData(ctx context.Context, month string) (*dto.PayrollResponse, error) {
+	// Parse month string (YYYY-MM format)
+	monthTime, err := ParseMonth(month)
+	if err != nil {
+		return nil, err
+	}
+
+	lines, err := u.payrollCalculator.CalculateMonth(ctx, monthTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ToPayrollResponse(lines), nil
+}
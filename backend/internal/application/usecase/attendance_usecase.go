@@ -3,37 +3,74 @@ package usecase
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/attendance_report_app/backend/internal/application/dto"
 	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/domain"
 	"github.com/attendance_report_app/backend/internal/domain/entity"
 	"github.com/attendance_report_app/backend/internal/domain/repository"
-	"github.com/attendance_report_app/backend/internal/infrastructure/slack"
 )
 
 type AttendanceUseCase interface {
 	GetMyAttendances(ctx context.Context, userID int, month *string) (*dto.AttendanceListResponse, error)
+
+	// GetUserAttendances returns another user's attendances as seen by viewerID (ADMIN only).
+	// Reports the viewer may not read are left out.
+	// NOTE: Caller must verify ADMIN role before calling this method
+	GetUserAttendances(ctx context.Context, userID int, viewerID int, month *string) (*dto.AttendanceListResponse, error)
 	CreateAttendance(ctx context.Context, req *request.CreateAttendanceRequest, userID int) (*dto.AttendanceResponse, error)
-	UpdateAttendance(ctx context.Context, id int, req *request.UpdateAttendanceRequest) (*dto.AttendanceResponse, error)
+	// UpdateAttendance updates an attendance record; editorID is recorded in the report history if the report changes
+	UpdateAttendance(ctx context.Context, id int, req *request.UpdateAttendanceRequest, editorID int) (*dto.AttendanceResponse, error)
 }
 
 type attendanceUseCase struct {
-	attendanceRepo repository.AttendanceRepository
-	userRepo       repository.UserRepository
-	slackService   slack.SlackService
+	attendanceRepo      repository.AttendanceRepository
+	userRepo            repository.UserRepository
+	payrollRunRepo      repository.PayrollRunRepository
+	dailyReportRepo     repository.DailyReportRepository
+	summaryService      MonthlySummaryService
+	dailyReportService  DailyReportService
+	notificationService NotificationService
+	webhookService      WebhookService
 }
 
-func NewAttendanceUseCase(attendanceRepo repository.AttendanceRepository, userRepo repository.UserRepository, slackService slack.SlackService) AttendanceUseCase {
+func NewAttendanceUseCase(attendanceRepo repository.AttendanceRepository, userRepo repository.UserRepository, payrollRunRepo repository.PayrollRunRepository, dailyReportRepo repository.DailyReportRepository, summaryService MonthlySummaryService, dailyReportService DailyReportService, notificationService NotificationService, webhookService WebhookService) AttendanceUseCase {
 	return &attendanceUseCase{
-		attendanceRepo: attendanceRepo,
-		userRepo:       userRepo,
-		slackService:   slackService,
+		attendanceRepo:      attendanceRepo,
+		userRepo:            userRepo,
+		payrollRunRepo:      payrollRunRepo,
+		dailyReportRepo:     dailyReportRepo,
+		summaryService:      summaryService,
+		dailyReportService:  dailyReportService,
+		notificationService: notificationService,
+		webhookService:      webhookService,
 	}
 }
 
 func (u *attendanceUseCase) GetMyAttendances(ctx context.Context, userID int, month *string) (*dto.AttendanceListResponse, error) {
+	attendances, reports, err := u.findAttendances(ctx, userID, month)
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToAttendanceListResponse(attendances, reports), nil
+}
+
+func (u *attendanceUseCase) GetUserAttendances(ctx context.Context, userID int, viewerID int, month *string) (*dto.AttendanceListResponse, error) {
+	viewer, err := u.userRepo.FindById(ctx, viewerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	attendances, reports, err := u.findAttendances(ctx, userID, month)
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToAttendanceListResponse(attendances, visibleReports(reports, viewer)), nil
+}
+
+// findAttendances returns the user's attendances, optionally limited to a month, with their daily reports
+func (u *attendanceUseCase) findAttendances(ctx context.Context, userID int, month *string) ([]*entity.Attendance, []*entity.DailyReport, error) {
 	var attendances []*entity.Attendance
 	var err error
 
@@ -41,7 +78,7 @@ func (u *attendanceUseCase) GetMyAttendances(ctx context.Context, userID int, mo
 		// Parse month string (YYYY-MM format)
 		monthTime, err := ParseMonth(*month)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		// Get first and last day of the month
@@ -50,17 +87,37 @@ func (u *attendanceUseCase) GetMyAttendances(ctx context.Context, userID int, mo
 
 		attendances, err = u.attendanceRepo.FindByDatePeriod(ctx, userID, startDate, endDate)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get attendances by period: %w", err)
+			return nil, nil, fmt.Errorf("failed to get attendances by period: %w", err)
 		}
 	} else {
 		// Get all attendances for the user
 		attendances, err = u.attendanceRepo.FindByUserId(ctx, userID)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get all attendances: %w", err)
+			return nil, nil, fmt.Errorf("failed to get all attendances: %w", err)
 		}
 	}
 
-	return dto.ToAttendanceListResponse(attendances), nil
+	ids := make([]int, len(attendances))
+	for i, attendance := range attendances {
+		ids[i] = attendance.Id
+	}
+	reports, err := u.dailyReportRepo.FindByAttendanceIds(ctx, ids)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get daily reports: %w", err)
+	}
+
+	return attendances, reports, nil
+}
+
+// visibleReports drops the reports viewer may not read
+func visibleReports(reports []*entity.DailyReport, viewer *entity.User) []*entity.DailyReport {
+	visible := make([]*entity.DailyReport, 0, len(reports))
+	for _, report := range reports {
+		if report.VisibleTo(viewer) {
+			visible = append(visible, report)
+		}
+	}
+	return visible
 }
 
 func (u *attendanceUseCase) CreateAttendance(ctx context.Context, req *request.CreateAttendanceRequest, userID int) (*dto.AttendanceResponse, error) {
@@ -85,6 +142,11 @@ func (u *attendanceUseCase) CreateAttendance(ctx context.Context, req *request.C
 		return nil, err
 	}
 
+	// Reject records in a month closed by payroll
+	if err := u.ensurePeriodOpen(ctx, date); err != nil {
+		return nil, err
+	}
+
 	// Create attendance entity
 	attendance := &entity.Attendance{
 		UserId:       userID,
@@ -92,7 +154,6 @@ func (u *attendanceUseCase) CreateAttendance(ctx context.Context, req *request.C
 		StartTime:    startTime,
 		EndTime:      endTime,
 		BreakMinutes: req.BreakMinutes,
-		Report:       req.Report,
 	}
 
 	// Save to repository
@@ -101,33 +162,46 @@ func (u *attendanceUseCase) CreateAttendance(ctx context.Context, req *request.C
 		return nil, fmt.Errorf("failed to create attendance: %w", err)
 	}
 
-	// Send Slack notification asynchronously
-	go func() {
-		// Use background context for async operation
-		user, err := u.userRepo.FindById(context.Background(), userID)
-		if err != nil {
-			log.Printf("Failed to get user for Slack notification: %v", err)
-			return
-		}
+	if _, err := u.summaryService.RefreshUserMonth(ctx, userID, date); err != nil {
+		return nil, err
+	}
 
-		// Send Slack notification
-		err = u.slackService.SendAttendanceNotification(
-			user.Name,
-			date,
-			startTime,
-			endTime,
-			req.BreakMinutes,
-			req.Report,
-		)
-		if err != nil {
-			log.Printf("Failed to send Slack notification: %v", err)
-		}
-	}()
+	report, err := u.saveReport(ctx, createdAttendance, req.Report, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Restricted reports are not posted to the shared channel
+	reportText := req.Report
+	if report != nil && !report.IsShared() {
+		reportText = ""
+	}
+
+	user, err := u.userRepo.FindById(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// The notification is queued with the attendance and sent once it is committed
+	err = u.notificationService.Notify(ctx, entity.NotificationAttendanceCreated, fmt.Sprintf("attendance-created:%d", createdAttendance.Id), userID, &AttendanceNotice{
+		UserName:     user.Name,
+		Date:         date,
+		StartTime:    startTime,
+		EndTime:      endTime,
+		BreakMinutes: req.BreakMinutes,
+		Report:       reportText,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := u.webhookService.Publish(ctx, entity.WebhookAttendanceCreated, newWebhookAttendanceData(createdAttendance)); err != nil {
+		return nil, err
+	}
 
-	return dto.ToAttendanceResponse(createdAttendance), nil
+	return dto.ToAttendanceResponse(createdAttendance, report), nil
 }
 
-func (u *attendanceUseCase) UpdateAttendance(ctx context.Context, id int, req *request.UpdateAttendanceRequest) (*dto.AttendanceResponse, error) {
+func (u *attendanceUseCase) UpdateAttendance(ctx context.Context, id int, req *request.UpdateAttendanceRequest, editorID int) (*dto.AttendanceResponse, error) {
 	// Validate request
 	if err := req.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid request: %w", err)
@@ -139,12 +213,21 @@ func (u *attendanceUseCase) UpdateAttendance(ctx context.Context, id int, req *r
 		return nil, fmt.Errorf("failed to find attendance: %w", err)
 	}
 
+	// Neither the current nor the new date may fall in a month closed by payroll
+	if err := u.ensurePeriodOpen(ctx, attendance.Date); err != nil {
+		return nil, err
+	}
+	previousDate := attendance.Date
+
 	// Update fields if provided
 	if req.Date != nil {
 		date, err := ParseDate(*req.Date)
 		if err != nil {
 			return nil, err
 		}
+		if err := u.ensurePeriodOpen(ctx, date); err != nil {
+			return nil, err
+		}
 		attendance.Date = date
 	}
 
@@ -168,15 +251,101 @@ func (u *attendanceUseCase) UpdateAttendance(ctx context.Context, id int, req *r
 		attendance.BreakMinutes = *req.BreakMinutes
 	}
 
-	if req.Report != nil {
-		attendance.Report = *req.Report
-	}
-
 	// Update in repository
 	updatedAttendance, err := u.attendanceRepo.Update(ctx, attendance)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update attendance: %w", err)
 	}
 
-	return dto.ToAttendanceResponse(updatedAttendance), nil
+	if _, err := u.summaryService.RefreshUserMonth(ctx, attendance.UserId, attendance.Date); err != nil {
+		return nil, err
+	}
+	if previousDate.Format("2006-01") != attendance.Date.Format("2006-01") {
+		if _, err := u.summaryService.RefreshUserMonth(ctx, attendance.UserId, previousDate); err != nil {
+			return nil, err
+		}
+	}
+
+	text := ""
+	if req.Report != nil {
+		text = *req.Report
+	}
+	report, err := u.saveReport(ctx, updatedAttendance, text, editorID)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := u.userRepo.FindById(ctx, updatedAttendance.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	reportText := ""
+	if report != nil && report.IsShared() {
+		reportText = report.Content()
+	}
+	// Every update is a separate event, so the key includes when it happened
+	key := fmt.Sprintf("attendance-updated:%d:%d", updatedAttendance.Id, time.Now().UnixNano())
+	err = u.notificationService.Notify(ctx, entity.NotificationAttendanceUpdated, key, owner.Id, &AttendanceNotice{
+		UserName:     owner.Name,
+		Date:         updatedAttendance.Date,
+		StartTime:    updatedAttendance.StartTime,
+		EndTime:      updatedAttendance.EndTime,
+		BreakMinutes: updatedAttendance.BreakMinutes,
+		Report:       reportText,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := u.webhookService.Publish(ctx, entity.WebhookAttendanceUpdated, newWebhookAttendanceData(updatedAttendance)); err != nil {
+		return nil, err
+	}
+
+	// Admins may edit other users' records without being able to read their reports
+	if report != nil && report.UserId != editorID {
+		editor, err := u.userRepo.FindById(ctx, editorID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user: %w", err)
+		}
+		if !report.VisibleTo(editor) {
+			report = nil
+		}
+	}
+
+	return dto.ToAttendanceResponse(updatedAttendance, report), nil
+}
+
+// saveReport links the attendance to the report of its date and, if text is given, publishes
+// it as that report's body. It returns the linked report, if any.
+func (u *attendanceUseCase) saveReport(ctx context.Context, attendance *entity.Attendance, text string, editorID int) (*entity.DailyReport, error) {
+	if err := u.dailyReportService.LinkAttendance(ctx, attendance); err != nil {
+		return nil, err
+	}
+	if text != "" {
+		return u.dailyReportService.SaveAttendanceReport(ctx, attendance, text, editorID)
+	}
+
+	reports, err := u.dailyReportRepo.FindByAttendanceIds(ctx, []int{attendance.Id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily report: %w", err)
+	}
+	if len(reports) == 0 {
+		return nil, nil
+	}
+	return reports[0], nil
+}
+
+// ensurePeriodOpen returns domain.ErrPayrollPeriodLocked if the month containing date has a finalized payroll run.
+// The month's runs stay share-locked until the transaction ends, so a concurrent finalize either
+// waits for this edit or is seen here once it has committed.
+func (u *attendanceUseCase) ensurePeriodOpen(ctx context.Context, date time.Time) error {
+	runs, err := u.payrollRunRepo.FindByMonthForShare(ctx, date.Format("2006-01"))
+	if err != nil {
+		return fmt.Errorf("failed to get payroll runs: %w", err)
+	}
+	for _, run := range runs {
+		if run.IsFinalized() {
+			return domain.ErrPayrollPeriodLocked
+		}
+	}
+	return nil
 }
@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+)
+
+// CalendarUseCase manages the company holiday calendar used to determine scheduled working days
+type CalendarUseCase interface {
+	// GetHolidays returns the holidays of the given year (defaults to the current year)
+	GetHolidays(ctx context.Context, year *string) (*dto.HolidaysResponse, error)
+
+	// CreateHoliday registers a holiday (ADMIN only)
+	// NOTE: Caller must verify ADMIN role before calling this method
+	CreateHoliday(ctx context.Context, req *request.CreateHolidayRequest) (*dto.HolidayResponse, error)
+
+	// DeleteHoliday removes a holiday (ADMIN only)
+	// NOTE: Caller must verify ADMIN role before calling this method
+	DeleteHoliday(ctx context.Context, id int) error
+}
+
+type calendarUseCase struct {
+	holidayRepo    repository.HolidayRepository
+	summaryService MonthlySummaryService
+}
+
+func NewCalendarUseCase(holidayRepo repository.HolidayRepository, summaryService MonthlySummaryService) CalendarUseCase {
+	return &calendarUseCase{
+		holidayRepo:    holidayRepo,
+		summaryService: summaryService,
+	}
+}
+
+func (u *calendarUseCase) GetHolidays(ctx context.Context, year *string) (*dto.HolidaysResponse, error) {
+	y := time.Now().Year()
+	if year != nil && *year != "" {
+		parsed, err := strconv.Atoi(*year)
+		if err != nil {
+			return nil, fmt.Errorf("invalid year format: %w", err)
+		}
+		y = parsed
+	}
+
+	startDate := time.Date(y, time.January, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(y, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	holidays, err := u.holidayRepo.FindByPeriod(ctx, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get holidays: %w", err)
+	}
+
+	return dto.ToHolidaysResponse(holidays), nil
+}
+
+func (u *calendarUseCase) CreateHoliday(ctx context.Context, req *request.CreateHolidayRequest) (*dto.HolidayResponse, error) {
+	// Validate request
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	date, err := ParseDate(req.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	holiday, err := entity.NewHoliday(date, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	createdHoliday, err := u.holidayRepo.Create(ctx, holiday)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create holiday: %w", err)
+	}
+
+	// Working days of the month changed for everyone
+	if err := u.summaryService.MarkStale(ctx, 0, createdHoliday.Date, createdHoliday.Date); err != nil {
+		return nil, err
+	}
+
+	return dto.ToHolidayResponse(createdHoliday), nil
+}
+
+func (u *calendarUseCase) DeleteHoliday(ctx context.Context, id int) error {
+	// Check if holiday exists
+	holiday, err := u.holidayRepo.FindById(ctx, id)
+	if err != nil {
+		return fmt.Errorf("holiday not found: %w", err)
+	}
+
+	if err := u.holidayRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete holiday: %w", err)
+	}
+
+	return u.summaryService.MarkStale(ctx, 0, holiday.Date, holiday.Date)
+}
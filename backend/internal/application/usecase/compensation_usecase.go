@@ -0,0 +1,354 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+)
+
+// CompensationUseCase manages allowance definitions, their per-user assignments and bonus payments.
+// NOTE: Caller must verify ADMIN role before calling any of these methods
+type CompensationUseCase interface {
+	GetAllowances(ctx context.Context) (*dto.AllowancesResponse, error)
+	CreateAllowance(ctx context.Context, req *request.CreateAllowanceRequest) (*dto.AllowanceResponse, error)
+	UpdateAllowance(ctx context.Context, id int, req *request.UpdateAllowanceRequest) (*dto.AllowanceResponse, error)
+	DeleteAllowance(ctx context.Context, id int) error
+
+	GetUserAllowances(ctx context.Context, userID int) (*dto.UserAllowancesResponse, error)
+	AssignAllowance(ctx context.Context, userID int, req *request.AssignAllowanceRequest) (*dto.UserAllowanceResponse, error)
+	UpdateUserAllowance(ctx context.Context, userID int, id int, req *request.UpdateUserAllowanceRequest) (*dto.UserAllowanceResponse, error)
+	DeleteUserAllowance(ctx context.Context, userID int, id int) error
+
+	// GetBonuses returns bonuses, optionally filtered by user
+	GetBonuses(ctx context.Context, userID *int) (*dto.BonusesResponse, error)
+	CreateBonus(ctx context.Context, req *request.CreateBonusRequest) (*dto.BonusResponse, error)
+	DeleteBonus(ctx context.Context, id int) error
+}
+
+type compensationUseCase struct {
+	allowanceRepo  repository.AllowanceRepository
+	bonusRepo      repository.BonusRepository
+	userRepo       repository.UserRepository
+	summaryService MonthlySummaryService
+}
+
+func NewCompensationUseCase(allowanceRepo repository.AllowanceRepository, bonusRepo repository.BonusRepository, userRepo repository.UserRepository, summaryService MonthlySummaryService) CompensationUseCase {
+	return &compensationUseCase{
+		allowanceRepo:  allowanceRepo,
+		bonusRepo:      bonusRepo,
+		userRepo:       userRepo,
+		summaryService: summaryService,
+	}
+}
+
+func (u *compensationUseCase) GetAllowances(ctx context.Context) (*dto.AllowancesResponse, error) {
+	allowances, err := u.allowanceRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get allowances: %w", err)
+	}
+
+	return dto.ToAllowancesResponse(allowances), nil
+}
+
+func (u *compensationUseCase) CreateAllowance(ctx context.Context, req *request.CreateAllowanceRequest) (*dto.AllowanceResponse, error) {
+	// Validate request
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	taxable := true
+	if req.Taxable != nil {
+		taxable = *req.Taxable
+	}
+
+	allowance, err := entity.NewAllowance(req.Name, taxable, req.DefaultAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAllowance, err := u.allowanceRepo.Create(ctx, allowance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create allowance: %w", err)
+	}
+
+	return dto.ToAllowanceResponse(createdAllowance), nil
+}
+
+func (u *compensationUseCase) UpdateAllowance(ctx context.Context, id int, req *request.UpdateAllowanceRequest) (*dto.AllowanceResponse, error) {
+	// Validate request
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	allowance, err := u.allowanceRepo.FindById(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("allowance not found: %w", err)
+	}
+
+	if req.Name != nil {
+		allowance.Name = *req.Name
+	}
+	if req.Taxable != nil {
+		allowance.Taxable = *req.Taxable
+	}
+	if req.DefaultAmount != nil {
+		allowance.DefaultAmount = *req.DefaultAmount
+	}
+
+	if err := allowance.Validate(); err != nil {
+		return nil, err
+	}
+
+	updatedAllowance, err := u.allowanceRepo.Update(ctx, allowance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update allowance: %w", err)
+	}
+
+	// The definition applies to every assignment of it
+	if err := u.summaryService.MarkStale(ctx, 0, time.Time{}, time.Time{}); err != nil {
+		return nil, err
+	}
+
+	return dto.ToAllowanceResponse(updatedAllowance), nil
+}
+
+func (u *compensationUseCase) DeleteAllowance(ctx context.Context, id int) error {
+	// Check if allowance exists
+	if _, err := u.allowanceRepo.FindById(ctx, id); err != nil {
+		return fmt.Errorf("allowance not found: %w", err)
+	}
+
+	if err := u.allowanceRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete allowance: %w", err)
+	}
+
+	// Its assignments are deleted with it
+	return u.summaryService.MarkStale(ctx, 0, time.Time{}, time.Time{})
+}
+
+func (u *compensationUseCase) GetUserAllowances(ctx context.Context, userID int) (*dto.UserAllowancesResponse, error) {
+	if _, err := u.userRepo.FindById(ctx, userID); err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	userAllowances, err := u.allowanceRepo.FindUserAllowancesByUserId(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user allowances: %w", err)
+	}
+
+	return dto.ToUserAllowancesResponse(userAllowances), nil
+}
+
+func (u *compensationUseCase) AssignAllowance(ctx context.Context, userID int, req *request.AssignAllowanceRequest) (*dto.UserAllowanceResponse, error) {
+	// Validate request
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	if _, err := u.userRepo.FindById(ctx, userID); err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	allowance, err := u.allowanceRepo.FindById(ctx, req.AllowanceId)
+	if err != nil {
+		return nil, fmt.Errorf("allowance not found: %w", err)
+	}
+
+	effectiveFrom, err := ParseDate(req.EffectiveFrom)
+	if err != nil {
+		return nil, err
+	}
+
+	var effectiveTo *time.Time
+	if req.EffectiveTo != nil {
+		effectiveTo, err = parseOptionalDate(*req.EffectiveTo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	amount := allowance.DefaultAmount
+	if req.Amount != nil {
+		amount = *req.Amount
+	}
+
+	userAllowance := &entity.UserAllowance{
+		UserId:        userID,
+		AllowanceId:   allowance.Id,
+		Amount:        amount,
+		EffectiveFrom: effectiveFrom,
+		EffectiveTo:   effectiveTo,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	if err := userAllowance.Validate(); err != nil {
+		return nil, err
+	}
+
+	createdUserAllowance, err := u.allowanceRepo.CreateUserAllowance(ctx, userAllowance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to assign allowance: %w", err)
+	}
+
+	if err := u.markUserAllowanceStale(ctx, createdUserAllowance); err != nil {
+		return nil, err
+	}
+
+	return dto.ToUserAllowanceResponse(createdUserAllowance), nil
+}
+
+func (u *compensationUseCase) UpdateUserAllowance(ctx context.Context, userID int, id int, req *request.UpdateUserAllowanceRequest) (*dto.UserAllowanceResponse, error) {
+	// Validate request
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	userAllowance, err := u.findUserAllowance(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	previous := *userAllowance
+
+	if req.Amount != nil {
+		userAllowance.Amount = *req.Amount
+	}
+	if req.EffectiveFrom != nil {
+		effectiveFrom, err := ParseDate(*req.EffectiveFrom)
+		if err != nil {
+			return nil, err
+		}
+		userAllowance.EffectiveFrom = effectiveFrom
+	}
+	if req.EffectiveTo != nil {
+		effectiveTo, err := parseOptionalDate(*req.EffectiveTo)
+		if err != nil {
+			return nil, err
+		}
+		userAllowance.EffectiveTo = effectiveTo
+	}
+
+	if err := userAllowance.Validate(); err != nil {
+		return nil, err
+	}
+
+	updatedUserAllowance, err := u.allowanceRepo.UpdateUserAllowance(ctx, userAllowance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update user allowance: %w", err)
+	}
+
+	// Both the months it used to cover and the ones it covers now
+	if err := u.markUserAllowanceStale(ctx, &previous); err != nil {
+		return nil, err
+	}
+	if err := u.markUserAllowanceStale(ctx, updatedUserAllowance); err != nil {
+		return nil, err
+	}
+
+	return dto.ToUserAllowanceResponse(updatedUserAllowance), nil
+}
+
+func (u *compensationUseCase) DeleteUserAllowance(ctx context.Context, userID int, id int) error {
+	userAllowance, err := u.findUserAllowance(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := u.allowanceRepo.DeleteUserAllowance(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete user allowance: %w", err)
+	}
+
+	return u.markUserAllowanceStale(ctx, userAllowance)
+}
+
+func (u *compensationUseCase) GetBonuses(ctx context.Context, userID *int) (*dto.BonusesResponse, error) {
+	var bonuses []*entity.Bonus
+	var err error
+
+	if userID != nil {
+		bonuses, err = u.bonusRepo.FindByUserId(ctx, *userID)
+	} else {
+		bonuses, err = u.bonusRepo.FindAll(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bonuses: %w", err)
+	}
+
+	return dto.ToBonusesResponse(bonuses), nil
+}
+
+func (u *compensationUseCase) CreateBonus(ctx context.Context, req *request.CreateBonusRequest) (*dto.BonusResponse, error) {
+	// Validate request
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	if _, err := u.userRepo.FindById(ctx, req.UserId); err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	paymentDate, err := ParseDate(req.PaymentDate)
+	if err != nil {
+		return nil, err
+	}
+
+	taxable := true
+	if req.Taxable != nil {
+		taxable = *req.Taxable
+	}
+
+	bonus, err := entity.NewBonus(req.UserId, req.Name, req.Amount, paymentDate, taxable)
+	if err != nil {
+		return nil, err
+	}
+
+	createdBonus, err := u.bonusRepo.Create(ctx, bonus)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bonus: %w", err)
+	}
+
+	if err := u.summaryService.MarkStale(ctx, createdBonus.UserId, createdBonus.PaymentDate, createdBonus.PaymentDate); err != nil {
+		return nil, err
+	}
+
+	return dto.ToBonusResponse(createdBonus), nil
+}
+
+func (u *compensationUseCase) DeleteBonus(ctx context.Context, id int) error {
+	// Check if bonus exists
+	bonus, err := u.bonusRepo.FindById(ctx, id)
+	if err != nil {
+		return fmt.Errorf("bonus not found: %w", err)
+	}
+
+	if err := u.bonusRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete bonus: %w", err)
+	}
+
+	return u.summaryService.MarkStale(ctx, bonus.UserId, bonus.PaymentDate, bonus.PaymentDate)
+}
+
+// markUserAllowanceStale marks the user's summaries of the months the assignment covers for recomputation
+func (u *compensationUseCase) markUserAllowanceStale(ctx context.Context, userAllowance *entity.UserAllowance) error {
+	var effectiveTo time.Time
+	if userAllowance.EffectiveTo != nil {
+		effectiveTo = *userAllowance.EffectiveTo
+	}
+	return u.summaryService.MarkStale(ctx, userAllowance.UserId, userAllowance.EffectiveFrom, effectiveTo)
+}
+
+// findUserAllowance loads an assignment and checks that it belongs to the given user
+func (u *compensationUseCase) findUserAllowance(ctx context.Context, userID int, id int) (*entity.UserAllowance, error) {
+	userAllowance, err := u.allowanceRepo.FindUserAllowanceById(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("user allowance not found: %w", err)
+	}
+	if userAllowance.UserId != userID {
+		return nil, fmt.Errorf("user allowance not found")
+	}
+	return userAllowance, nil
+}
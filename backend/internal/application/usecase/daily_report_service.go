@@ -0,0 +1,270 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+)
+
+// DailyReportService is the single write path for daily reports. Besides storing the
+// report it keeps the edit history, the link to the day's attendance, the hashtags and
+// mentions parsed from the text, and the search index. Users mentioned in a published
+// report are notified once.
+type DailyReportService interface {
+	// Create stores a new report; the user may have only one report per day
+	Create(ctx context.Context, report *entity.DailyReport) (*entity.DailyReport, error)
+
+	// Update stores an edited report. The stored version it replaces is kept as a revision.
+	Update(ctx context.Context, report *entity.DailyReport, editorID int) (*entity.DailyReport, error)
+
+	Delete(ctx context.Context, report *entity.DailyReport) error
+
+	// SaveAttendanceReport publishes text entered together with an attendance record as
+	// the body of that day's report, creating the report if needed
+	SaveAttendanceReport(ctx context.Context, attendance *entity.Attendance, text string, editorID int) (*entity.DailyReport, error)
+
+	// LinkAttendance links the attendance to the report of its date, if there is one
+	LinkAttendance(ctx context.Context, attendance *entity.Attendance) error
+}
+
+type dailyReportService struct {
+	dailyReportRepo     repository.DailyReportRepository
+	attendanceRepo      repository.AttendanceRepository
+	tagRepo             repository.ReportTagRepository
+	userRepo            repository.UserRepository
+	searchService       ReportSearchService
+	notificationService NotificationService
+}
+
+func NewDailyReportService(dailyReportRepo repository.DailyReportRepository, attendanceRepo repository.AttendanceRepository, tagRepo repository.ReportTagRepository, userRepo repository.UserRepository, searchService ReportSearchService, notificationService NotificationService) DailyReportService {
+	return &dailyReportService{
+		dailyReportRepo:     dailyReportRepo,
+		attendanceRepo:      attendanceRepo,
+		tagRepo:             tagRepo,
+		userRepo:            userRepo,
+		searchService:       searchService,
+		notificationService: notificationService,
+	}
+}
+
+func (s *dailyReportService) Create(ctx context.Context, report *entity.DailyReport) (*entity.DailyReport, error) {
+	existing, err := s.dailyReportRepo.FindByUserAndDate(ctx, report.UserId, report.Date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily report: %w", err)
+	}
+	if existing != nil {
+		return nil, domain.ErrDailyReportExists
+	}
+
+	if report.AttendanceId == nil {
+		attendanceID, err := s.attendanceOn(ctx, report.UserId, report.Date)
+		if err != nil {
+			return nil, err
+		}
+		report.AttendanceId = attendanceID
+	}
+
+	createdReport, err := s.dailyReportRepo.Create(ctx, report)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create daily report: %w", err)
+	}
+
+	if err := s.syncTags(ctx, createdReport); err != nil {
+		return nil, err
+	}
+	s.searchService.IndexReport(ctx, createdReport)
+	return createdReport, nil
+}
+
+func (s *dailyReportService) Update(ctx context.Context, report *entity.DailyReport, editorID int) (*entity.DailyReport, error) {
+	stored, err := s.dailyReportRepo.FindById(ctx, report.Id)
+	if err != nil {
+		return nil, domain.ErrDailyReportNotFound
+	}
+
+	if _, err := s.dailyReportRepo.CreateRevision(ctx, stored.Revision(editorID)); err != nil {
+		return nil, fmt.Errorf("failed to save daily report revision: %w", err)
+	}
+
+	report.Version = stored.Version + 1
+	updatedReport, err := s.dailyReportRepo.Update(ctx, report)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update daily report: %w", err)
+	}
+
+	if err := s.syncTags(ctx, updatedReport); err != nil {
+		return nil, err
+	}
+	s.searchService.IndexReport(ctx, updatedReport)
+	return updatedReport, nil
+}
+
+func (s *dailyReportService) Delete(ctx context.Context, report *entity.DailyReport) error {
+	if err := s.dailyReportRepo.Delete(ctx, report.Id); err != nil {
+		return fmt.Errorf("failed to delete daily report: %w", err)
+	}
+
+	s.searchService.RemoveReport(ctx, report.Id)
+	return nil
+}
+
+func (s *dailyReportService) SaveAttendanceReport(ctx context.Context, attendance *entity.Attendance, text string, editorID int) (*entity.DailyReport, error) {
+	report, err := s.dailyReportRepo.FindByUserAndDate(ctx, attendance.UserId, attendance.Date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily report: %w", err)
+	}
+
+	if report == nil {
+		report, err = entity.NewDailyReport(attendance.UserId, attendance.Date, nil, nil, text, entity.ReportStatusPublished)
+		if err != nil {
+			return nil, err
+		}
+		report.AttendanceId = &attendance.Id
+		return s.Create(ctx, report)
+	}
+
+	if report.Body == text && report.IsPublished() {
+		return report, nil
+	}
+	report.Body = text
+	report.Publish(time.Now())
+	if report.AttendanceId == nil {
+		report.AttendanceId = &attendance.Id
+	}
+	if err := report.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Update(ctx, report, editorID)
+}
+
+func (s *dailyReportService) LinkAttendance(ctx context.Context, attendance *entity.Attendance) error {
+	if err := s.dailyReportRepo.LinkAttendance(ctx, attendance.UserId, attendance.Date, attendance.Id); err != nil {
+		return fmt.Errorf("failed to link attendance to daily report: %w", err)
+	}
+	return nil
+}
+
+// syncTags stores the report's hashtags and mentions and notifies newly mentioned users
+// once the report is published
+func (s *dailyReportService) syncTags(ctx context.Context, report *entity.DailyReport) error {
+	tags, handles := entity.ParseReportText(report.Content())
+	if err := s.tagRepo.ReplaceTags(ctx, report.Id, tags); err != nil {
+		return fmt.Errorf("failed to save report tags: %w", err)
+	}
+
+	var users []*entity.User
+	if len(handles) > 0 {
+		var err error
+		users, err = s.userRepo.FindAll(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get users: %w", err)
+		}
+	}
+	mentioned := mentionedUserIds(users, handles)
+	if err := s.tagRepo.ReplaceMentions(ctx, report.Id, mentioned); err != nil {
+		return fmt.Errorf("failed to save report mentions: %w", err)
+	}
+
+	if !report.IsPublished() || len(mentioned) == 0 {
+		return nil
+	}
+	pending, err := s.tagRepo.FindUnnotifiedMentions(ctx, report.Id)
+	if err != nil {
+		return fmt.Errorf("failed to get report mentions: %w", err)
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	usersById := make(map[int]*entity.User, len(users))
+	for _, user := range users {
+		usersById[user.Id] = user
+	}
+	authorName := report.UserName
+	if author := usersById[report.UserId]; author != nil {
+		authorName = author.Name
+		report.Department = author.Department
+	}
+
+	// Users who cannot read the report stay pending, so they are notified if it is shared with them later
+	var notified []int
+	var recipients []*entity.User
+	for _, id := range pending {
+		user := usersById[id]
+		if user == nil || !report.VisibleTo(user) {
+			continue
+		}
+		notified = append(notified, id)
+		// Mentioning yourself is allowed but not worth a notification
+		if id != report.UserId {
+			recipients = append(recipients, user)
+		}
+	}
+	if len(notified) == 0 {
+		return nil
+	}
+	if err := s.tagRepo.MarkMentionsNotified(ctx, report.Id, notified, time.Now()); err != nil {
+		return fmt.Errorf("failed to save report mentions: %w", err)
+	}
+
+	// The notification goes to the shared channel, so restricted reports are not quoted
+	date, content := report.Date, ""
+	if report.IsShared() {
+		content = report.Content()
+	}
+
+	// Mentions are marked as notified in the same transaction, so each user is notified once per report.
+	// Every user gets a notification of their own, sent as their preferences say.
+	for _, user := range recipients {
+		key := fmt.Sprintf("report-mention:%d:%d", report.Id, user.Id)
+		err := s.notificationService.Notify(ctx, entity.NotificationReportMentioned, key, user.Id, &MentionNotice{
+			Mentioned:    []string{user.Name},
+			ReportAuthor: authorName,
+			ReportDate:   date,
+			Report:       content,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mentionedUserIds resolves mention handles to the IDs of the users they refer to
+func mentionedUserIds(users []*entity.User, handles []string) []int {
+	var ids []int
+	seen := make(map[int]bool)
+	for _, handle := range handles {
+		for _, user := range users {
+			if !seen[user.Id] && user.MatchesMention(handle) {
+				seen[user.Id] = true
+				ids = append(ids, user.Id)
+				break
+			}
+		}
+	}
+	return ids
+}
+
+// attendanceOn returns the ID of the user's first attendance on date, or nil if there is none
+func (s *dailyReportService) attendanceOn(ctx context.Context, userID int, date time.Time) (*int, error) {
+	attendances, err := s.attendanceRepo.FindByDatePeriod(ctx, userID, date, date.AddDate(0, 0, 1).Add(-time.Second))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attendances: %w", err)
+	}
+	if len(attendances) == 0 {
+		return nil, nil
+	}
+
+	first := attendances[0]
+	for _, attendance := range attendances[1:] {
+		if attendance.StartTime.Before(first.StartTime) {
+			first = attendance
+		}
+	}
+	return &first.Id, nil
+}
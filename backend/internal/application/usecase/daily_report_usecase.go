@@ -3,78 +3,520 @@ package usecase
 import (
 	"context"
 	"fmt"
-	"sort"
+	"time"
 
 	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/domain"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
 	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/search"
 )
 
 type DailyReportUseCase interface {
-	// GetAllDailyReports returns all daily reports from all users, sorted by date descending
-	GetAllDailyReports(ctx context.Context) (*dto.DailyReportsResponse, error)
+	// GetAllDailyReports returns one page of published reports from all users matching the filters, sorted by date descending,
+	// with the caller's read state and unread count
+	GetAllDailyReports(ctx context.Context, userID int, req *request.GetDailyReportsRequest) (*dto.DailyReportsPageResponse, error)
+
+	// GetMyDailyReports returns one page of the user's own reports, including drafts
+	GetMyDailyReports(ctx context.Context, userID int, req *request.GetDailyReportsRequest) (*dto.PaginationResponse, error)
+
+	// GetDailyReport returns a published report, or one of the user's own drafts. Reading someone else's report marks it as read.
+	GetDailyReport(ctx context.Context, id int, userID int) (*dto.DailyReportResponse, error)
+
+	// MarkDailyReportRead marks a report as read by the user
+	MarkDailyReportRead(ctx context.Context, id int, userID int) error
+
+	// MarkAllDailyReportsRead marks every published report by others as read by the user
+	MarkAllDailyReportsRead(ctx context.Context, userID int) (*dto.MarkAllReadResponse, error)
+
+	// GetDailyReportReaders lists who has read a report; only its author may see them
+	GetDailyReportReaders(ctx context.Context, id int, userID int) (*dto.ReportReadersResponse, error)
+
+	// GetDailyReportHistory returns a report with its earlier versions
+	GetDailyReportHistory(ctx context.Context, id int, userID int) (*dto.DailyReportHistoryResponse, error)
+
+	CreateDailyReport(ctx context.Context, userID int, req *request.CreateDailyReportRequest) (*dto.DailyReportResponse, error)
+
+	// UpdateDailyReport edits one of the user's own reports
+	UpdateDailyReport(ctx context.Context, id int, userID int, req *request.UpdateDailyReportRequest) (*dto.DailyReportResponse, error)
+
+	// DeleteDailyReport deletes a report; authors may delete their own, admins any
+	DeleteDailyReport(ctx context.Context, id int, userID int) error
+
+	// GetTagCloud counts the published reports the user may read per hashtag, most used first
+	GetTagCloud(ctx context.Context, userID int, req *request.GetTagCloudRequest) (*dto.TagCloudResponse, error)
+
+	// SearchDailyReports returns one page of the published reports the user may read matching the query, most relevant first
+	SearchDailyReports(ctx context.Context, userID int, req *request.SearchDailyReportsRequest) (*dto.PaginationResponse, error)
+
+	// RebuildSearchIndex rebuilds the full-text index from the database (ADMIN only)
+	// NOTE: Caller must verify ADMIN role before calling this method
+	RebuildSearchIndex(ctx context.Context) (*dto.SearchIndexResponse, error)
 }
 
 type dailyReportUseCase struct {
-	attendanceRepo repository.AttendanceRepository
-	userRepo       repository.UserRepository
+	dailyReportRepo    repository.DailyReportRepository
+	templateRepo       repository.ReportTemplateRepository
+	readRepo           repository.ReportReadRepository
+	tagRepo            repository.ReportTagRepository
+	userRepo           repository.UserRepository
+	dailyReportService DailyReportService
+	searchService      ReportSearchService
 }
 
-func NewDailyReportUseCase(attendanceRepo repository.AttendanceRepository, userRepo repository.UserRepository) DailyReportUseCase {
+func NewDailyReportUseCase(dailyReportRepo repository.DailyReportRepository, templateRepo repository.ReportTemplateRepository, readRepo repository.ReportReadRepository, tagRepo repository.ReportTagRepository, userRepo repository.UserRepository, dailyReportService DailyReportService, searchService ReportSearchService) DailyReportUseCase {
 	return &dailyReportUseCase{
-		attendanceRepo: attendanceRepo,
-		userRepo:       userRepo,
+		dailyReportRepo:    dailyReportRepo,
+		templateRepo:       templateRepo,
+		readRepo:           readRepo,
+		tagRepo:            tagRepo,
+		userRepo:           userRepo,
+		dailyReportService: dailyReportService,
+		searchService:      searchService,
 	}
 }
 
-func (u *dailyReportUseCase) GetAllDailyReports(ctx context.Context) (*dto.DailyReportsResponse, error) {
-	// Get all attendances (which contain the reports)
-	attendances, err := u.attendanceRepo.FindAll(ctx)
+func (u *dailyReportUseCase) GetAllDailyReports(ctx context.Context, userID int, req *request.GetDailyReportsRequest) (*dto.DailyReportsPageResponse, error) {
+	filter, err := reportFilter(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get attendances: %w", err)
+		return nil, err
 	}
+	viewer, err := u.userRepo.FindById(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+	filter.Status = entity.ReportStatusPublished
+	filter.Viewer = viewer
+	filter.ReaderId = userID
+	filter.Unread = req.Unread
 
-	// Create a map to cache user information
-	userCache := make(map[int]string)
+	page, err := u.findReports(ctx, filter, req)
+	if err != nil {
+		return nil, err
+	}
 
-	// Convert attendances to daily reports
-	reports := make([]dto.DailyReportResponse, 0, len(attendances))
+	unread, err := u.dailyReportRepo.CountUnread(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count unread daily reports: %w", err)
+	}
 
-	for _, attendance := range attendances {
-		// Skip if no report
-		if attendance.Report == "" {
-			continue
+	return &dto.DailyReportsPageResponse{PaginationResponse: *page, UnreadCount: unread}, nil
+}
+
+func (u *dailyReportUseCase) GetMyDailyReports(ctx context.Context, userID int, req *request.GetDailyReportsRequest) (*dto.PaginationResponse, error) {
+	filter, err := reportFilter(req)
+	if err != nil {
+		return nil, err
+	}
+	filter.UserId = userID
+	filter.Status = entity.ReportStatus(req.Status)
+
+	return u.findReports(ctx, filter, req)
+}
+
+func (u *dailyReportUseCase) findReports(ctx context.Context, filter repository.DailyReportFilter, req *request.GetDailyReportsRequest) (*dto.PaginationResponse, error) {
+	reports, total, err := u.dailyReportRepo.FindByFilter(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily reports: %w", err)
+	}
+
+	return dto.ToPaginationResponse(dto.ToDailyReportsResponse(reports).Reports, total, req.Page, req.PerPage), nil
+}
+
+// reportFilter validates the request and converts it into a repository filter
+func reportFilter(req *request.GetDailyReportsRequest) (repository.DailyReportFilter, error) {
+	if err := req.Validate(); err != nil {
+		return repository.DailyReportFilter{}, fmt.Errorf("invalid request: %w", err)
+	}
+
+	filter := repository.DailyReportFilter{
+		UserId:     req.UserId,
+		Department: req.Department,
+		Keyword:    req.Keyword,
+		Tag:        entity.NormalizeTag(req.Tag),
+		Offset:     (req.Page - 1) * req.PerPage,
+		Limit:      req.PerPage,
+	}
+	if req.From != "" {
+		from, err := ParseDate(req.From)
+		if err != nil {
+			return filter, err
+		}
+		filter.StartDate = &from
+	}
+	if req.To != "" {
+		to, err := ParseDate(req.To)
+		if err != nil {
+			return filter, err
+		}
+		filter.EndDate = &to
+	}
+	if filter.StartDate != nil && filter.EndDate != nil && filter.EndDate.Before(*filter.StartDate) {
+		return filter, fmt.Errorf("from date must not be after to date")
+	}
+	return filter, nil
+}
+
+func (u *dailyReportUseCase) GetDailyReport(ctx context.Context, id int, userID int) (*dto.DailyReportResponse, error) {
+	report, err := findVisibleReport(ctx, u.dailyReportRepo, u.userRepo, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.markRead(ctx, report, userID); err != nil {
+		return nil, err
+	}
+	read := true
+	report.Read = &read
+
+	return dto.ToDailyReportResponse(report), nil
+}
+
+func (u *dailyReportUseCase) MarkDailyReportRead(ctx context.Context, id int, userID int) error {
+	report, err := findVisibleReport(ctx, u.dailyReportRepo, u.userRepo, id, userID)
+	if err != nil {
+		return err
+	}
+	return u.markRead(ctx, report, userID)
+}
+
+// markRead records the read of a published report by someone other than its author
+func (u *dailyReportUseCase) markRead(ctx context.Context, report *entity.DailyReport, userID int) error {
+	if !report.IsPublished() || report.UserId == userID {
+		return nil
+	}
+	if err := u.readRepo.MarkRead(ctx, report.Id, userID, time.Now()); err != nil {
+		return fmt.Errorf("failed to mark daily report as read: %w", err)
+	}
+	return nil
+}
+
+func (u *dailyReportUseCase) MarkAllDailyReportsRead(ctx context.Context, userID int) (*dto.MarkAllReadResponse, error) {
+	viewer, err := u.userRepo.FindById(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	marked, err := u.readRepo.MarkAllRead(ctx, viewer, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark daily reports as read: %w", err)
+	}
+	return &dto.MarkAllReadResponse{Marked: marked}, nil
+}
+
+func (u *dailyReportUseCase) GetDailyReportReaders(ctx context.Context, id int, userID int) (*dto.ReportReadersResponse, error) {
+	report, err := findVisibleReport(ctx, u.dailyReportRepo, u.userRepo, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if report.UserId != userID {
+		return nil, domain.ErrForbidden
+	}
+
+	reads, err := u.readRepo.FindByReportId(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily report readers: %w", err)
+	}
+	return dto.ToReportReadersResponse(reads), nil
+}
+
+func (u *dailyReportUseCase) GetDailyReportHistory(ctx context.Context, id int, userID int) (*dto.DailyReportHistoryResponse, error) {
+	report, err := findVisibleReport(ctx, u.dailyReportRepo, u.userRepo, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := u.dailyReportRepo.FindRevisions(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily report history: %w", err)
+	}
+	return dto.ToDailyReportHistoryResponse(report, revisions), nil
+}
+
+// findVisibleReport returns the report if the user may read it. Drafts and reports outside the
+// user's visibility are reported as not found, so that their existence is not revealed.
+func findVisibleReport(ctx context.Context, dailyReportRepo repository.DailyReportRepository, userRepo repository.UserRepository, id int, userID int) (*entity.DailyReport, error) {
+	report, err := dailyReportRepo.FindById(ctx, id)
+	if err != nil {
+		return nil, domain.ErrDailyReportNotFound
+	}
+	if report.UserId == userID {
+		return report, nil
+	}
+
+	viewer, err := userRepo.FindById(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+	if !report.VisibleTo(viewer) {
+		return nil, domain.ErrDailyReportNotFound
+	}
+	return report, nil
+}
+
+func (u *dailyReportUseCase) CreateDailyReport(ctx context.Context, userID int, req *request.CreateDailyReportRequest) (*dto.DailyReportResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	date, err := ParseDate(req.Date)
+	if err != nil {
+		return nil, err
+	}
+	status := entity.ReportStatus(req.Status)
+	if err := status.Validate(); err != nil {
+		return nil, err
+	}
+	visibility := entity.ReportVisibility(req.Visibility)
+	if err := visibility.Validate(); err != nil {
+		return nil, err
+	}
+
+	sections := toReportSections(req.Sections)
+	if req.TemplateId != nil {
+		template, err := u.templateRepo.FindById(ctx, *req.TemplateId)
+		if err != nil {
+			return nil, fmt.Errorf("report template not found: %w", err)
+		}
+		if !template.Active {
+			return nil, fmt.Errorf("report template %s is no longer in use", template.Name)
+		}
+		if len(sections) == 0 {
+			sections = template.EmptySections()
 		}
+		if err := template.CheckSections(sections, status == entity.ReportStatusPublished); err != nil {
+			return nil, err
+		}
+	}
+
+	report, err := entity.NewDailyReport(userID, date, req.TemplateId, sections, req.Body, status)
+	if err != nil {
+		return nil, err
+	}
+	report.Visibility = visibility
 
-		// Get user name from cache or fetch from repository
-		userName, ok := userCache[attendance.UserId]
-		if !ok {
-			user, err := u.userRepo.FindById(ctx, attendance.UserId)
-			if err != nil {
-				// Log error but continue processing
-				userName = "Unknown User"
-			} else {
-				userName = user.Name
-				userCache[attendance.UserId] = userName
+	createdReport, err := u.dailyReportService.Create(ctx, report)
+	if err != nil {
+		return nil, err
+	}
+	return u.reportResponse(ctx, createdReport.Id)
+}
+
+func (u *dailyReportUseCase) UpdateDailyReport(ctx context.Context, id int, userID int, req *request.UpdateDailyReportRequest) (*dto.DailyReportResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	report, err := findVisibleReport(ctx, u.dailyReportRepo, u.userRepo, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if report.UserId != userID {
+		return nil, domain.ErrForbidden
+	}
+
+	if req.Sections != nil {
+		sections := toReportSections(req.Sections)
+		if !sameHeadings(report.Sections, sections) {
+			return nil, fmt.Errorf("sections must keep their headings")
+		}
+		report.Sections = sections
+	}
+	if req.Body != nil {
+		report.Body = *req.Body
+	}
+	if req.Status != nil {
+		status := entity.ReportStatus(*req.Status)
+		if err := status.Validate(); err != nil {
+			return nil, err
+		}
+		if report.IsPublished() && status == entity.ReportStatusDraft {
+			return nil, fmt.Errorf("a published report cannot be turned back into a draft")
+		}
+		if status == entity.ReportStatusPublished {
+			report.Publish(time.Now())
+		}
+	}
+	if req.Visibility != nil {
+		visibility := entity.ReportVisibility(*req.Visibility)
+		if err := visibility.Validate(); err != nil {
+			return nil, err
+		}
+		report.Visibility = visibility
+	}
+
+	if report.IsPublished() && report.TemplateId != nil {
+		// Templates may have changed since the report was written; only enforce them while they still match
+		template, err := u.templateRepo.FindById(ctx, *report.TemplateId)
+		if err == nil && sameHeadings(template.EmptySections(), report.Sections) {
+			if err := template.CheckSections(report.Sections, true); err != nil {
+				return nil, err
 			}
 		}
+	}
+	if err := report.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := u.dailyReportService.Update(ctx, report, userID); err != nil {
+		return nil, err
+	}
+	return u.reportResponse(ctx, id)
+}
+
+func (u *dailyReportUseCase) DeleteDailyReport(ctx context.Context, id int, userID int) error {
+	report, err := u.dailyReportRepo.FindById(ctx, id)
+	if err != nil {
+		return domain.ErrDailyReportNotFound
+	}
+
+	if report.UserId != userID {
+		user, err := u.userRepo.FindById(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("user not found: %w", err)
+		}
+		if !user.IsAdmin() {
+			return domain.ErrForbidden
+		}
+	}
+
+	return u.dailyReportService.Delete(ctx, report)
+}
 
-		// Create daily report response
-		report := dto.DailyReportResponse{
-			Id:       attendance.Id,
-			UserId:   attendance.UserId,
-			Date:     attendance.Date,
-			Report:   attendance.Report,
-			UserName: userName,
+// reportResponse re-reads a report so that the response includes the author details
+func (u *dailyReportUseCase) reportResponse(ctx context.Context, id int) (*dto.DailyReportResponse, error) {
+	report, err := u.dailyReportRepo.FindById(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily report: %w", err)
+	}
+	return dto.ToDailyReportResponse(report), nil
+}
+
+func (u *dailyReportUseCase) GetTagCloud(ctx context.Context, userID int, req *request.GetTagCloudRequest) (*dto.TagCloudResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	viewer, err := u.userRepo.FindById(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	filter := repository.TagCountFilter{
+		Department: req.Department,
+		Viewer:     viewer,
+		Limit:      req.Limit,
+	}
+	if req.From != "" {
+		from, err := ParseDate(req.From)
+		if err != nil {
+			return nil, err
+		}
+		filter.StartDate = &from
+	}
+	if req.To != "" {
+		to, err := ParseDate(req.To)
+		if err != nil {
+			return nil, err
 		}
+		filter.EndDate = &to
+	}
+
+	counts, err := u.tagRepo.CountTags(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count report tags: %w", err)
+	}
+	return dto.ToTagCloudResponse(counts), nil
+}
 
-		reports = append(reports, report)
+func (u *dailyReportUseCase) SearchDailyReports(ctx context.Context, userID int, req *request.SearchDailyReportsRequest) (*dto.PaginationResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
 	}
 
-	// Sort reports by date descending (newest first)
-	sort.Slice(reports, func(i, j int) bool {
-		return reports[i].Date.After(reports[j].Date)
-	})
+	viewer, err := u.userRepo.FindById(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	// The index holds every published report, so the hits are narrowed down to the ones the
+	// viewer may read before paging
+	allHits, _ := u.searchService.Search(req.Q, 0, 0)
+	allIds := make([]int, len(allHits))
+	scores := make(map[int]float64, len(allHits))
+	for i, hit := range allHits {
+		allIds[i] = hit.Id
+		scores[hit.Id] = hit.Score
+	}
+	visibleIds, err := u.dailyReportRepo.FindVisibleIds(ctx, allIds, viewer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily reports: %w", err)
+	}
 
-	return dto.ToDailyReportsResponse(reports), nil
+	total := len(visibleIds)
+	start := min((req.Page-1)*req.PerPage, total)
+	end := min(start+req.PerPage, total)
+	hits := make([]search.Hit, 0, end-start)
+	for _, id := range visibleIds[start:end] {
+		hits = append(hits, search.Hit{Id: id, Score: scores[id]})
+	}
+
+	ids := make([]int, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.Id
+	}
+	reports, err := u.dailyReportRepo.FindByIds(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily reports: %w", err)
+	}
+	reportsById := make(map[int]*entity.DailyReport, len(reports))
+	for _, report := range reports {
+		reportsById[report.Id] = report
+	}
+
+	// Keep the relevance order; hits whose report has gone since indexing are skipped
+	results := make([]dto.DailyReportSearchResult, 0, len(hits))
+	for _, hit := range hits {
+		report, ok := reportsById[hit.Id]
+		if !ok || !report.IsPublished() {
+			continue
+		}
+		results = append(results, dto.DailyReportSearchResult{
+			DailyReportResponse: *dto.ToDailyReportResponse(report),
+			Snippet:             search.Highlight(report.Content(), req.Q),
+			Score:               hit.Score,
+		})
+	}
+
+	return dto.ToPaginationResponse(results, int64(total), req.Page, req.PerPage), nil
 }
 
+func (u *dailyReportUseCase) RebuildSearchIndex(ctx context.Context) (*dto.SearchIndexResponse, error) {
+	documents, err := u.searchService.Rebuild(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rebuild search index: %w", err)
+	}
+	return &dto.SearchIndexResponse{Documents: documents}, nil
+}
+
+func toReportSections(sections []request.ReportSectionRequest) []entity.ReportSection {
+	result := make([]entity.ReportSection, len(sections))
+	for i, section := range sections {
+		result[i] = entity.ReportSection{Heading: section.Heading, Body: section.Body}
+	}
+	return result
+}
+
+// sameHeadings reports whether both lists have the same section headings in the same order
+func sameHeadings(a, b []entity.ReportSection) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Heading != b[i].Heading {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+)
+
+// EarningsUseCase lets a user view their own estimated pay
+type EarningsUseCase interface {
+	// GetMyEarnings returns the user's hours and pay per month between req.From and req.To (inclusive).
+	// Both default to the current month. Months closed by a finalized payroll run return the snapshot,
+	// other past months the materialized monthly summary (without itemization).
+	GetMyEarnings(ctx context.Context, userID int, req *request.GetEarningsRequest) (*dto.EarningsResponse, error)
+}
+
+type earningsUseCase struct {
+	userRepo          repository.UserRepository
+	payrollRunRepo    repository.PayrollRunRepository
+	payrollCalculator PayrollCalculator
+	summaryService    MonthlySummaryService
+}
+
+func NewEarningsUseCase(userRepo repository.UserRepository, payrollRunRepo repository.PayrollRunRepository, payrollCalculator PayrollCalculator, summaryService MonthlySummaryService) EarningsUseCase {
+	return &earningsUseCase{
+		userRepo:          userRepo,
+		payrollRunRepo:    payrollRunRepo,
+		payrollCalculator: payrollCalculator,
+		summaryService:    summaryService,
+	}
+}
+
+func (u *earningsUseCase) GetMyEarnings(ctx context.Context, userID int, req *request.GetEarningsRequest) (*dto.EarningsResponse, error) {
+	// Validate request
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	now := time.Now()
+	currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	fromMonth, err := ParseMonth(req.From)
+	if err != nil {
+		return nil, err
+	}
+	toMonth, err := ParseMonth(req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := u.userRepo.FindById(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	response := &dto.EarningsResponse{
+		From:   fromMonth.Format("2006-01"),
+		To:     toMonth.Format("2006-01"),
+		Goal:   user.Goal,
+		Months: make([]dto.MonthEarnings, 0),
+	}
+
+	for month := fromMonth; !month.After(toMonth); month = month.AddDate(0, 1, 0) {
+		monthEarnings, err := u.monthEarnings(ctx, user, month, currentMonth)
+		if err != nil {
+			return nil, err
+		}
+
+		response.TotalHours += monthEarnings.TotalHours
+		response.TotalEarnings += monthEarnings.EstimatedPay
+		response.Months = append(response.Months, monthEarnings)
+	}
+
+	return response, nil
+}
+
+// monthEarnings reads a finalized snapshot if there is one, the materialized summary for
+// other past months, and calculates the current and future months live
+func (u *earningsUseCase) monthEarnings(ctx context.Context, user *entity.User, month, currentMonth time.Time) (dto.MonthEarnings, error) {
+	monthStr := month.Format("2006-01")
+
+	line, finalized, err := finalizedUserLine(ctx, u.payrollRunRepo, user.Id, month)
+	if err != nil {
+		return dto.MonthEarnings{}, err
+	}
+	if finalized {
+		return dto.ToMonthEarnings(monthStr, line, true, user.Goal), nil
+	}
+
+	if month.Before(currentMonth) {
+		summary, err := u.summaryService.UserMonthSummary(ctx, user.Id, month)
+		if err != nil {
+			return dto.MonthEarnings{}, err
+		}
+		return dto.ToMonthEarningsFromSummary(monthStr, summary, user.Goal), nil
+	}
+
+	line, err = u.payrollCalculator.CalculateUserMonth(ctx, user, month)
+	if err != nil {
+		return dto.MonthEarnings{}, err
+	}
+	return dto.ToMonthEarnings(monthStr, line, false, user.Goal), nil
+}
+
+// userMonthLine returns the user's line from the month's finalized run if there is one,
+// otherwise a live calculation. The bool reports whether the figures are finalized.
+func userMonthLine(ctx context.Context, payrollRunRepo repository.PayrollRunRepository, payrollCalculator PayrollCalculator, user *entity.User, month time.Time) (*entity.PayrollLine, bool, error) {
+	line, finalized, err := finalizedUserLine(ctx, payrollRunRepo, user.Id, month)
+	if err != nil || finalized {
+		return line, finalized, err
+	}
+
+	line, err = payrollCalculator.CalculateUserMonth(ctx, user, month)
+	if err != nil {
+		return nil, false, err
+	}
+	return line, false, nil
+}
+
+// finalizedUserLine returns the user's line from the month's finalized run. The bool reports
+// whether the month is finalized; the line is nil if the user is not on that run.
+func finalizedUserLine(ctx context.Context, payrollRunRepo repository.PayrollRunRepository, userID int, month time.Time) (*entity.PayrollLine, bool, error) {
+	runs, err := payrollRunRepo.FindByMonth(ctx, month.Format("2006-01"))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get payroll runs: %w", err)
+	}
+
+	for _, run := range runs {
+		if !run.IsFinalized() {
+			continue
+		}
+		lines, err := payrollRunRepo.FindLinesByRunId(ctx, run.Id)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to get payroll lines: %w", err)
+		}
+		for _, line := range lines {
+			if line.UserId == userID {
+				return line, true, nil
+			}
+		}
+		return nil, true, nil
+	}
+
+	return nil, false, nil
+}
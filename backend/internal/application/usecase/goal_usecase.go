@@ -0,0 +1,280 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+)
+
+// GoalUseCase manages a user's hours and income goals and computes progress towards them
+type GoalUseCase interface {
+	// GetMyGoals returns the active goals and the full goal history
+	GetMyGoals(ctx context.Context, userID int) (*dto.GoalsResponse, error)
+
+	// SetGoal replaces the active goal of the same type and period, keeping the old one as history
+	SetGoal(ctx context.Context, userID int, req *request.SetGoalRequest) (*dto.GoalResponse, error)
+
+	// GetGoalProgress computes actual versus target for every active goal as of date (defaults to today)
+	GetGoalProgress(ctx context.Context, userID int, date *string) (*dto.GoalProgressResponse, error)
+}
+
+type goalUseCase struct {
+	goalRepo          repository.GoalRepository
+	userRepo          repository.UserRepository
+	attendanceRepo    repository.AttendanceRepository
+	holidayRepo       repository.HolidayRepository
+	payrollRunRepo    repository.PayrollRunRepository
+	payrollCalculator PayrollCalculator
+}
+
+func NewGoalUseCase(
+	goalRepo repository.GoalRepository,
+	userRepo repository.UserRepository,
+	attendanceRepo repository.AttendanceRepository,
+	holidayRepo repository.HolidayRepository,
+	payrollRunRepo repository.PayrollRunRepository,
+	payrollCalculator PayrollCalculator,
+) GoalUseCase {
+	return &goalUseCase{
+		goalRepo:          goalRepo,
+		userRepo:          userRepo,
+		attendanceRepo:    attendanceRepo,
+		holidayRepo:       holidayRepo,
+		payrollRunRepo:    payrollRunRepo,
+		payrollCalculator: payrollCalculator,
+	}
+}
+
+func (u *goalUseCase) GetMyGoals(ctx context.Context, userID int) (*dto.GoalsResponse, error) {
+	history, err := u.goalRepo.FindByUserId(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get goals: %w", err)
+	}
+
+	active := make([]*entity.Goal, 0)
+	for _, goal := range history {
+		if goal.IsActive() {
+			active = append(active, goal)
+		}
+	}
+
+	return dto.ToGoalsResponse(active, history), nil
+}
+
+func (u *goalUseCase) SetGoal(ctx context.Context, userID int, req *request.SetGoalRequest) (*dto.GoalResponse, error) {
+	// Validate request
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	goal, err := entity.NewGoal(userID, entity.GoalType(req.Type), entity.GoalPeriod(req.Period), req.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	createdGoal, err := replaceGoal(ctx, u.goalRepo, goal)
+	if err != nil {
+		return nil, err
+	}
+
+	// The legacy User.Goal field mirrors the monthly income goal
+	if goal.Type == entity.GoalTypeIncome && goal.Period == entity.GoalPeriodMonth {
+		user, err := u.userRepo.FindById(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("user not found: %w", err)
+		}
+		user.Goal = goal.Target
+		if _, err := u.userRepo.Update(ctx, user); err != nil {
+			return nil, fmt.Errorf("failed to update user: %w", err)
+		}
+	}
+
+	return dto.ToGoalResponse(createdGoal), nil
+}
+
+func (u *goalUseCase) GetGoalProgress(ctx context.Context, userID int, date *string) (*dto.GoalProgressResponse, error) {
+	asOf := time.Now()
+	if date != nil && *date != "" {
+		parsed, err := ParseDate(*date)
+		if err != nil {
+			return nil, err
+		}
+		asOf = parsed
+	}
+	asOf = time.Date(asOf.Year(), asOf.Month(), asOf.Day(), 0, 0, 0, 0, time.UTC)
+
+	user, err := u.userRepo.FindById(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	goals, err := u.goalRepo.FindActiveByUserId(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get goals: %w", err)
+	}
+
+	response := &dto.GoalProgressResponse{
+		AsOf:  asOf.Format(DateFormat),
+		Goals: make([]dto.GoalProgressItem, 0, len(goals)),
+	}
+
+	for _, goal := range goals {
+		progress, err := u.progress(ctx, user, goal, asOf)
+		if err != nil {
+			return nil, err
+		}
+		response.Goals = append(response.Goals, *progress)
+	}
+
+	return response, nil
+}
+
+func (u *goalUseCase) progress(ctx context.Context, user *entity.User, goal *entity.Goal, asOf time.Time) (*dto.GoalProgressItem, error) {
+	periodStart, periodEnd := goal.PeriodRange(asOf)
+	monthStart := time.Date(asOf.Year(), asOf.Month(), 1, 0, 0, 0, 0, time.UTC)
+	monthEnd := monthStart.AddDate(0, 1, -1)
+
+	// Days left in the current month on which the user is expected to work
+	holidays, err := u.holidayRepo.FindByPeriod(ctx, asOf.AddDate(0, 0, 1), monthEnd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get holidays: %w", err)
+	}
+	calendar := entity.NewWorkCalendar(holidays)
+	remainingDays := 0
+	for day := asOf.AddDate(0, 0, 1); !day.After(monthEnd); day = day.AddDate(0, 0, 1) {
+		if calendar.IsWorkingDay(day) && user.IsEmployedOn(day) {
+			remainingDays++
+		}
+	}
+
+	var actual, projected float64
+	switch goal.Type {
+	case entity.GoalTypeHours:
+		actual, projected, err = u.hoursProgress(ctx, user, periodStart, monthStart, asOf, remainingDays)
+	default:
+		actual, projected, err = u.incomeProgress(ctx, user, periodStart, monthStart, remainingDays)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.GoalProgressItem{
+		Goal:              *dto.ToGoalResponse(goal),
+		PeriodStart:       periodStart.Format(DateFormat),
+		PeriodEnd:         periodEnd.Format(DateFormat),
+		Actual:            roundTenth(actual),
+		ProjectedMonthEnd: roundTenth(projected),
+		Progress:          percentOf(actual, goal.Target),
+		ProjectedProgress: percentOf(projected, goal.Target),
+	}, nil
+}
+
+// hoursProgress returns hours worked from periodStart to asOf, and that figure projected to
+// the end of the month using the scheduled daily hours, or the average of days worked so far
+func (u *goalUseCase) hoursProgress(ctx context.Context, user *entity.User, periodStart, monthStart, asOf time.Time, remainingDays int) (float64, float64, error) {
+	attendances, err := u.attendanceRepo.FindByDatePeriod(ctx, user.Id, periodStart, asOf)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get attendances: %w", err)
+	}
+
+	var total, monthTotal float64
+	monthAttendances := make([]*entity.Attendance, 0)
+	for _, attendance := range attendances {
+		hours := CalculateWorkingHours(attendance)
+		total += hours
+		if !attendance.Date.Before(monthStart) {
+			monthTotal += hours
+			monthAttendances = append(monthAttendances, attendance)
+		}
+	}
+
+	dailyHours := 0.0
+	if user.HasSchedule() {
+		dailyHours = float64(user.ScheduledDailyMinutes()) / 60
+	} else if workedDays := CountWorkedDays(monthAttendances); workedDays > 0 {
+		dailyHours = monthTotal / float64(workedDays)
+	}
+
+	return total, total + dailyHours*float64(remainingDays), nil
+}
+
+// incomeProgress returns the estimated pay from periodStart through the current month, and
+// that figure projected to the end of the month. Monthly salaries are already full-month
+// figures; hourly and daily pay is extrapolated over the remaining working days.
+func (u *goalUseCase) incomeProgress(ctx context.Context, user *entity.User, periodStart, monthStart time.Time, remainingDays int) (float64, float64, error) {
+	var total float64
+	var current *entity.PayrollLine
+	for month := periodStart; !month.After(monthStart); month = month.AddDate(0, 1, 0) {
+		line, _, err := userMonthLine(ctx, u.payrollRunRepo, u.payrollCalculator, user, month)
+		if err != nil {
+			return 0, 0, err
+		}
+		if line == nil {
+			continue
+		}
+		total += float64(line.TotalSalary)
+		if month.Equal(monthStart) {
+			current = line
+		}
+	}
+
+	if current == nil || user.PayType.IsMonthly() {
+		return total, total, nil
+	}
+
+	dailyPay := 0.0
+	switch user.PayType {
+	case entity.PayTypeDaily:
+		dailyPay = float64(user.PayRate)
+	case entity.PayTypeHourly:
+		if user.HasSchedule() {
+			dailyPay = float64(user.ScheduledDailyMinutes()) / 60 * float64(user.PayRate)
+		} else if current.WorkedDays > 0 {
+			dailyPay = current.TotalHours / float64(current.WorkedDays) * float64(user.PayRate)
+		}
+	}
+
+	return total, total + dailyPay*float64(remainingDays), nil
+}
+
+// replaceGoal supersedes the user's active goal of the same type and period and stores the new one
+func replaceGoal(ctx context.Context, goalRepo repository.GoalRepository, goal *entity.Goal) (*entity.Goal, error) {
+	activeGoals, err := goalRepo.FindActiveByUserId(ctx, goal.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get goals: %w", err)
+	}
+
+	for _, active := range activeGoals {
+		if active.Type != goal.Type || active.Period != goal.Period {
+			continue
+		}
+		active.Supersede(goal.ActiveFrom)
+		if _, err := goalRepo.Update(ctx, active); err != nil {
+			return nil, fmt.Errorf("failed to update goal: %w", err)
+		}
+	}
+
+	createdGoal, err := goalRepo.Create(ctx, goal)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create goal: %w", err)
+	}
+	return createdGoal, nil
+}
+
+func roundTenth(value float64) float64 {
+	return math.Round(value*10) / 10
+}
+
+// percentOf returns value as a percentage of target, rounded to one decimal place
+func percentOf(value float64, target int) float64 {
+	if target <= 0 {
+		return 0
+	}
+	return roundTenth(value / float64(target) * 100)
+}
@@ -0,0 +1,241 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+)
+
+// DefaultJobTimeout bounds a job run when the job does not set its own timeout
+const DefaultJobTimeout = 30 * time.Minute
+
+// Job is background work that the scheduler runs on a cron schedule or when an admin triggers it
+type Job struct {
+	Name        string
+	Description string
+	// Schedule is a cron expression (see entity.CronSchedule); empty means manual runs only
+	Schedule string
+	// Timeout bounds a run and is also the lease of the job's lock, so a run that outlives it
+	// may overlap with the next one; DefaultJobTimeout if zero
+	Timeout time.Duration
+	// Run does the work and returns a short summary for the run history
+	Run func(ctx context.Context) (string, error)
+
+	schedule *entity.CronSchedule
+}
+
+// NextRun returns when the schedule fires next after t, or nil for manual jobs
+func (j *Job) NextRun(t time.Time) *time.Time {
+	if j.schedule == nil {
+		return nil
+	}
+	next := j.schedule.Next(t)
+	if next.IsZero() {
+		return nil
+	}
+	return &next
+}
+
+// JobScheduler runs registered jobs in the background. Every instance of the API may run a
+// scheduler: each scheduled minute of a job runs on one instance only, and a job never runs
+// on two instances at once. Schedules are evaluated in the process's local time zone.
+type JobScheduler interface {
+	// Register adds a job; it fails for duplicate names and invalid schedules
+	Register(job Job) error
+	// Jobs returns the registered jobs in registration order
+	Jobs() []*Job
+	// Start runs the jobs on their schedules until ctx is done
+	Start(ctx context.Context)
+	// Trigger starts a job now in the background and returns its run record. It returns
+	// domain.ErrJobNotFound for unknown jobs and domain.ErrJobRunning if the job is running.
+	Trigger(ctx context.Context, name string, triggeredBy int) (*entity.JobRun, error)
+}
+
+type jobScheduler struct {
+	jobRunRepo  repository.JobRunRepository
+	jobLockRepo repository.JobLockRepository
+	instance    string
+
+	mu     sync.RWMutex
+	jobs   []*Job
+	byName map[string]*Job
+}
+
+func NewJobScheduler(jobRunRepo repository.JobRunRepository, jobLockRepo repository.JobLockRepository) JobScheduler {
+	hostname, _ := os.Hostname()
+	return &jobScheduler{
+		jobRunRepo:  jobRunRepo,
+		jobLockRepo: jobLockRepo,
+		instance:    fmt.Sprintf("%s-%d-%d", hostname, os.Getpid(), time.Now().UnixNano()),
+		byName:      make(map[string]*Job),
+	}
+}
+
+func (s *jobScheduler) Register(job Job) error {
+	if job.Name == "" || job.Run == nil {
+		return errors.New("job needs a name and a run function")
+	}
+	if job.Schedule != "" {
+		schedule, err := entity.ParseCronSchedule(job.Schedule)
+		if err != nil {
+			return fmt.Errorf("job %s: %w", job.Name, err)
+		}
+		job.schedule = schedule
+	}
+	if job.Timeout <= 0 {
+		job.Timeout = DefaultJobTimeout
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, exists := s.byName[job.Name]; exists {
+		return fmt.Errorf("job %s is already registered", job.Name)
+	}
+	s.jobs = append(s.jobs, &job)
+	s.byName[job.Name] = &job
+	return nil
+}
+
+func (s *jobScheduler) Jobs() []*Job {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]*Job(nil), s.jobs...)
+}
+
+func (s *jobScheduler) Start(ctx context.Context) {
+	go func() {
+		for {
+			now := time.Now()
+			minute := now.Truncate(time.Minute).Add(time.Minute)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(minute.Sub(now)):
+			}
+
+			for _, job := range s.Jobs() {
+				if job.schedule != nil && job.schedule.Matches(minute) {
+					go s.runScheduled(job, minute)
+				}
+			}
+		}
+	}()
+}
+
+func (s *jobScheduler) Trigger(ctx context.Context, name string, triggeredBy int) (*entity.JobRun, error) {
+	s.mu.RLock()
+	job, ok := s.byName[name]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, domain.ErrJobNotFound
+	}
+
+	acquired, err := s.lock(ctx, job, 0)
+	if err != nil {
+		return nil, err
+	}
+	if !acquired {
+		return nil, domain.ErrJobRunning
+	}
+
+	run, err := s.jobRunRepo.Create(ctx, entity.NewJobRun(job.Name, entity.JobTriggerManual, nil, &triggeredBy, s.instance))
+	if err != nil {
+		s.unlock(job)
+		return nil, fmt.Errorf("failed to create job run: %w", err)
+	}
+
+	go s.execute(job, run)
+	return run, nil
+}
+
+// runScheduled runs the job for one scheduled minute unless another instance already has
+func (s *jobScheduler) runScheduled(job *Job, minute time.Time) {
+	ctx := context.Background()
+
+	// Creating the minute's run record is the claim: the unique (job_name, scheduled_at)
+	// index lets only one instance insert it, and only that instance goes on
+	run, err := s.jobRunRepo.Create(ctx, entity.NewJobRun(job.Name, entity.JobTriggerSchedule, &minute, nil, s.instance))
+	if errors.Is(err, domain.ErrJobAlreadyRan) {
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to create run of job %s: %v", job.Name, err)
+		return
+	}
+
+	acquired, err := s.lock(ctx, job, run.Id)
+	if err != nil || !acquired {
+		if err != nil {
+			log.Printf("Failed to lock job %s: %v", job.Name, err)
+			run.Finish("", err, time.Now())
+		} else {
+			// This minute is claimed here, so the lock is held by an earlier run that is still going
+			run.Skip("previous run is still in progress", time.Now())
+		}
+		if _, err := s.jobRunRepo.Update(ctx, run); err != nil {
+			log.Printf("Failed to record run of job %s: %v", job.Name, err)
+		}
+		return
+	}
+
+	s.execute(job, run)
+}
+
+// lock takes the job's lock. Runs still recorded as running at that point, other than the
+// caller's own run claimed just before, belong to an instance that stopped without finishing
+// them, so they are marked as failed.
+func (s *jobScheduler) lock(ctx context.Context, job *Job, claimedRunId int) (bool, error) {
+	acquired, err := s.jobLockRepo.Acquire(ctx, job.Name, s.instance, time.Now().Add(job.Timeout))
+	if err != nil {
+		return false, fmt.Errorf("failed to lock job: %w", err)
+	}
+	if !acquired {
+		return false, nil
+	}
+	if err := s.jobRunRepo.FailRunning(ctx, job.Name, claimedRunId, "abandoned: the instance running it stopped", time.Now()); err != nil {
+		s.unlock(job)
+		return false, fmt.Errorf("failed to update job runs: %w", err)
+	}
+	return true, nil
+}
+
+func (s *jobScheduler) unlock(job *Job) {
+	if err := s.jobLockRepo.Release(context.Background(), job.Name, s.instance); err != nil {
+		log.Printf("Failed to unlock job %s: %v", job.Name, err)
+	}
+}
+
+// execute runs the locked job, records the outcome and releases the lock
+func (s *jobScheduler) execute(job *Job, run *entity.JobRun) {
+	defer s.unlock(job)
+
+	ctx, cancel := context.WithTimeout(context.Background(), job.Timeout)
+	defer cancel()
+
+	output, err := runJob(ctx, job)
+	if err != nil {
+		log.Printf("Job %s failed: %v", job.Name, err)
+	}
+	run.Finish(output, err, time.Now())
+	if _, err := s.jobRunRepo.Update(context.Background(), run); err != nil {
+		log.Printf("Failed to record run of job %s: %v", job.Name, err)
+	}
+}
+
+// runJob calls the job, turning a panic into an error so that it ends up in the run history
+func runJob(ctx context.Context, job *Job) (output string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return job.Run(ctx)
+}
@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/domain"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+)
+
+// Limits for the run history of a job
+const (
+	DefaultJobRunsLimit = 20
+	MaxJobRunsLimit     = 100
+)
+
+// JobUseCase lets admins inspect and trigger the background jobs
+type JobUseCase interface {
+	// GetJobs lists the registered jobs with their next and latest runs (ADMIN only)
+	// NOTE: Caller must verify ADMIN role before calling this method
+	GetJobs(ctx context.Context) (*dto.JobsResponse, error)
+
+	// TriggerJob starts a job now; it keeps running after the call returns (ADMIN only)
+	// NOTE: Caller must verify ADMIN role before calling this method
+	TriggerJob(ctx context.Context, name string, userID int) (*dto.JobRunResponse, error)
+
+	// GetJobRuns returns a job's latest runs, newest first (ADMIN only)
+	// NOTE: Caller must verify ADMIN role before calling this method
+	GetJobRuns(ctx context.Context, name string, limit int) (*dto.JobRunsResponse, error)
+}
+
+type jobUseCase struct {
+	scheduler        JobScheduler
+	jobRunRepo       repository.JobRunRepository
+	schedulerEnabled bool
+}
+
+// NewJobUseCase creates the use case; schedulerEnabled tells whether this instance runs the schedules
+func NewJobUseCase(scheduler JobScheduler, jobRunRepo repository.JobRunRepository, schedulerEnabled bool) JobUseCase {
+	return &jobUseCase{
+		scheduler:        scheduler,
+		jobRunRepo:       jobRunRepo,
+		schedulerEnabled: schedulerEnabled,
+	}
+}
+
+func (u *jobUseCase) GetJobs(ctx context.Context) (*dto.JobsResponse, error) {
+	latest, err := u.jobRunRepo.FindLatest(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job runs: %w", err)
+	}
+	lastRuns := make(map[string]*dto.JobRunResponse, len(latest))
+	for _, run := range latest {
+		lastRuns[run.JobName] = dto.ToJobRunResponse(run)
+	}
+
+	now := time.Now()
+	response := &dto.JobsResponse{
+		SchedulerEnabled: u.schedulerEnabled,
+		Jobs:             make([]dto.JobResponse, 0),
+	}
+	for _, job := range u.scheduler.Jobs() {
+		jobResponse := dto.JobResponse{
+			Name:        job.Name,
+			Description: job.Description,
+			Schedule:    job.Schedule,
+			LastRun:     lastRuns[job.Name],
+		}
+		if u.schedulerEnabled {
+			jobResponse.NextRunAt = job.NextRun(now)
+		}
+		response.Jobs = append(response.Jobs, jobResponse)
+	}
+	return response, nil
+}
+
+func (u *jobUseCase) TriggerJob(ctx context.Context, name string, userID int) (*dto.JobRunResponse, error) {
+	run, err := u.scheduler.Trigger(ctx, name, userID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToJobRunResponse(run), nil
+}
+
+func (u *jobUseCase) GetJobRuns(ctx context.Context, name string, limit int) (*dto.JobRunsResponse, error) {
+	if !u.hasJob(name) {
+		return nil, domain.ErrJobNotFound
+	}
+	if limit <= 0 {
+		limit = DefaultJobRunsLimit
+	}
+	if limit > MaxJobRunsLimit {
+		limit = MaxJobRunsLimit
+	}
+
+	runs, err := u.jobRunRepo.FindByJob(ctx, name, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job runs: %w", err)
+	}
+	return dto.ToJobRunsResponse(runs), nil
+}
+
+func (u *jobUseCase) hasJob(name string) bool {
+	for _, job := range u.scheduler.Jobs() {
+		if job.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,235 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+)
+
+// Late-night work (深夜労働) is work between 22:00 and 05:00
+const (
+	LateNightStartHour = 22
+	LateNightEndHour   = 5
+)
+
+// MonthlySummaryService maintains the materialized per-user monthly summaries.
+// Months that are over are read from the summary table; the current month keeps
+// changing as days pass and is always calculated live by its callers.
+// Attendance changes refresh summaries directly; changes to pay terms, allowances, bonuses
+// and holidays mark the months they affect stale, and those are recomputed when next read.
+type MonthlySummaryService interface {
+	// RefreshUserMonth recomputes the user's summary for the month containing date.
+	// It returns nil if the user was not employed during that month.
+	RefreshUserMonth(ctx context.Context, userID int, date time.Time) (*entity.MonthlySummary, error)
+
+	// RebuildMonth recomputes the summaries of every employee for the month starting at monthTime
+	RebuildMonth(ctx context.Context, monthTime time.Time) ([]*entity.MonthlySummary, error)
+
+	// MonthSummaries returns the summaries of a month, rebuilding them first if the month was
+	// never built, was built before it ended or has been marked stale. A built month without
+	// employees is returned as is.
+	MonthSummaries(ctx context.Context, monthTime time.Time) ([]*entity.MonthlySummary, error)
+
+	// UserMonthSummary returns one user's summary of a month, refreshing it first if it is
+	// missing, was calculated before the month ended or has been marked stale
+	UserMonthSummary(ctx context.Context, userID int, monthTime time.Time) (*entity.MonthlySummary, error)
+
+	// MarkStale flags the summaries of the months between from and to (inclusive) for recomputation.
+	// userID 0 marks every user; a zero from or to leaves that end of the range open.
+	MarkStale(ctx context.Context, userID int, from, to time.Time) error
+}
+
+type monthlySummaryService struct {
+	summaryRepo       repository.MonthlySummaryRepository
+	userRepo          repository.UserRepository
+	payrollCalculator PayrollCalculator
+}
+
+func NewMonthlySummaryService(
+	summaryRepo repository.MonthlySummaryRepository,
+	userRepo repository.UserRepository,
+	payrollCalculator PayrollCalculator,
+) MonthlySummaryService {
+	return &monthlySummaryService{
+		summaryRepo:       summaryRepo,
+		userRepo:          userRepo,
+		payrollCalculator: payrollCalculator,
+	}
+}
+
+func (s *monthlySummaryService) RefreshUserMonth(ctx context.Context, userID int, date time.Time) (*entity.MonthlySummary, error) {
+	monthStart := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+	month := monthStart.Format("2006-01")
+
+	user, err := s.userRepo.FindById(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	data, err := s.payrollCalculator.CalculateUserMonthData(ctx, user, monthStart)
+	if err != nil {
+		return nil, err
+	}
+	if len(data.Lines) == 0 {
+		if err := s.summaryRepo.DeleteByUserAndMonth(ctx, userID, month); err != nil {
+			return nil, fmt.Errorf("failed to delete monthly summary: %w", err)
+		}
+		return nil, nil
+	}
+
+	summary := buildMonthlySummary(month, data.Lines[0], data.AttendancesByUser[userID], data.Calendar)
+	if err := s.summaryRepo.Upsert(ctx, summary); err != nil {
+		return nil, fmt.Errorf("failed to save monthly summary: %w", err)
+	}
+	return summary, nil
+}
+
+func (s *monthlySummaryService) RebuildMonth(ctx context.Context, monthTime time.Time) ([]*entity.MonthlySummary, error) {
+	month := monthTime.Format("2006-01")
+	calculatedAt := time.Now()
+
+	data, err := s.payrollCalculator.CalculateMonthData(ctx, monthTime)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make([]*entity.MonthlySummary, 0, len(data.Lines))
+	employed := make(map[int]struct{}, len(data.Lines))
+	for _, line := range data.Lines {
+		summary := buildMonthlySummary(month, line, data.AttendancesByUser[line.UserId], data.Calendar)
+		if err := s.summaryRepo.Upsert(ctx, summary); err != nil {
+			return nil, fmt.Errorf("failed to save monthly summary: %w", err)
+		}
+		summaries = append(summaries, summary)
+		employed[line.UserId] = struct{}{}
+	}
+
+	// Remove summaries of users no longer on the month's payroll (e.g. a corrected hire date)
+	existing, err := s.summaryRepo.FindByMonth(ctx, month)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get monthly summaries: %w", err)
+	}
+	for _, summary := range existing {
+		if _, exists := employed[summary.UserId]; !exists {
+			if err := s.summaryRepo.DeleteByUserAndMonth(ctx, summary.UserId, month); err != nil {
+				return nil, fmt.Errorf("failed to delete monthly summary: %w", err)
+			}
+		}
+	}
+
+	if err := s.summaryRepo.UpsertBuild(ctx, &entity.MonthlySummaryBuild{Month: month, CalculatedAt: calculatedAt}); err != nil {
+		return nil, fmt.Errorf("failed to save monthly summary build: %w", err)
+	}
+
+	return summaries, nil
+}
+
+func (s *monthlySummaryService) MonthSummaries(ctx context.Context, monthTime time.Time) ([]*entity.MonthlySummary, error) {
+	month := monthTime.Format("2006-01")
+	build, err := s.summaryRepo.FindBuild(ctx, month)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get monthly summary build: %w", err)
+	}
+	if build == nil || !build.IsCurrentAsOf(monthTime) {
+		return s.RebuildMonth(ctx, monthTime)
+	}
+
+	summaries, err := s.summaryRepo.FindByMonth(ctx, month)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get monthly summaries: %w", err)
+	}
+
+	// A summary refreshed on its own before the month ended is rebuilt with the rest
+	stale := false
+	for _, summary := range summaries {
+		if !summary.IsCurrentAsOf(monthTime) {
+			stale = true
+			break
+		}
+	}
+	if !stale {
+		return summaries, nil
+	}
+
+	return s.RebuildMonth(ctx, monthTime)
+}
+
+func (s *monthlySummaryService) UserMonthSummary(ctx context.Context, userID int, monthTime time.Time) (*entity.MonthlySummary, error) {
+	month := monthTime.Format("2006-01")
+	summaries, err := s.summaryRepo.FindByUserId(ctx, userID, month, month)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get monthly summaries: %w", err)
+	}
+	if len(summaries) > 0 && summaries[0].IsCurrentAsOf(monthTime) {
+		return summaries[0], nil
+	}
+
+	return s.RefreshUserMonth(ctx, userID, monthTime)
+}
+
+func (s *monthlySummaryService) MarkStale(ctx context.Context, userID int, from, to time.Time) error {
+	var fromMonth, toMonth string
+	if !from.IsZero() {
+		fromMonth = from.Format("2006-01")
+	}
+	if !to.IsZero() {
+		toMonth = to.Format("2006-01")
+	}
+	if err := s.summaryRepo.MarkStale(ctx, userID, fromMonth, toMonth); err != nil {
+		return fmt.Errorf("failed to mark monthly summaries stale: %w", err)
+	}
+	return nil
+}
+
+// buildMonthlySummary combines a payroll line with the hour buckets of the month's attendances
+func buildMonthlySummary(month string, line *entity.PayrollLine, attendances []*entity.Attendance, calendar *entity.WorkCalendar) *entity.MonthlySummary {
+	summary := &entity.MonthlySummary{
+		UserId:        line.UserId,
+		Month:         month,
+		TotalHours:    line.TotalHours,
+		RegularHours:  line.TotalHours - line.OvertimeHours,
+		OvertimeHours: line.OvertimeHours,
+		WorkedDays:    line.WorkedDays,
+		EstimatedPay:  line.TotalSalary,
+		CalculatedAt:  time.Now(),
+	}
+
+	for _, attendance := range attendances {
+		if !calendar.IsWorkingDay(attendance.Date) {
+			summary.HolidayHours += CalculateWorkingHours(attendance)
+		}
+		summary.LateNightHours += lateNightHours(attendance)
+	}
+
+	return summary
+}
+
+// lateNightHours returns the part of the attendance between 22:00 and 05:00.
+// Breaks are not subtracted because their time of day is not recorded.
+func lateNightHours(attendance *entity.Attendance) float64 {
+	start, end := attendance.StartTime, attendance.EndTime
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+
+	var total time.Duration
+	// The windows touching the start day: the previous night and the following night
+	for _, windowStart := range []time.Time{
+		day.Add(time.Duration(LateNightStartHour-24) * time.Hour),
+		day.Add(LateNightStartHour * time.Hour),
+	} {
+		windowEnd := windowStart.Add(time.Duration(24-LateNightStartHour+LateNightEndHour) * time.Hour)
+		overlapStart, overlapEnd := start, end
+		if windowStart.After(overlapStart) {
+			overlapStart = windowStart
+		}
+		if windowEnd.Before(overlapEnd) {
+			overlapEnd = windowEnd
+		}
+		if overlapEnd.After(overlapStart) {
+			total += overlapEnd.Sub(overlapStart)
+		}
+	}
+	return total.Hours()
+}
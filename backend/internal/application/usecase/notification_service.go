@@ -0,0 +1,352 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/notifier"
+)
+
+// OutboxKindNotification is the outbox message kind of a notification for one channel
+const OutboxKindNotification = "notification"
+
+// NotificationService renders events with their templates and delivers them to the channels
+// admins routed the events to. Events without routes go to the default Slack webhook, if one
+// is configured and the event was sent there before channels could be routed.
+//
+// Events about a single user follow that user's preferences: they can be sent to the user's
+// email address or Slack DMs instead, held back during quiet hours or not sent at all. They
+// also go to the channels routed for the user's department and @-mention the user's linked
+// Slack account. userID names that user; it is 0 for events that are not about one user.
+type NotificationService interface {
+	// Notify queues the event for each of its channels in the transaction in ctx; the
+	// notifications are sent once it commits. key identifies the occurrence of the event,
+	// e.g. attendance-created:42, so that it is queued once.
+	Notify(ctx context.Context, event entity.NotificationEvent, key string, userID int, data interface{}) error
+	// Send delivers the event to each of its channels now, or queues it for the end of the
+	// user's quiet hours, for callers that report the outcome themselves. It returns
+	// notifier.ErrNotConfigured if the event has no channel and domain.ErrNotificationOptedOut
+	// if the user turned it off.
+	Send(ctx context.Context, event entity.NotificationEvent, userID int, data interface{}) error
+	// SendToChannel delivers the event to the channel now, whether or not it is routed there
+	SendToChannel(ctx context.Context, channel *entity.NotificationChannel, event entity.NotificationEvent, data interface{}) error
+}
+
+// notificationPayload is the outbox payload of a rendered notification
+type notificationPayload struct {
+	// ChannelId is 0 for the default Slack webhook
+	ChannelId int `json:"channel_id"`
+	// Email or SlackUserId is set instead of ChannelId for notifications sent to the user directly
+	Email         string                     `json:"email,omitempty"`
+	SlackUserId   string                     `json:"slack_user_id,omitempty"`
+	Event         string                     `json:"event"`
+	Title         string                     `json:"title"`
+	Color         string                     `json:"color,omitempty"`
+	Fields        []notificationFieldPayload `json:"fields,omitempty"`
+	SlackMentions []string                   `json:"slack_mentions,omitempty"`
+}
+
+type notificationFieldPayload struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+	Short bool   `json:"short"`
+}
+
+// notificationRoute is where and from when an event is sent, and who it mentions
+type notificationRoute struct {
+	channels      []*entity.NotificationChannel
+	notBefore     time.Time // zero to send now
+	slackMentions []string
+}
+
+type notificationService struct {
+	channelRepo      repository.NotificationChannelRepository
+	routeRepo        repository.NotificationRouteRepository
+	preferenceRepo   repository.NotificationPreferenceRepository
+	userRepo         repository.UserRepository
+	slackAccountRepo repository.SlackAccountRepository
+	outboxService    OutboxService
+	notifiers        map[entity.NotificationChannelType]notifier.Notifier
+	defaultChannel   *entity.NotificationChannel
+}
+
+// NewNotificationService creates the service and registers its outbox handler.
+// defaultSlackWebhookURL may be empty, in which case unrouted events are not sent anywhere.
+func NewNotificationService(channelRepo repository.NotificationChannelRepository, routeRepo repository.NotificationRouteRepository, preferenceRepo repository.NotificationPreferenceRepository, userRepo repository.UserRepository, slackAccountRepo repository.SlackAccountRepository, outboxService OutboxService, notifiers map[entity.NotificationChannelType]notifier.Notifier, defaultSlackWebhookURL string) NotificationService {
+	s := &notificationService{
+		channelRepo:      channelRepo,
+		routeRepo:        routeRepo,
+		preferenceRepo:   preferenceRepo,
+		userRepo:         userRepo,
+		slackAccountRepo: slackAccountRepo,
+		outboxService:    outboxService,
+		notifiers:        notifiers,
+	}
+	if defaultSlackWebhookURL != "" {
+		s.defaultChannel = &entity.NotificationChannel{
+			Name:    "SLACK_WEBHOOK_URL",
+			Type:    entity.NotificationChannelSlack,
+			Target:  defaultSlackWebhookURL,
+			Enabled: true,
+		}
+	}
+	outboxService.RegisterHandler(OutboxKindNotification, s.deliverQueued)
+	return s
+}
+
+func (s *notificationService) Notify(ctx context.Context, event entity.NotificationEvent, key string, userID int, data interface{}) error {
+	notification, err := renderNotification(event, data)
+	if err != nil {
+		return err
+	}
+	route, err := s.route(ctx, event, userID)
+	if errors.Is(err, domain.ErrNotificationOptedOut) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	notification.SlackMentions = route.slackMentions
+
+	for _, channel := range route.channels {
+		if err := s.enqueue(ctx, key, channel, notification, route.notBefore); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *notificationService) Send(ctx context.Context, event entity.NotificationEvent, userID int, data interface{}) error {
+	notification, err := renderNotification(event, data)
+	if err != nil {
+		return err
+	}
+	route, err := s.route(ctx, event, userID)
+	if err != nil {
+		return err
+	}
+	notification.SlackMentions = route.slackMentions
+	if len(route.channels) == 0 {
+		return notifier.ErrNotConfigured
+	}
+
+	var errs []error
+	for _, channel := range route.channels {
+		if !route.notBefore.IsZero() {
+			key := fmt.Sprintf("%s:%d:%d", strings.ToLower(string(event)), userID, time.Now().UnixNano())
+			if err := s.enqueue(ctx, key, channel, notification, route.notBefore); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", channel.Name, err))
+			}
+			continue
+		}
+		if err := s.deliver(ctx, channel, notification); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", channel.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *notificationService) SendToChannel(ctx context.Context, channel *entity.NotificationChannel, event entity.NotificationEvent, data interface{}) error {
+	notification, err := renderNotification(event, data)
+	if err != nil {
+		return err
+	}
+	return s.deliver(ctx, channel, notification)
+}
+
+// route applies the user's preference for the event: it returns the channels the event goes to,
+// when, and whom it mentions, or domain.ErrNotificationOptedOut
+func (s *notificationService) route(ctx context.Context, event entity.NotificationEvent, userID int) (*notificationRoute, error) {
+	if userID == 0 {
+		channels, err := s.channels(ctx, event, "")
+		if err != nil {
+			return nil, err
+		}
+		return &notificationRoute{channels: channels}, nil
+	}
+
+	preference := entity.DefaultNotificationPreference(userID, event)
+	if tmpl := notificationTemplates[event]; tmpl != nil && tmpl.personal {
+		stored, err := s.preferenceRepo.Find(ctx, userID, event)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get notification preference: %w", err)
+		}
+		if stored != nil {
+			preference = stored
+		}
+	}
+	if preference.OptOut {
+		return nil, domain.ErrNotificationOptedOut
+	}
+
+	user, err := s.userRepo.FindById(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	account, err := s.slackAccountRepo.FindByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get slack account: %w", err)
+	}
+
+	route := &notificationRoute{}
+	if until, quiet := preference.QuietUntil(time.Now()); quiet {
+		route.notBefore = until
+	}
+	if account != nil {
+		route.slackMentions = []string{account.SlackUserId}
+	}
+
+	switch {
+	case preference.Channel == entity.PreferredChannelEmail:
+		route.channels = []*entity.NotificationChannel{emailChannel(user.Email)}
+	// Users who unlinked their Slack account get the notifications of everyone else again
+	case preference.Channel == entity.PreferredChannelSlackDM && account != nil:
+		route.channels = []*entity.NotificationChannel{slackDMChannel(account.SlackUserId)}
+	default:
+		if route.channels, err = s.channels(ctx, event, user.Department); err != nil {
+			return nil, err
+		}
+	}
+	return route, nil
+}
+
+// channels returns the enabled channels the event is routed to for notifications about users of
+// the department, or the default channel if the event has no routes and falls back to it
+func (s *notificationService) channels(ctx context.Context, event entity.NotificationEvent, department string) ([]*entity.NotificationChannel, error) {
+	routes, err := s.routeRepo.FindByEvent(ctx, event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification routes: %w", err)
+	}
+	if len(routes) == 0 {
+		if tmpl := notificationTemplates[event]; tmpl != nil && tmpl.fallback && s.defaultChannel != nil {
+			return []*entity.NotificationChannel{s.defaultChannel}, nil
+		}
+		return nil, nil
+	}
+
+	var channels []*entity.NotificationChannel
+	seen := make(map[int]bool, len(routes))
+	for _, route := range routes {
+		// A channel routed for everyone and for the department gets the notification once
+		if !route.Applies(department) || seen[route.ChannelId] {
+			continue
+		}
+		seen[route.ChannelId] = true
+		channel, err := s.channelRepo.FindById(ctx, route.ChannelId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get notification channel: %w", err)
+		}
+		if channel.Enabled {
+			channels = append(channels, channel)
+		}
+	}
+	return channels, nil
+}
+
+// deliverQueued is the outbox handler of notifications
+func (s *notificationService) deliverQueued(ctx context.Context, data []byte) error {
+	var payload notificationPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return &PermanentError{Err: err}
+	}
+
+	channel := s.defaultChannel
+	switch {
+	case payload.Email != "":
+		channel = emailChannel(payload.Email)
+	case payload.SlackUserId != "":
+		channel = slackDMChannel(payload.SlackUserId)
+	case payload.ChannelId != 0:
+		var err error
+		if channel, err = s.channelRepo.FindById(ctx, payload.ChannelId); err != nil {
+			return fmt.Errorf("failed to get notification channel: %w", err)
+		}
+	}
+	if channel == nil {
+		return &PermanentError{Err: notifier.ErrNotConfigured}
+	}
+	if !channel.Enabled {
+		return &PermanentError{Err: fmt.Errorf("notification channel %s is disabled", channel.Name)}
+	}
+
+	notification := &entity.Notification{
+		Event:         entity.NotificationEvent(payload.Event),
+		Title:         payload.Title,
+		Color:         payload.Color,
+		SlackMentions: payload.SlackMentions,
+	}
+	for _, f := range payload.Fields {
+		notification.Fields = append(notification.Fields, entity.NotificationField{Title: f.Title, Value: f.Value, Short: f.Short})
+	}
+
+	if err := s.deliver(ctx, channel, notification); err != nil {
+		// The settings have to be fixed before the message can be resent
+		if errors.Is(err, notifier.ErrNotConfigured) {
+			return &PermanentError{Err: err}
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *notificationService) deliver(ctx context.Context, channel *entity.NotificationChannel, notification *entity.Notification) error {
+	adapter, ok := s.notifiers[channel.Type]
+	if !ok {
+		return fmt.Errorf("%w: no adapter for %s channels", notifier.ErrNotConfigured, channel.Type)
+	}
+	return adapter.Send(ctx, channel.Target, notification)
+}
+
+// enqueue queues the notification for one channel
+func (s *notificationService) enqueue(ctx context.Context, key string, channel *entity.NotificationChannel, notification *entity.Notification, notBefore time.Time) error {
+	payload := &notificationPayload{
+		ChannelId:     channel.Id,
+		Event:         string(notification.Event),
+		Title:         notification.Title,
+		Color:         notification.Color,
+		SlackMentions: notification.SlackMentions,
+	}
+	key = fmt.Sprintf("%s:%d", key, channel.Id)
+	// Channels of the user's own are not stored, so the payload carries their target
+	if channel.Id == 0 && channel != s.defaultChannel {
+		switch channel.Type {
+		case entity.NotificationChannelEmail:
+			payload.Email = channel.Target
+			key = fmt.Sprintf("%s:email", key)
+		case entity.NotificationChannelSlack:
+			payload.SlackUserId = channel.Target
+			key = fmt.Sprintf("%s:slack-dm", key)
+		}
+	}
+	for _, f := range notification.Fields {
+		payload.Fields = append(payload.Fields, notificationFieldPayload{Title: f.Title, Value: f.Value, Short: f.Short})
+	}
+	return s.outboxService.EnqueueAt(ctx, OutboxKindNotification, key, payload, notBefore)
+}
+
+// emailChannel sends to a user's own address
+func emailChannel(address string) *entity.NotificationChannel {
+	return &entity.NotificationChannel{
+		Name:    "email",
+		Type:    entity.NotificationChannelEmail,
+		Target:  address,
+		Enabled: true,
+	}
+}
+
+// slackDMChannel sends a direct message from the bot to a Slack member
+func slackDMChannel(slackUserId string) *entity.NotificationChannel {
+	return &entity.NotificationChannel{
+		Name:    "slack-dm",
+		Type:    entity.NotificationChannelSlack,
+		Target:  slackUserId,
+		Enabled: true,
+	}
+}
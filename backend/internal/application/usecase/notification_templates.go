@@ -0,0 +1,247 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+// AttendanceNotice is the data of ATTENDANCE_CREATED and ATTENDANCE_UPDATED notifications
+type AttendanceNotice struct {
+	UserName     string
+	Date         time.Time
+	StartTime    time.Time
+	EndTime      time.Time
+	BreakMinutes int
+	// Report is empty for reports not shared with the whole company
+	Report string
+}
+
+// WorkMinutes returns the time worked, breaks excluded
+func (n *AttendanceNotice) WorkMinutes() int {
+	return int(n.EndTime.Sub(n.StartTime).Minutes()) - n.BreakMinutes
+}
+
+// CommentNotice is the data of REPORT_COMMENTED notifications
+type CommentNotice struct {
+	ReportAuthor string
+	ReportDate   time.Time
+	Commenter    string
+	// Comment is empty for comments on restricted reports
+	Comment string
+}
+
+// MentionNotice is the data of REPORT_MENTIONED notifications
+type MentionNotice struct {
+	Mentioned    []string
+	ReportAuthor string
+	ReportDate   time.Time
+	// Report is empty for restricted reports
+	Report string
+}
+
+// DigestNotice is the data of REPORT_DIGEST notifications
+type DigestNotice struct {
+	Title       string
+	UserName    string
+	WorkedDays  int
+	WorkMinutes int
+	ReportCount int
+	TopTags     []string
+}
+
+// ReminderNotice is the data of REMINDER notifications
+type ReminderNotice struct {
+	UserName string
+	Date     time.Time
+	Missing  []string // 勤怠, 日報
+}
+
+// MissingSummaryNotice is the data of MISSING_SUMMARY notifications
+type MissingSummaryNotice struct {
+	Date  time.Time
+	Lines []string
+}
+
+// TestNotice is the data of the notification admins send to check a channel
+type TestNotice struct {
+	ChannelName string
+	SentBy      string
+}
+
+// notificationTemplate renders an event's data into a notification. Title and field values
+// are text/templates executed with the event's data; fields that come out empty are left out.
+type notificationTemplate struct {
+	description string
+	// fallback sends the event to the default Slack webhook (SLACK_WEBHOOK_URL) while no
+	// channel is routed for it
+	fallback bool
+	// personal events are about one user, whose preferences decide where and when they are sent
+	personal bool
+	color    string
+	title    *template.Template
+	fields   []fieldTemplate
+}
+
+type fieldTemplate struct {
+	title string
+	value *template.Template
+	short bool
+}
+
+var notificationFuncs = template.FuncMap{
+	"date":  func(t time.Time) string { return t.Format("2006-01-02") },
+	"clock": func(t time.Time) string { return t.Format("15:04") },
+	"duration": func(minutes int) string {
+		return fmt.Sprintf("%d時間%d分", minutes/60, minutes%60)
+	},
+	"join": strings.Join,
+	// excerpt cuts long texts so that messages stay readable
+	"excerpt": func(max int, s string) string {
+		runes := []rune(s)
+		if len(runes) <= max {
+			return s
+		}
+		return string(runes[:max]) + "…"
+	},
+}
+
+func parseNotificationText(text string) *template.Template {
+	return template.Must(template.New("").Funcs(notificationFuncs).Parse(text))
+}
+
+func field(title, value string, short bool) fieldTemplate {
+	return fieldTemplate{title: title, value: parseNotificationText(value), short: short}
+}
+
+var attendanceFields = []fieldTemplate{
+	field("社員", "{{.UserName}}", true),
+	field("日付", "{{date .Date}}", true),
+	field("勤務時間", "{{clock .StartTime}} - {{clock .EndTime}}", true),
+	field("実働時間", "{{duration .WorkMinutes}}", true),
+	field("休憩時間", "{{.BreakMinutes}}分", true),
+	field("業務報告", `{{or .Report "（レポートなし）"}}`, false),
+}
+
+var notificationTemplates = map[entity.NotificationEvent]*notificationTemplate{
+	entity.NotificationAttendanceCreated: {
+		description: "An attendance record was registered",
+		fallback:    true,
+		personal:    true,
+		color:       "#2EB886",
+		title:       parseNotificationText("🔔 新しい勤務報告が登録されました"),
+		fields:      attendanceFields,
+	},
+	entity.NotificationAttendanceUpdated: {
+		description: "An attendance record was changed",
+		personal:    true,
+		color:       "#2EB886",
+		title:       parseNotificationText("✏️ 勤務報告が更新されました"),
+		fields:      attendanceFields,
+	},
+	entity.NotificationReportCommented: {
+		description: "Someone commented on a daily report",
+		fallback:    true,
+		personal:    true,
+		color:       "#439FE0",
+		title:       parseNotificationText("💬 {{.ReportAuthor}}さんの日報にコメントがありました"),
+		fields: []fieldTemplate{
+			field("日報", "{{date .ReportDate}}", true),
+			field("コメントした人", "{{.Commenter}}", true),
+			field("コメント", "{{.Comment}}", false),
+		},
+	},
+	entity.NotificationReportMentioned: {
+		description: "Users were mentioned in a daily report",
+		fallback:    true,
+		personal:    true,
+		color:       "#439FE0",
+		title:       parseNotificationText("📣 {{range $i, $name := .Mentioned}}{{if $i}}、{{end}}{{$name}}さん{{end}}、{{.ReportAuthor}}さんの日報でメンションされました"),
+		fields: []fieldTemplate{
+			field("日報", "{{date .ReportDate}}", true),
+			field("社員", "{{.ReportAuthor}}", true),
+			field("業務報告", "{{excerpt 500 .Report}}", false),
+		},
+	},
+	entity.NotificationReportDigest: {
+		description: "Weekly or monthly summary of a user's reports",
+		fallback:    true,
+		personal:    true,
+		color:       "#764FA5",
+		title:       parseNotificationText("📒 {{.Title}}"),
+		fields: []fieldTemplate{
+			field("社員", "{{.UserName}}", true),
+			field("勤務日数", "{{.WorkedDays}}日", true),
+			field("実働時間", "{{duration .WorkMinutes}}", true),
+			field("日報", "{{.ReportCount}}件", true),
+			field("よく使われたタグ", "{{range $i, $tag := .TopTags}}{{if $i}} {{end}}#{{$tag}}{{end}}", false),
+		},
+	},
+	entity.NotificationReminder: {
+		description: "A user has not logged the day's attendance or daily report",
+		fallback:    true,
+		personal:    true,
+		title:       parseNotificationText(`⏰ {{.UserName}}さん、{{date .Date}}の{{join .Missing "と"}}がまだ登録されていません`),
+	},
+	entity.NotificationMissingSummary: {
+		description: "Admin summary of the users who have not logged the day",
+		fallback:    true,
+		color:       "#DAA038",
+		title:       parseNotificationText("📋 {{date .Date}} の未登録者（{{len .Lines}}名）"),
+		fields: []fieldTemplate{
+			field("管理者向け", `{{join .Lines "\n"}}`, false),
+		},
+	},
+	entity.NotificationTest: {
+		color: "#439FE0",
+		title: parseNotificationText("✅ 通知のテストです"),
+		fields: []fieldTemplate{
+			field("チャンネル", "{{.ChannelName}}", true),
+			field("送信者", "{{.SentBy}}", true),
+		},
+	},
+}
+
+// renderNotification fills in the event's template with data, which must be the event's notice type
+func renderNotification(event entity.NotificationEvent, data interface{}) (*entity.Notification, error) {
+	tmpl, ok := notificationTemplates[event]
+	if !ok {
+		return nil, fmt.Errorf("no template for notification event %s", event)
+	}
+
+	title, err := executeNotificationText(tmpl.title, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render %s notification: %w", event, err)
+	}
+	notification := &entity.Notification{
+		Event: event,
+		Title: title,
+		Color: tmpl.color,
+	}
+	for _, f := range tmpl.fields {
+		value, err := executeNotificationText(f.value, data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render %s notification: %w", event, err)
+		}
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+		notification.Fields = append(notification.Fields, entity.NotificationField{
+			Title: f.title,
+			Value: value,
+			Short: f.short,
+		})
+	}
+	return notification, nil
+}
+
+func executeNotificationText(tmpl *template.Template, data interface{}) (string, error) {
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
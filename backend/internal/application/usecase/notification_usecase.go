@@ -0,0 +1,292 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/domain"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+)
+
+// NotificationUseCase lets admins manage notification channels and route events to them, and
+// users choose how they are notified about events concerning them
+type NotificationUseCase interface {
+	// GetEvents lists the notification events with the channels they are routed to (ADMIN only)
+	// NOTE: Caller must verify ADMIN role before calling this method
+	GetEvents(ctx context.Context) (*dto.NotificationEventsResponse, error)
+
+	// SetRoutes routes the event to exactly the given channels (ADMIN only)
+	// NOTE: Caller must verify ADMIN role before calling this method
+	SetRoutes(ctx context.Context, event string, req *request.SetNotificationRoutesRequest) (*dto.NotificationEventResponse, error)
+
+	// GetChannels lists the channels (ADMIN only)
+	// NOTE: Caller must verify ADMIN role before calling this method
+	GetChannels(ctx context.Context) (*dto.NotificationChannelsResponse, error)
+
+	// CreateChannel adds a channel (ADMIN only)
+	// NOTE: Caller must verify ADMIN role before calling this method
+	CreateChannel(ctx context.Context, req *request.CreateNotificationChannelRequest) (*dto.NotificationChannelResponse, error)
+
+	// UpdateChannel changes a channel (ADMIN only)
+	// NOTE: Caller must verify ADMIN role before calling this method
+	UpdateChannel(ctx context.Context, id int, req *request.UpdateNotificationChannelRequest) (*dto.NotificationChannelResponse, error)
+
+	// DeleteChannel deletes a channel and its routes (ADMIN only)
+	// NOTE: Caller must verify ADMIN role before calling this method
+	DeleteChannel(ctx context.Context, id int) error
+
+	// TestChannel sends a test notification to the channel now, even if it is disabled (ADMIN only)
+	// NOTE: Caller must verify ADMIN role before calling this method
+	TestChannel(ctx context.Context, id int, userID int) error
+
+	// GetMyPreferences lists the user's settings for every event that concerns them
+	GetMyPreferences(ctx context.Context, userID int) (*dto.NotificationPreferencesResponse, error)
+
+	// UpdateMyPreference replaces the user's setting for the event
+	UpdateMyPreference(ctx context.Context, userID int, event string, req *request.UpdateNotificationPreferenceRequest) (*dto.NotificationPreferenceResponse, error)
+}
+
+type notificationUseCase struct {
+	channelRepo         repository.NotificationChannelRepository
+	routeRepo           repository.NotificationRouteRepository
+	preferenceRepo      repository.NotificationPreferenceRepository
+	userRepo            repository.UserRepository
+	notificationService NotificationService
+	defaultChannel      bool
+}
+
+// NewNotificationUseCase creates the use case; defaultChannel tells whether the default Slack webhook is set
+func NewNotificationUseCase(channelRepo repository.NotificationChannelRepository, routeRepo repository.NotificationRouteRepository, preferenceRepo repository.NotificationPreferenceRepository, userRepo repository.UserRepository, notificationService NotificationService, defaultChannel bool) NotificationUseCase {
+	return &notificationUseCase{
+		channelRepo:         channelRepo,
+		routeRepo:           routeRepo,
+		preferenceRepo:      preferenceRepo,
+		userRepo:            userRepo,
+		notificationService: notificationService,
+		defaultChannel:      defaultChannel,
+	}
+}
+
+func (u *notificationUseCase) GetEvents(ctx context.Context) (*dto.NotificationEventsResponse, error) {
+	routes, err := u.routeRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification routes: %w", err)
+	}
+	routesByEvent := make(map[entity.NotificationEvent][]*entity.NotificationRoute)
+	for _, route := range routes {
+		routesByEvent[route.Event] = append(routesByEvent[route.Event], route)
+	}
+
+	response := &dto.NotificationEventsResponse{
+		DefaultChannel: u.defaultChannel,
+		Events:         make([]dto.NotificationEventResponse, len(entity.NotificationEvents)),
+	}
+	for i, event := range entity.NotificationEvents {
+		response.Events[i] = toNotificationEventResponse(event, routesByEvent[event])
+	}
+	return response, nil
+}
+
+func (u *notificationUseCase) SetRoutes(ctx context.Context, event string, req *request.SetNotificationRoutesRequest) (*dto.NotificationEventResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	notificationEvent := entity.NotificationEvent(strings.ToUpper(event))
+	if err := notificationEvent.Validate(); err != nil {
+		return nil, domain.ErrNotificationEventNotFound
+	}
+
+	var routes []*entity.NotificationRoute
+	addRoutes := func(department string, channelIds []int) error {
+		for _, id := range channelIds {
+			if _, err := u.channelRepo.FindById(ctx, id); err != nil {
+				return domain.ErrNotificationChannelNotFound
+			}
+			routes = append(routes, &entity.NotificationRoute{Event: notificationEvent, ChannelId: id, Department: department})
+		}
+		return nil
+	}
+	if err := addRoutes("", req.ChannelIds); err != nil {
+		return nil, err
+	}
+	for department, channelIds := range req.DepartmentChannelIds {
+		if err := addRoutes(strings.TrimSpace(department), channelIds); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := u.routeRepo.ReplaceForEvent(ctx, notificationEvent, routes); err != nil {
+		return nil, fmt.Errorf("failed to save notification routes: %w", err)
+	}
+	response := toNotificationEventResponse(notificationEvent, routes)
+	return &response, nil
+}
+
+func (u *notificationUseCase) GetChannels(ctx context.Context) (*dto.NotificationChannelsResponse, error) {
+	channels, err := u.channelRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification channels: %w", err)
+	}
+	return dto.ToNotificationChannelsResponse(channels), nil
+}
+
+func (u *notificationUseCase) CreateChannel(ctx context.Context, req *request.CreateNotificationChannelRequest) (*dto.NotificationChannelResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	channel, err := entity.NewNotificationChannel(strings.TrimSpace(req.Name), entity.NotificationChannelType(req.Type), strings.TrimSpace(req.Target))
+	if err != nil {
+		return nil, err
+	}
+	if req.Enabled != nil {
+		channel.Enabled = *req.Enabled
+	}
+
+	createdChannel, err := u.channelRepo.Create(ctx, channel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create notification channel: %w", err)
+	}
+	return dto.ToNotificationChannelResponse(createdChannel), nil
+}
+
+func (u *notificationUseCase) UpdateChannel(ctx context.Context, id int, req *request.UpdateNotificationChannelRequest) (*dto.NotificationChannelResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	channel, err := u.channelRepo.FindById(ctx, id)
+	if err != nil {
+		return nil, domain.ErrNotificationChannelNotFound
+	}
+
+	if req.Name != nil {
+		channel.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Type != nil {
+		channel.Type = entity.NotificationChannelType(*req.Type)
+	}
+	if req.Target != nil {
+		channel.Target = strings.TrimSpace(*req.Target)
+	}
+	if req.Enabled != nil {
+		channel.Enabled = *req.Enabled
+	}
+
+	if err := channel.Validate(); err != nil {
+		return nil, err
+	}
+
+	updatedChannel, err := u.channelRepo.Update(ctx, channel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update notification channel: %w", err)
+	}
+	return dto.ToNotificationChannelResponse(updatedChannel), nil
+}
+
+func (u *notificationUseCase) DeleteChannel(ctx context.Context, id int) error {
+	if _, err := u.channelRepo.FindById(ctx, id); err != nil {
+		return domain.ErrNotificationChannelNotFound
+	}
+
+	if err := u.channelRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete notification channel: %w", err)
+	}
+	return nil
+}
+
+func (u *notificationUseCase) TestChannel(ctx context.Context, id int, userID int) error {
+	channel, err := u.channelRepo.FindById(ctx, id)
+	if err != nil {
+		return domain.ErrNotificationChannelNotFound
+	}
+	user, err := u.userRepo.FindById(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("user not found: %w", err)
+	}
+
+	err = u.notificationService.SendToChannel(ctx, channel, entity.NotificationTest, &TestNotice{ChannelName: channel.Name, SentBy: user.Name})
+	if err != nil {
+		return fmt.Errorf("failed to send test notification: %w", err)
+	}
+	return nil
+}
+
+func (u *notificationUseCase) GetMyPreferences(ctx context.Context, userID int) (*dto.NotificationPreferencesResponse, error) {
+	stored, err := u.preferenceRepo.FindByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+	byEvent := make(map[entity.NotificationEvent]*entity.NotificationPreference, len(stored))
+	for _, preference := range stored {
+		byEvent[preference.Event] = preference
+	}
+
+	response := &dto.NotificationPreferencesResponse{Preferences: []dto.NotificationPreferenceResponse{}}
+	for _, event := range entity.NotificationEvents {
+		tmpl := notificationTemplates[event]
+		if tmpl == nil || !tmpl.personal {
+			continue
+		}
+		preference := byEvent[event]
+		if preference == nil {
+			preference = entity.DefaultNotificationPreference(userID, event)
+		}
+		response.Preferences = append(response.Preferences, *dto.ToNotificationPreferenceResponse(preference, tmpl.description))
+	}
+	return response, nil
+}
+
+func (u *notificationUseCase) UpdateMyPreference(ctx context.Context, userID int, event string, req *request.UpdateNotificationPreferenceRequest) (*dto.NotificationPreferenceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	// Only events about a single user follow user preferences
+	notificationEvent := entity.NotificationEvent(strings.ToUpper(event))
+	tmpl := notificationTemplates[notificationEvent]
+	if notificationEvent.Validate() != nil || tmpl == nil || !tmpl.personal {
+		return nil, domain.ErrNotificationEventNotFound
+	}
+
+	preference := entity.DefaultNotificationPreference(userID, notificationEvent)
+	if req.Channel != "" {
+		preference.Channel = entity.PreferredChannel(req.Channel)
+	}
+	preference.OptOut = req.OptOut
+	preference.QuietStart = req.QuietStart
+	preference.QuietEnd = req.QuietEnd
+	preference.UpdatedAt = time.Now()
+	if err := preference.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := u.preferenceRepo.Upsert(ctx, preference); err != nil {
+		return nil, fmt.Errorf("failed to save notification preference: %w", err)
+	}
+	return dto.ToNotificationPreferenceResponse(preference, tmpl.description), nil
+}
+
+func toNotificationEventResponse(event entity.NotificationEvent, routes []*entity.NotificationRoute) dto.NotificationEventResponse {
+	response := dto.NotificationEventResponse{
+		Event:                string(event),
+		ChannelIds:           []int{},
+		DepartmentChannelIds: map[string][]int{},
+	}
+	for _, route := range routes {
+		if route.Department == "" {
+			response.ChannelIds = append(response.ChannelIds, route.ChannelId)
+		} else {
+			response.DepartmentChannelIds[route.Department] = append(response.DepartmentChannelIds[route.Department], route.ChannelId)
+		}
+	}
+	if tmpl := notificationTemplates[event]; tmpl != nil {
+		response.Description = tmpl.description
+		response.Fallback = tmpl.fallback
+	}
+	return response
+}
@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/application/transaction"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+)
+
+const (
+	// outboxBatchSize is the number of messages claimed at a time
+	outboxBatchSize = 20
+	// outboxClaimTimeout is how long other instances leave a claimed message alone; a delivery
+	// taking longer may be repeated
+	outboxClaimTimeout = 2 * time.Minute
+)
+
+// OutboxHandler delivers the payload of one kind of outbox message
+type OutboxHandler func(ctx context.Context, payload []byte) error
+
+// PermanentError marks a delivery failure that retrying cannot fix; the message is dead-lettered at once
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.Err
+}
+
+// OutboxService queues notifications in the database transaction of the change they report and
+// delivers them once it has committed. Deliveries are retried with exponential backoff and
+// dead-lettered after entity.MaxOutboxAttempts; a message may be delivered more than once if
+// an instance stops mid-delivery.
+type OutboxService interface {
+	// RegisterHandler sets the handler delivering messages of kind
+	RegisterHandler(kind string, handler OutboxHandler)
+	// Enqueue stores payload as JSON in the transaction in ctx. A message whose idempotency
+	// key was already queued is dropped.
+	Enqueue(ctx context.Context, kind string, idempotencyKey string, payload interface{}) error
+	// EnqueueAt is Enqueue for a message that must not be delivered before notBefore
+	EnqueueAt(ctx context.Context, kind string, idempotencyKey string, payload interface{}, notBefore time.Time) error
+	// DispatchDue delivers the messages that are due and returns how many were sent
+	DispatchDue(ctx context.Context) (int, error)
+	// Wake makes the dispatcher look for due messages now rather than at its next poll
+	Wake()
+	// Start dispatches due messages every interval, and whenever woken, until ctx is done
+	Start(ctx context.Context, interval time.Duration)
+}
+
+type outboxService struct {
+	outboxRepo repository.OutboxRepository
+	instance   string
+	claims     atomic.Int64
+	wake       chan struct{}
+
+	mu       sync.RWMutex
+	handlers map[string]OutboxHandler
+}
+
+func NewOutboxService(outboxRepo repository.OutboxRepository) OutboxService {
+	hostname, _ := os.Hostname()
+	return &outboxService{
+		outboxRepo: outboxRepo,
+		instance:   fmt.Sprintf("%s-%d-%d", hostname, os.Getpid(), time.Now().UnixNano()),
+		wake:       make(chan struct{}, 1),
+		handlers:   make(map[string]OutboxHandler),
+	}
+}
+
+func (s *outboxService) RegisterHandler(kind string, handler OutboxHandler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[kind] = handler
+}
+
+func (s *outboxService) Enqueue(ctx context.Context, kind string, idempotencyKey string, payload interface{}) error {
+	return s.EnqueueAt(ctx, kind, idempotencyKey, payload, time.Now())
+}
+
+func (s *outboxService) EnqueueAt(ctx context.Context, kind string, idempotencyKey string, payload interface{}, notBefore time.Time) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode outbox message: %w", err)
+	}
+	message, err := entity.NewOutboxMessage(kind, idempotencyKey, string(data))
+	if err != nil {
+		return err
+	}
+	if notBefore.After(message.NextAttemptAt) {
+		message.NextAttemptAt = notBefore
+	}
+	if err := s.outboxRepo.Enqueue(ctx, message); err != nil {
+		return fmt.Errorf("failed to queue outbox message: %w", err)
+	}
+
+	transaction.AfterCommit(ctx, s.Wake)
+	return nil
+}
+
+func (s *outboxService) Wake() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+		// A wake-up is already pending
+	}
+}
+
+func (s *outboxService) Start(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			case <-s.wake:
+			}
+			if _, err := s.DispatchDue(ctx); err != nil {
+				log.Printf("Failed to dispatch outbox messages: %v", err)
+			}
+		}
+	}()
+}
+
+func (s *outboxService) DispatchDue(ctx context.Context) (int, error) {
+	sent := 0
+	for {
+		now := time.Now()
+		// Every claim gets its own token so that a batch only returns the messages it claimed
+		claimant := fmt.Sprintf("%s-%d", s.instance, s.claims.Add(1))
+		messages, err := s.outboxRepo.ClaimDue(ctx, claimant, now, now.Add(outboxClaimTimeout), outboxBatchSize)
+		if err != nil {
+			return sent, fmt.Errorf("failed to claim outbox messages: %w", err)
+		}
+		if len(messages) == 0 {
+			return sent, nil
+		}
+
+		for _, message := range messages {
+			if s.deliver(ctx, message) {
+				sent++
+			}
+		}
+	}
+}
+
+// deliver hands the message to its handler and records the outcome
+func (s *outboxService) deliver(ctx context.Context, message *entity.OutboxMessage) bool {
+	s.mu.RLock()
+	handler, ok := s.handlers[message.Kind]
+	s.mu.RUnlock()
+
+	var err error
+	if ok {
+		err = handler(ctx, []byte(message.Payload))
+	} else {
+		err = &PermanentError{Err: fmt.Errorf("no handler for outbox message kind %s", message.Kind)}
+	}
+
+	if err == nil {
+		message.MarkSent(time.Now())
+	} else {
+		var permanent *PermanentError
+		message.MarkFailed(err, errors.As(err, &permanent), time.Now())
+		log.Printf("Failed to deliver outbox message %d (%s, attempt %d): %v", message.Id, message.Kind, message.Attempts, err)
+	}
+
+	if _, err := s.outboxRepo.Update(ctx, message); err != nil {
+		log.Printf("Failed to update outbox message %d: %v", message.Id, err)
+	}
+	return message.Status == entity.OutboxStatusSent
+}
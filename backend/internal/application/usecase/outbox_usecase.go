@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/application/transaction"
+	"github.com/attendance_report_app/backend/internal/domain"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+)
+
+// OutboxUseCase lets admins inspect queued notifications and resend dead-lettered ones
+type OutboxUseCase interface {
+	// GetMessages lists the messages in a status, newest first (ADMIN only)
+	// NOTE: Caller must verify ADMIN role before calling this method
+	GetMessages(ctx context.Context, req *request.GetOutboxMessagesRequest) (*dto.PaginationResponse, error)
+
+	// ResendMessage queues a dead-lettered message again with a fresh set of attempts (ADMIN only)
+	// NOTE: Caller must verify ADMIN role before calling this method
+	ResendMessage(ctx context.Context, id int) (*dto.OutboxMessageResponse, error)
+}
+
+type outboxUseCase struct {
+	outboxRepo    repository.OutboxRepository
+	outboxService OutboxService
+}
+
+func NewOutboxUseCase(outboxRepo repository.OutboxRepository, outboxService OutboxService) OutboxUseCase {
+	return &outboxUseCase{
+		outboxRepo:    outboxRepo,
+		outboxService: outboxService,
+	}
+}
+
+func (u *outboxUseCase) GetMessages(ctx context.Context, req *request.GetOutboxMessagesRequest) (*dto.PaginationResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	messages, total, err := u.outboxRepo.FindByStatus(ctx, entity.OutboxStatus(req.Status), offset, req.PerPage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get outbox messages: %w", err)
+	}
+	return dto.ToPaginationResponse(dto.ToOutboxMessageResponses(messages), total, req.Page, req.PerPage), nil
+}
+
+func (u *outboxUseCase) ResendMessage(ctx context.Context, id int) (*dto.OutboxMessageResponse, error) {
+	message, err := u.outboxRepo.FindById(ctx, id)
+	if err != nil {
+		return nil, domain.ErrOutboxMessageNotFound
+	}
+	if err := message.Resend(time.Now()); err != nil {
+		return nil, domain.ErrOutboxNotResendable
+	}
+
+	updatedMessage, err := u.outboxRepo.Update(ctx, message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update outbox message: %w", err)
+	}
+
+	transaction.AfterCommit(ctx, u.outboxService.Wake)
+	return dto.ToOutboxMessageResponse(updatedMessage), nil
+}
@@ -0,0 +1,497 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+)
+
+const (
+	// StatutoryDailyHours is the daily working hours limit (法定労働時間) used
+	// as the overtime threshold for users without a fixed schedule
+	StatutoryDailyHours = 8.0
+
+	// OvertimePremiumRate is the statutory overtime premium (割増賃金率)
+	OvertimePremiumRate = 1.25
+)
+
+// PayrollCalculator computes per-employee payroll lines for a month.
+// It is shared by the live payroll view and payroll runs so that a
+// finalized snapshot always matches what was reviewed as a draft.
+type PayrollCalculator interface {
+	// CalculateMonth returns one payroll line per employee employed during the month starting at monthTime
+	CalculateMonth(ctx context.Context, monthTime time.Time) ([]*entity.PayrollLine, error)
+
+	// CalculateUserMonth returns a single user's payroll line, or nil if the user was not employed during the month
+	CalculateUserMonth(ctx context.Context, user *entity.User, monthTime time.Time) (*entity.PayrollLine, error)
+
+	// CalculateMonthData is CalculateMonth returning the attendances and calendar the lines were computed from
+	CalculateMonthData(ctx context.Context, monthTime time.Time) (*PayrollMonthData, error)
+
+	// CalculateUserMonthData is CalculateUserMonth returning the attendances and calendar the line was
+	// computed from; Lines is empty if the user was not employed during the month
+	CalculateUserMonthData(ctx context.Context, user *entity.User, monthTime time.Time) (*PayrollMonthData, error)
+}
+
+// PayrollMonthData is a month's payroll lines together with the data they were calculated from
+type PayrollMonthData struct {
+	Lines             []*entity.PayrollLine
+	AttendancesByUser map[int][]*entity.Attendance
+	Calendar          *entity.WorkCalendar
+}
+
+type payrollCalculator struct {
+	userRepo       repository.UserRepository
+	attendanceRepo repository.AttendanceRepository
+	holidayRepo    repository.HolidayRepository
+	allowanceRepo  repository.AllowanceRepository
+	bonusRepo      repository.BonusRepository
+}
+
+func NewPayrollCalculator(
+	userRepo repository.UserRepository,
+	attendanceRepo repository.AttendanceRepository,
+	holidayRepo repository.HolidayRepository,
+	allowanceRepo repository.AllowanceRepository,
+	bonusRepo repository.BonusRepository,
+) PayrollCalculator {
+	return &payrollCalculator{
+		userRepo:       userRepo,
+		attendanceRepo: attendanceRepo,
+		holidayRepo:    holidayRepo,
+		allowanceRepo:  allowanceRepo,
+		bonusRepo:      bonusRepo,
+	}
+}
+
+// PayrollInput is everything needed to compute one employee's pay for a month
+type PayrollInput struct {
+	User        *entity.User
+	Attendances []*entity.Attendance
+	Allowances  []*entity.UserAllowance
+	Bonuses     []*entity.Bonus
+}
+
+func (c *payrollCalculator) CalculateMonth(ctx context.Context, monthTime time.Time) ([]*entity.PayrollLine, error) {
+	data, err := c.CalculateMonthData(ctx, monthTime)
+	if err != nil {
+		return nil, err
+	}
+	return data.Lines, nil
+}
+
+func (c *payrollCalculator) CalculateUserMonth(ctx context.Context, user *entity.User, monthTime time.Time) (*entity.PayrollLine, error) {
+	data, err := c.CalculateUserMonthData(ctx, user, monthTime)
+	if err != nil || len(data.Lines) == 0 {
+		return nil, err
+	}
+	return data.Lines[0], nil
+}
+
+func (c *payrollCalculator) CalculateMonthData(ctx context.Context, monthTime time.Time) (*PayrollMonthData, error) {
+	// Get first and last day of the month
+	startDate := monthTime
+	endDate := monthTime.AddDate(0, 1, 0).Add(-time.Second)
+
+	// Get all users
+	users, err := c.userRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+
+	calendar, err := c.calendar(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	allowancesByUser, bonusesByUser, err := c.compensation(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Load the month's attendances in one query instead of one query per user
+	attendances, err := c.attendanceRepo.FindAllByDatePeriod(ctx, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attendances: %w", err)
+	}
+	attendancesByUser := make(map[int][]*entity.Attendance)
+	for _, attendance := range attendances {
+		attendancesByUser[attendance.UserId] = append(attendancesByUser[attendance.UserId], attendance)
+	}
+
+	lines := make([]*entity.PayrollLine, 0)
+
+	// Calculate payroll for each user
+	for _, user := range users {
+		if user.Role != entity.UserRoleUser {
+			continue // Skip non-employee users (e.g., admins)
+		}
+		if _, _, employed := employmentPeriod(user, startDate, endDate); !employed {
+			continue // Skip users not employed during this month
+		}
+
+		input := PayrollInput{
+			User:        user,
+			Attendances: attendancesByUser[user.Id],
+			Allowances:  allowancesByUser[user.Id],
+			Bonuses:     bonusesByUser[user.Id],
+		}
+		lines = append(lines, CalculatePayrollLine(input, calendar, monthTime, time.Now()))
+	}
+
+	return &PayrollMonthData{Lines: lines, AttendancesByUser: attendancesByUser, Calendar: calendar}, nil
+}
+
+func (c *payrollCalculator) CalculateUserMonthData(ctx context.Context, user *entity.User, monthTime time.Time) (*PayrollMonthData, error) {
+	startDate := monthTime
+	endDate := monthTime.AddDate(0, 1, 0).Add(-time.Second)
+
+	if _, _, employed := employmentPeriod(user, startDate, endDate); !employed {
+		return &PayrollMonthData{Lines: []*entity.PayrollLine{}}, nil
+	}
+
+	calendar, err := c.calendar(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	allowancesByUser, bonusesByUser, err := c.compensation(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	attendances, err := c.attendanceRepo.FindByDatePeriod(ctx, user.Id, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attendances for user %d: %w", user.Id, err)
+	}
+
+	input := PayrollInput{
+		User:        user,
+		Attendances: attendances,
+		Allowances:  allowancesByUser[user.Id],
+		Bonuses:     bonusesByUser[user.Id],
+	}
+	return &PayrollMonthData{
+		Lines:             []*entity.PayrollLine{CalculatePayrollLine(input, calendar, monthTime, time.Now())},
+		AttendancesByUser: map[int][]*entity.Attendance{user.Id: attendances},
+		Calendar:          calendar,
+	}, nil
+}
+
+func (c *payrollCalculator) calendar(ctx context.Context, startDate, endDate time.Time) (*entity.WorkCalendar, error) {
+	holidays, err := c.holidayRepo.FindByPeriod(ctx, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get holidays: %w", err)
+	}
+	return entity.NewWorkCalendar(holidays), nil
+}
+
+// compensation returns the allowance assignments and bonuses of the period grouped by user
+func (c *payrollCalculator) compensation(ctx context.Context, startDate, endDate time.Time) (map[int][]*entity.UserAllowance, map[int][]*entity.Bonus, error) {
+	userAllowances, err := c.allowanceRepo.FindUserAllowancesByPeriod(ctx, startDate, endDate)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get allowances: %w", err)
+	}
+	allowancesByUser := make(map[int][]*entity.UserAllowance)
+	for _, userAllowance := range userAllowances {
+		allowancesByUser[userAllowance.UserId] = append(allowancesByUser[userAllowance.UserId], userAllowance)
+	}
+
+	bonuses, err := c.bonusRepo.FindByPeriod(ctx, startDate, endDate)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get bonuses: %w", err)
+	}
+	bonusesByUser := make(map[int][]*entity.Bonus)
+	for _, bonus := range bonuses {
+		bonusesByUser[bonus.UserId] = append(bonusesByUser[bonus.UserId], bonus)
+	}
+
+	return allowancesByUser, bonusesByUser, nil
+}
+
+// CalculatePayrollLine computes one employee's pay for the month starting at monthStart.
+// Working days after asOf are never counted as absences, so the current month
+// can be previewed without deducting days that have not happened yet.
+func CalculatePayrollLine(input PayrollInput, calendar *entity.WorkCalendar, monthStart, asOf time.Time) *entity.PayrollLine {
+	user, attendances := input.User, input.Attendances
+	monthEnd := monthStart.AddDate(0, 1, -1)
+
+	line := &entity.PayrollLine{
+		UserId:      user.Id,
+		UserName:    user.Name,
+		PayType:     user.PayType,
+		PayRate:     user.PayRate,
+		WorkingDays: calendar.WorkingDays(monthStart, monthEnd),
+	}
+
+	// Calculate total hours for the month
+	for _, attendance := range attendances {
+		line.TotalHours += CalculateWorkingHours(attendance)
+	}
+	line.WorkedDays = CountWorkedDays(attendances)
+	line.OvertimeHours = overtimeHours(attendances, dailyThresholdHours(user))
+
+	switch user.PayType {
+	case entity.PayTypeSalary:
+		addMonthlyPayItems(line, user, attendances, calendar, monthStart, monthEnd, asOf)
+	case entity.PayTypeFixedOvertime:
+		addMonthlyPayItems(line, user, attendances, calendar, monthStart, monthEnd, asOf)
+		addOvertimeExcessItem(line, user)
+	case entity.PayTypeDaily:
+		line.AddItem(entity.PayrollItemCodeDailyPay, "日給", entity.PayrollItemKindEarning,
+			CalculateSalary(user.PayType, user.PayRate, line.TotalHours, line.WorkedDays))
+	default:
+		line.AddItem(entity.PayrollItemCodeHourlyPay, "時給", entity.PayrollItemKindEarning,
+			CalculateSalary(user.PayType, user.PayRate, line.TotalHours, line.WorkedDays))
+	}
+
+	addAllowanceItems(line, input.Allowances, monthStart, monthEnd)
+	for _, bonus := range input.Bonuses {
+		if bonus.Taxable {
+			line.AddItem(entity.PayrollItemCodeBonus, bonus.Name, entity.PayrollItemKindEarning, bonus.Amount)
+		} else {
+			line.AddNonTaxableItem(entity.PayrollItemCodeBonus, bonus.Name, bonus.Amount)
+		}
+	}
+
+	line.TotalSalary = line.Total()
+	return line
+}
+
+// addMonthlyPayItems adds the prorated base salary (基本給) and the absence
+// (欠勤控除) and late-arrival/early-leave (遅刻早退控除) deductions.
+// Deductions use the daily rate PayRate / scheduled working days in the month.
+func addMonthlyPayItems(line *entity.PayrollLine, user *entity.User, attendances []*entity.Attendance, calendar *entity.WorkCalendar, monthStart, monthEnd, asOf time.Time) {
+	periodStart, periodEnd, employed := employmentPeriod(user, monthStart, monthEnd)
+	if !employed {
+		return
+	}
+
+	line.AddItem(entity.PayrollItemCodeBasePay, "基本給", entity.PayrollItemKindEarning,
+		proratedSalary(user, calendar, monthStart, monthEnd, periodStart, periodEnd))
+
+	if line.WorkingDays == 0 {
+		return
+	}
+	dailyRate := float64(user.PayRate) / float64(line.WorkingDays)
+
+	// 欠勤控除: scheduled working days without any attendance, up to the day before asOf
+	attended := make(map[string]bool, len(attendances))
+	for _, attendance := range attendances {
+		attended[attendance.Date.Format(DateFormat)] = true
+	}
+	absenceEnd := periodEnd
+	yesterday := time.Date(asOf.Year(), asOf.Month(), asOf.Day()-1, 0, 0, 0, 0, monthStart.Location())
+	if yesterday.Before(absenceEnd) {
+		absenceEnd = yesterday
+	}
+	for day := periodStart; !day.After(absenceEnd); day = day.AddDate(0, 0, 1) {
+		if calendar.IsWorkingDay(day) && !attended[day.Format(DateFormat)] {
+			line.AbsenceDays++
+		}
+	}
+	if line.AbsenceDays > 0 {
+		line.AddItem(entity.PayrollItemCodeAbsenceDeduction, "欠勤控除", entity.PayrollItemKindDeduction,
+			int(dailyRate*float64(line.AbsenceDays)))
+	}
+
+	// 遅刻早退控除: minutes outside the user's scheduled hours on working days
+	dailyMinutes := user.ScheduledDailyMinutes()
+	if dailyMinutes <= 0 {
+		return
+	}
+	for _, span := range workSpansByDate(attendances) {
+		if calendar.IsWorkingDay(span.Date) {
+			line.LateEarlyMinutes += lateEarlyMinutes(user, span)
+		}
+	}
+	if line.LateEarlyMinutes > 0 {
+		minuteRate := dailyRate / float64(dailyMinutes)
+		line.AddItem(entity.PayrollItemCodeLateEarlyDeduction, "遅刻早退控除", entity.PayrollItemKindDeduction,
+			int(minuteRate*float64(line.LateEarlyMinutes)))
+	}
+}
+
+// addAllowanceItems adds one earning per allowance (手当). When an allowance
+// has several assignments overlapping the month, the most recent one wins.
+func addAllowanceItems(line *entity.PayrollLine, userAllowances []*entity.UserAllowance, monthStart, monthEnd time.Time) {
+	latest := make(map[int]*entity.UserAllowance)
+	order := make([]int, 0)
+	for _, userAllowance := range userAllowances {
+		if !userAllowance.IsEffectiveDuring(monthStart, monthEnd) {
+			continue
+		}
+		current, exists := latest[userAllowance.AllowanceId]
+		if !exists {
+			order = append(order, userAllowance.AllowanceId)
+		}
+		if !exists || userAllowance.EffectiveFrom.After(current.EffectiveFrom) {
+			latest[userAllowance.AllowanceId] = userAllowance
+		}
+	}
+
+	for _, allowanceId := range order {
+		userAllowance := latest[allowanceId]
+		if userAllowance.Amount == 0 || userAllowance.Allowance == nil {
+			continue
+		}
+		if userAllowance.Allowance.Taxable {
+			line.AddItem(entity.PayrollItemCodeAllowance, userAllowance.Allowance.Name, entity.PayrollItemKindEarning, userAllowance.Amount)
+		} else {
+			line.AddNonTaxableItem(entity.PayrollItemCodeAllowance, userAllowance.Allowance.Name, userAllowance.Amount)
+		}
+	}
+}
+
+// addOvertimeExcessItem pays overtime beyond the hours included in a
+// fixed-overtime salary (みなし残業) as a separate earning. The hourly unit
+// price is the monthly salary divided by the scheduled hours of the month.
+func addOvertimeExcessItem(line *entity.PayrollLine, user *entity.User) {
+	excessHours := line.OvertimeHours - float64(user.FixedOvertimeHours)
+	scheduledHours := float64(line.WorkingDays) * dailyThresholdHours(user)
+	if excessHours <= 0 || scheduledHours <= 0 {
+		return
+	}
+
+	hourlyRate := float64(user.PayRate) / scheduledHours
+	line.AddItem(entity.PayrollItemCodeOvertimeExcessPay, "固定残業超過手当", entity.PayrollItemKindEarning,
+		int(excessHours*hourlyRate*OvertimePremiumRate))
+}
+
+// dailyThresholdHours returns the user's scheduled daily hours, or the statutory hours without a schedule
+func dailyThresholdHours(user *entity.User) float64 {
+	if minutes := user.ScheduledDailyMinutes(); minutes > 0 {
+		return float64(minutes) / 60
+	}
+	return StatutoryDailyHours
+}
+
+// overtimeHours sums, per date, the hours worked beyond thresholdHours
+func overtimeHours(attendances []*entity.Attendance, thresholdHours float64) float64 {
+	hoursByDate := make(map[string]float64)
+	for _, attendance := range attendances {
+		hoursByDate[attendance.Date.Format(DateFormat)] += CalculateWorkingHours(attendance)
+	}
+
+	var overtime float64
+	for _, hours := range hoursByDate {
+		if hours > thresholdHours {
+			overtime += hours - thresholdHours
+		}
+	}
+	return overtime
+}
+
+// proratedSalary returns the monthly salary prorated to the employment period
+// according to the user's proration policy
+func proratedSalary(user *entity.User, calendar *entity.WorkCalendar, monthStart, monthEnd, periodStart, periodEnd time.Time) int {
+	if periodStart.Equal(monthStart) && periodEnd.Equal(monthEnd) {
+		return user.PayRate
+	}
+
+	switch user.ProrationPolicy {
+	case entity.ProrationPolicyWorkingDays:
+		monthDays := calendar.WorkingDays(monthStart, monthEnd)
+		if monthDays == 0 {
+			return 0
+		}
+		return user.PayRate * calendar.WorkingDays(periodStart, periodEnd) / monthDays
+	default:
+		return user.PayRate * entity.CalendarDays(periodStart, periodEnd) / entity.CalendarDays(monthStart, monthEnd)
+	}
+}
+
+// employmentPeriod clips [start, end] to the user's hire and termination dates.
+// It returns false if the user was not employed at any point in the range.
+func employmentPeriod(user *entity.User, start, end time.Time) (time.Time, time.Time, bool) {
+	periodStart := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+	periodEnd := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, start.Location())
+
+	if user.HireDate != nil {
+		hire := time.Date(user.HireDate.Year(), user.HireDate.Month(), user.HireDate.Day(), 0, 0, 0, 0, start.Location())
+		if hire.After(periodStart) {
+			periodStart = hire
+		}
+	}
+	if user.TerminationDate != nil {
+		termination := time.Date(user.TerminationDate.Year(), user.TerminationDate.Month(), user.TerminationDate.Day(), 0, 0, 0, 0, start.Location())
+		if termination.Before(periodEnd) {
+			periodEnd = termination
+		}
+	}
+
+	return periodStart, periodEnd, !periodStart.After(periodEnd)
+}
+
+// workSpansByDate merges each date's attendances into one running from the first start to the last end,
+// so that a day split into several records is compared with the schedule once
+func workSpansByDate(attendances []*entity.Attendance) []*entity.Attendance {
+	spansByDate := make(map[string]*entity.Attendance)
+	spans := make([]*entity.Attendance, 0, len(attendances))
+	for _, attendance := range attendances {
+		date := attendance.Date.Format(DateFormat)
+		span, exists := spansByDate[date]
+		if !exists {
+			span = &entity.Attendance{
+				UserId:    attendance.UserId,
+				Date:      attendance.Date,
+				StartTime: attendance.StartTime,
+				EndTime:   attendance.EndTime,
+			}
+			spansByDate[date] = span
+			spans = append(spans, span)
+			continue
+		}
+		if attendance.StartTime.Before(span.StartTime) {
+			span.StartTime = attendance.StartTime
+		}
+		if attendance.EndTime.After(span.EndTime) {
+			span.EndTime = attendance.EndTime
+		}
+	}
+	return spans
+}
+
+// lateEarlyMinutes returns how many minutes the attendance started after or ended before the user's schedule.
+// The attendance is compared with the scheduled shift starting nearest to it, so that night shifts such as
+// 22:00-06:00 end on the day after they start.
+func lateEarlyMinutes(user *entity.User, attendance *entity.Attendance) int {
+	scheduledStart, err := entity.ParseClock(user.ScheduledStartTime)
+	if err != nil {
+		return 0
+	}
+	if _, err := entity.ParseClock(user.ScheduledEndTime); err != nil {
+		return 0
+	}
+
+	start := attendance.StartTime
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+	shiftStart := day.Add(time.Duration(scheduledStart) * time.Minute)
+	if shiftStart.Sub(start) > 12*time.Hour {
+		shiftStart = shiftStart.AddDate(0, 0, -1)
+	} else if start.Sub(shiftStart) >= 12*time.Hour {
+		shiftStart = shiftStart.AddDate(0, 0, 1)
+	}
+	shiftEnd := shiftStart.Add(time.Duration(user.ScheduledShiftMinutes()) * time.Minute)
+
+	minutes := 0
+	if start.After(shiftStart) {
+		minutes += int(start.Sub(shiftStart).Minutes())
+	}
+	if attendance.EndTime.Before(shiftEnd) {
+		minutes += int(shiftEnd.Sub(attendance.EndTime).Minutes())
+	}
+	return minutes
+}
+
+// sumPayrollLines returns the total salary of the given lines
+func sumPayrollLines(lines []*entity.PayrollLine) int {
+	total := 0
+	for _, line := range lines {
+		total += line.TotalSalary
+	}
+	return total
+}
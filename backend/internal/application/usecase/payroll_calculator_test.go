@@ -0,0 +1,115 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/application/usecase"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+// newShift returns an attendance on date from start to end ("HH:MM"); an end before the start is on the next day
+func newShift(date, start, end string) *entity.Attendance {
+	day, _ := time.Parse("2006-01-02", date)
+	startClock, _ := entity.ParseClock(start)
+	endClock, _ := entity.ParseClock(end)
+	if endClock < startClock {
+		endClock += 24 * 60
+	}
+	return &entity.Attendance{
+		UserId:    1,
+		Date:      day,
+		StartTime: day.Add(time.Duration(startClock) * time.Minute),
+		EndTime:   day.Add(time.Duration(endClock) * time.Minute),
+	}
+}
+
+func TestCalculatePayrollLineLateEarlyMinutes(t *testing.T) {
+	monthStart := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		scheduledStart   string
+		scheduledEnd     string
+		attendances      []*entity.Attendance
+		lateEarlyMinutes int
+	}{
+		{
+			name:           "on schedule",
+			scheduledStart: "09:00", scheduledEnd: "18:00",
+			attendances:      []*entity.Attendance{newShift("2024-04-01", "09:00", "18:00")},
+			lateEarlyMinutes: 0,
+		},
+		{
+			name:           "late and early",
+			scheduledStart: "09:00", scheduledEnd: "18:00",
+			attendances:      []*entity.Attendance{newShift("2024-04-01", "09:30", "17:45")},
+			lateEarlyMinutes: 45,
+		},
+		{
+			name:           "split day",
+			scheduledStart: "09:00", scheduledEnd: "18:00",
+			attendances: []*entity.Attendance{
+				newShift("2024-04-01", "09:00", "12:00"),
+				newShift("2024-04-01", "13:00", "18:00"),
+			},
+			lateEarlyMinutes: 0,
+		},
+		{
+			name:           "split day recorded out of order, late and early",
+			scheduledStart: "09:00", scheduledEnd: "18:00",
+			attendances: []*entity.Attendance{
+				newShift("2024-04-01", "13:00", "17:30"),
+				newShift("2024-04-01", "09:20", "12:00"),
+			},
+			lateEarlyMinutes: 50,
+		},
+		{
+			name:           "night shift",
+			scheduledStart: "22:00", scheduledEnd: "06:00",
+			attendances:      []*entity.Attendance{newShift("2024-04-01", "22:00", "06:00")},
+			lateEarlyMinutes: 0,
+		},
+		{
+			name:           "night shift, late and early",
+			scheduledStart: "22:00", scheduledEnd: "06:00",
+			attendances:      []*entity.Attendance{newShift("2024-04-01", "22:10", "05:30")},
+			lateEarlyMinutes: 40,
+		},
+		{
+			name:           "weekend is not charged",
+			scheduledStart: "09:00", scheduledEnd: "18:00",
+			attendances:      []*entity.Attendance{newShift("2024-04-06", "10:00", "15:00")},
+			lateEarlyMinutes: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := &entity.User{
+				Id:                    1,
+				PayType:               entity.PayTypeSalary,
+				PayRate:               300000,
+				ScheduledStartTime:    tt.scheduledStart,
+				ScheduledEndTime:      tt.scheduledEnd,
+				ScheduledBreakMinutes: 60,
+			}
+			// As of the first of the month, no absences are deducted
+			line := usecase.CalculatePayrollLine(usecase.PayrollInput{User: user, Attendances: tt.attendances},
+				entity.NewWorkCalendar(nil), monthStart, monthStart)
+
+			if line.LateEarlyMinutes != tt.lateEarlyMinutes {
+				t.Errorf("LateEarlyMinutes = %d, want %d", line.LateEarlyMinutes, tt.lateEarlyMinutes)
+			}
+			deducted := false
+			for _, item := range line.Items {
+				if item.Code == entity.PayrollItemCodeLateEarlyDeduction {
+					deducted = true
+				}
+			}
+			if deducted != (tt.lateEarlyMinutes > 0) {
+				t.Errorf("late/early deduction present = %v, want %v", deducted, tt.lateEarlyMinutes > 0)
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/domain"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+)
+
+// PayrollRunUseCase manages the draft -> finalized -> reopened lifecycle of monthly payroll runs.
+// NOTE: Caller must verify ADMIN role before calling any of these methods
+type PayrollRunUseCase interface {
+	// GetPayrollRuns returns all payroll runs, optionally filtered by month
+	GetPayrollRuns(ctx context.Context, month *string) (*dto.PayrollRunsResponse, error)
+
+	// GetPayrollRun returns a run with its lines and audit trail.
+	// Draft runs are recalculated live; finalized and reopened runs return their snapshot.
+	GetPayrollRun(ctx context.Context, id int) (*dto.PayrollRunResponse, error)
+
+	// GetPayslip returns one employee's payslip from the run, with earnings and deductions itemized
+	GetPayslip(ctx context.Context, id int, userID int) (*dto.PayslipResponse, error)
+
+	// CreatePayrollRun creates a draft run. Only one draft or finalized run may exist per month.
+	CreatePayrollRun(ctx context.Context, req *request.CreatePayrollRunRequest, adminID int) (*dto.PayrollRunResponse, error)
+
+	// FinalizePayrollRun snapshots the current figures and locks the month's attendances
+	FinalizePayrollRun(ctx context.Context, id int, adminID int) (*dto.PayrollRunResponse, error)
+
+	// ReopenPayrollRun unlocks a finalized month; the reason is recorded in the audit trail
+	ReopenPayrollRun(ctx context.Context, id int, req *request.ReopenPayrollRunRequest, adminID int) (*dto.PayrollRunResponse, error)
+}
+
+type payrollRunUseCase struct {
+	payrollRunRepo    repository.PayrollRunRepository
+	payrollCalculator PayrollCalculator
+}
+
+func NewPayrollRunUseCase(payrollRunRepo repository.PayrollRunRepository, payrollCalculator PayrollCalculator) PayrollRunUseCase {
+	return &payrollRunUseCase{
+		payrollRunRepo:    payrollRunRepo,
+		payrollCalculator: payrollCalculator,
+	}
+}
+
+func (u *payrollRunUseCase) GetPayrollRuns(ctx context.Context, month *string) (*dto.PayrollRunsResponse, error) {
+	var runs []*entity.PayrollRun
+	var err error
+
+	if month != nil && *month != "" {
+		if _, err := ParseMonth(*month); err != nil {
+			return nil, err
+		}
+		runs, err = u.payrollRunRepo.FindByMonth(ctx, *month)
+	} else {
+		runs, err = u.payrollRunRepo.FindAll(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payroll runs: %w", err)
+	}
+
+	return dto.ToPayrollRunsResponse(runs), nil
+}
+
+func (u *payrollRunUseCase) GetPayrollRun(ctx context.Context, id int) (*dto.PayrollRunResponse, error) {
+	run, err := u.payrollRunRepo.FindById(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("payroll run not found: %w", err)
+	}
+
+	return u.toDetailResponse(ctx, run)
+}
+
+func (u *payrollRunUseCase) GetPayslip(ctx context.Context, id int, userID int) (*dto.PayslipResponse, error) {
+	run, err := u.payrollRunRepo.FindById(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("payroll run not found: %w", err)
+	}
+
+	lines, err := u.runLines(ctx, run)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range lines {
+		if line.UserId == userID {
+			return dto.ToPayslipResponse(run, line), nil
+		}
+	}
+
+	return nil, fmt.Errorf("payslip not found for user %d", userID)
+}
+
+func (u *payrollRunUseCase) CreatePayrollRun(ctx context.Context, req *request.CreatePayrollRunRequest, adminID int) (*dto.PayrollRunResponse, error) {
+	// Validate request
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	run, err := entity.NewPayrollRun(req.Month, adminID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reopened runs are history only; any other run blocks a new draft
+	existingRuns, err := u.payrollRunRepo.FindByMonth(ctx, req.Month)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payroll runs: %w", err)
+	}
+	for _, existing := range existingRuns {
+		if existing.Status != entity.PayrollRunStatusReopened {
+			return nil, domain.ErrPayrollRunExists
+		}
+	}
+
+	// A concurrent request may have opened a run since; the repository rejects it then
+	createdRun, err := u.payrollRunRepo.Create(ctx, run)
+	if errors.Is(err, domain.ErrPayrollRunExists) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create payroll run: %w", err)
+	}
+
+	if err := u.audit(ctx, createdRun.Id, entity.PayrollRunActionCreate, adminID, ""); err != nil {
+		return nil, err
+	}
+
+	return u.toDetailResponse(ctx, createdRun)
+}
+
+func (u *payrollRunUseCase) FinalizePayrollRun(ctx context.Context, id int, adminID int) (*dto.PayrollRunResponse, error) {
+	// The lock makes a concurrent finalize wait and then fail in run.Finalize before any lines are
+	// saved, and attendance edits holding the month's runs commit before the figures are calculated
+	run, err := u.payrollRunRepo.FindByIdForUpdate(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("payroll run not found: %w", err)
+	}
+
+	monthTime, err := ParseMonth(run.Month)
+	if err != nil {
+		return nil, err
+	}
+
+	lines, err := u.payrollCalculator.CalculateMonth(ctx, monthTime)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := run.Finalize(adminID, sumPayrollLines(lines)); err != nil {
+		return nil, err
+	}
+
+	for _, line := range lines {
+		line.RunId = run.Id
+	}
+	if err := u.payrollRunRepo.CreateLines(ctx, lines); err != nil {
+		return nil, fmt.Errorf("failed to save payroll lines: %w", err)
+	}
+
+	updatedRun, err := u.payrollRunRepo.Update(ctx, run)
+	if err != nil {
+		return nil, fmt.Errorf("failed to finalize payroll run: %w", err)
+	}
+
+	if err := u.audit(ctx, run.Id, entity.PayrollRunActionFinalize, adminID, ""); err != nil {
+		return nil, err
+	}
+
+	return u.toDetailResponse(ctx, updatedRun)
+}
+
+func (u *payrollRunUseCase) ReopenPayrollRun(ctx context.Context, id int, req *request.ReopenPayrollRunRequest, adminID int) (*dto.PayrollRunResponse, error) {
+	// Validate request
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	run, err := u.payrollRunRepo.FindByIdForUpdate(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("payroll run not found: %w", err)
+	}
+
+	if err := run.Reopen(adminID, req.Reason); err != nil {
+		return nil, err
+	}
+
+	updatedRun, err := u.payrollRunRepo.Update(ctx, run)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reopen payroll run: %w", err)
+	}
+
+	if err := u.audit(ctx, run.Id, entity.PayrollRunActionReopen, adminID, req.Reason); err != nil {
+		return nil, err
+	}
+
+	return u.toDetailResponse(ctx, updatedRun)
+}
+
+func (u *payrollRunUseCase) audit(ctx context.Context, runID int, action entity.PayrollRunAction, actorID int, reason string) error {
+	audit := &entity.PayrollRunAudit{
+		RunId:   runID,
+		Action:  action,
+		ActorId: actorID,
+		Reason:  reason,
+	}
+	if err := u.payrollRunRepo.CreateAudit(ctx, audit); err != nil {
+		return fmt.Errorf("failed to record payroll run audit: %w", err)
+	}
+	return nil
+}
+
+// runLines returns live figures for draft runs and the persisted snapshot otherwise
+func (u *payrollRunUseCase) runLines(ctx context.Context, run *entity.PayrollRun) ([]*entity.PayrollLine, error) {
+	if run.IsDraft() {
+		monthTime, err := ParseMonth(run.Month)
+		if err != nil {
+			return nil, err
+		}
+		return u.payrollCalculator.CalculateMonth(ctx, monthTime)
+	}
+
+	lines, err := u.payrollRunRepo.FindLinesByRunId(ctx, run.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payroll lines: %w", err)
+	}
+	return lines, nil
+}
+
+func (u *payrollRunUseCase) toDetailResponse(ctx context.Context, run *entity.PayrollRun) (*dto.PayrollRunResponse, error) {
+	lines, err := u.runLines(ctx, run)
+	if err != nil {
+		return nil, err
+	}
+
+	audits, err := u.payrollRunRepo.FindAuditsByRunId(ctx, run.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payroll run audits: %w", err)
+	}
+
+	return dto.ToPayrollRunDetailResponse(run, lines, audits), nil
+}
@@ -0,0 +1,200 @@
+package usecase_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/application/usecase"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+)
+
+// fakeTx collects the row locks taken in a transaction and releases them when it ends
+type fakeTx struct {
+	unlocks []func()
+}
+
+type fakeTxKey struct{}
+
+type fakeTxManager struct{}
+
+func (fakeTxManager) ExecuteInTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx := &fakeTx{}
+	defer func() {
+		for _, unlock := range tx.unlocks {
+			unlock()
+		}
+	}()
+	return fn(context.WithValue(ctx, fakeTxKey{}, tx))
+}
+
+// fakePayrollRunRepository keeps runs in memory; FindByIdForUpdate locks the run until the
+// transaction in ctx ends, like SELECT ... FOR UPDATE
+type fakePayrollRunRepository struct {
+	repository.PayrollRunRepository
+
+	mu       sync.Mutex
+	runs     map[int]entity.PayrollRun
+	rowLocks map[int]*sync.Mutex
+	lines    []*entity.PayrollLine
+	audits   []*entity.PayrollRunAudit
+}
+
+func newFakePayrollRunRepository(runs ...entity.PayrollRun) *fakePayrollRunRepository {
+	r := &fakePayrollRunRepository{runs: make(map[int]entity.PayrollRun), rowLocks: make(map[int]*sync.Mutex)}
+	for _, run := range runs {
+		r.runs[run.Id] = run
+		r.rowLocks[run.Id] = &sync.Mutex{}
+	}
+	return r
+}
+
+func (r *fakePayrollRunRepository) FindById(ctx context.Context, id int) (*entity.PayrollRun, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	run := r.runs[id]
+	return &run, nil
+}
+
+func (r *fakePayrollRunRepository) FindByIdForUpdate(ctx context.Context, id int) (*entity.PayrollRun, error) {
+	r.mu.Lock()
+	rowLock := r.rowLocks[id]
+	r.mu.Unlock()
+
+	rowLock.Lock()
+	tx := ctx.Value(fakeTxKey{}).(*fakeTx)
+	tx.unlocks = append(tx.unlocks, rowLock.Unlock)
+	return r.FindById(ctx, id)
+}
+
+func (r *fakePayrollRunRepository) Update(ctx context.Context, run *entity.PayrollRun) (*entity.PayrollRun, error) {
+	r.mu.Lock()
+	r.runs[run.Id] = *run
+	r.mu.Unlock()
+	return r.FindById(ctx, run.Id)
+}
+
+func (r *fakePayrollRunRepository) CreateLines(ctx context.Context, lines []*entity.PayrollLine) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lines = append(r.lines, lines...)
+	return nil
+}
+
+func (r *fakePayrollRunRepository) FindLinesByRunId(ctx context.Context, runId int) ([]*entity.PayrollLine, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var lines []*entity.PayrollLine
+	for _, line := range r.lines {
+		if line.RunId == runId {
+			lines = append(lines, line)
+		}
+	}
+	return lines, nil
+}
+
+func (r *fakePayrollRunRepository) CreateAudit(ctx context.Context, audit *entity.PayrollRunAudit) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.audits = append(r.audits, audit)
+	return nil
+}
+
+func (r *fakePayrollRunRepository) FindAuditsByRunId(ctx context.Context, runId int) ([]*entity.PayrollRunAudit, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var audits []*entity.PayrollRunAudit
+	for _, audit := range r.audits {
+		if audit.RunId == runId {
+			audits = append(audits, audit)
+		}
+	}
+	return audits, nil
+}
+
+// stubPayrollCalculator returns the same two employees for every month
+type stubPayrollCalculator struct {
+	usecase.PayrollCalculator
+}
+
+func (stubPayrollCalculator) CalculateMonth(ctx context.Context, monthTime time.Time) ([]*entity.PayrollLine, error) {
+	// A slow calculation widens the window in which concurrent finalizes overlap
+	time.Sleep(10 * time.Millisecond)
+	return []*entity.PayrollLine{
+		{UserId: 1, UserName: "山田 太郎", TotalSalary: 300000},
+		{UserId: 2, UserName: "佐藤 花子", TotalSalary: 250000},
+	}, nil
+}
+
+func newDraftPayrollRun() entity.PayrollRun {
+	return entity.PayrollRun{Id: 1, Month: "2024-04", Status: entity.PayrollRunStatusDraft, CreatedBy: 1}
+}
+
+func finalizePayrollRun(uc usecase.PayrollRunUseCase, id int) error {
+	return fakeTxManager{}.ExecuteInTx(context.Background(), func(ctx context.Context) error {
+		_, err := uc.FinalizePayrollRun(ctx, id, 1)
+		return err
+	})
+}
+
+func assertFinalizedOnce(t *testing.T, repo *fakePayrollRunRepository) {
+	t.Helper()
+	if run := repo.runs[1]; run.Status != entity.PayrollRunStatusFinalized || run.TotalPayroll != 550000 {
+		t.Errorf("run = %s with total %d, want FINALIZED with total 550000", run.Status, run.TotalPayroll)
+	}
+	if len(repo.lines) != 2 {
+		t.Errorf("saved %d payroll lines, want 2", len(repo.lines))
+	}
+	finalizeAudits := 0
+	for _, audit := range repo.audits {
+		if audit.Action == entity.PayrollRunActionFinalize {
+			finalizeAudits++
+		}
+	}
+	if finalizeAudits != 1 {
+		t.Errorf("recorded %d finalize audits, want 1", finalizeAudits)
+	}
+}
+
+func TestFinalizePayrollRunTwice(t *testing.T) {
+	repo := newFakePayrollRunRepository(newDraftPayrollRun())
+	uc := usecase.NewPayrollRunUseCase(repo, stubPayrollCalculator{})
+
+	if err := finalizePayrollRun(uc, 1); err != nil {
+		t.Fatalf("first finalize: %v", err)
+	}
+	if err := finalizePayrollRun(uc, 1); err == nil {
+		t.Error("second finalize succeeded, want an error")
+	}
+	assertFinalizedOnce(t, repo)
+}
+
+func TestFinalizePayrollRunConcurrently(t *testing.T) {
+	repo := newFakePayrollRunRepository(newDraftPayrollRun())
+	uc := usecase.NewPayrollRunUseCase(repo, stubPayrollCalculator{})
+
+	const finalizers = 4
+	errs := make(chan error, finalizers)
+	var start sync.WaitGroup
+	start.Add(1)
+	for i := 0; i < finalizers; i++ {
+		go func() {
+			start.Wait()
+			errs <- finalizePayrollRun(uc, 1)
+		}()
+	}
+	start.Done()
+
+	succeeded := 0
+	for i := 0; i < finalizers; i++ {
+		if err := <-errs; err == nil {
+			succeeded++
+		}
+	}
+	if succeeded != 1 {
+		t.Errorf("%d finalizes succeeded, want 1", succeeded)
+	}
+	assertFinalizedOnce(t, repo)
+}
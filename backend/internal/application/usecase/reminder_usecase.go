@@ -0,0 +1,216 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/domain"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+)
+
+// DefaultReminderCutoff is used when REMINDER_CUTOFF is not set
+const DefaultReminderCutoff = "18:00"
+
+// ReminderUseCase finds users who have not logged their working day and reminds them
+type ReminderUseCase interface {
+	// GetMissingEntries lists who is missing attendance or a report, without notifying anyone (ADMIN only)
+	// NOTE: Caller must verify ADMIN role before calling this method
+	GetMissingEntries(ctx context.Context, req *request.RemindersRequest) (*dto.MissingEntriesResponse, error)
+
+	// SendReminders reminds every missing user individually and, if anyone new was reminded, posts
+	// a summary of everyone still missing for admins. A user is due once both the cutoff and their
+	// shift end have passed, so it is run every hour by the reminders job or cmd/remind, or by an
+	// admin; each user is reminded at most once per day. Without a date or cutoff the previous day
+	// is checked first, for shifts that ended after its last run.
+	// NOTE: Caller must verify ADMIN role before calling this method
+	SendReminders(ctx context.Context, req *request.RemindersRequest) (*dto.MissingEntriesResponse, error)
+}
+
+type reminderUseCase struct {
+	userRepo            repository.UserRepository
+	attendanceRepo      repository.AttendanceRepository
+	dailyReportRepo     repository.DailyReportRepository
+	holidayRepo         repository.HolidayRepository
+	sentReminderRepo    repository.SentReminderRepository
+	notificationService NotificationService
+	cutoff              string
+}
+
+// NewReminderUseCase creates the use case; cutoff ("HH:MM") is the default time by which the day
+// must be logged, DefaultReminderCutoff if empty
+func NewReminderUseCase(userRepo repository.UserRepository, attendanceRepo repository.AttendanceRepository, dailyReportRepo repository.DailyReportRepository, holidayRepo repository.HolidayRepository, sentReminderRepo repository.SentReminderRepository, notificationService NotificationService, cutoff string) ReminderUseCase {
+	if cutoff == "" {
+		cutoff = DefaultReminderCutoff
+	}
+	return &reminderUseCase{
+		userRepo:            userRepo,
+		attendanceRepo:      attendanceRepo,
+		dailyReportRepo:     dailyReportRepo,
+		holidayRepo:         holidayRepo,
+		sentReminderRepo:    sentReminderRepo,
+		notificationService: notificationService,
+		cutoff:              cutoff,
+	}
+}
+
+func (u *reminderUseCase) GetMissingEntries(ctx context.Context, req *request.RemindersRequest) (*dto.MissingEntriesResponse, error) {
+	date, cutoff, entries, err := u.findMissing(ctx, req, false)
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToMissingEntriesResponse(date, cutoff, entries), nil
+}
+
+func (u *reminderUseCase) SendReminders(ctx context.Context, req *request.RemindersRequest) (*dto.MissingEntriesResponse, error) {
+	var previous *dto.MissingEntriesResponse
+	if req.Date == "" && req.Cutoff == "" {
+		// A shift ending after the day's last run, e.g. at 23:30, is only due after midnight
+		var err error
+		yesterday := time.Now().AddDate(0, 0, -1).Format(DateFormat)
+		if previous, err = u.sendReminders(ctx, &request.RemindersRequest{Date: yesterday}); err != nil {
+			return nil, err
+		}
+	}
+
+	response, err := u.sendReminders(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response.Previous = previous
+	return response, nil
+}
+
+// sendReminders reminds the users missing on the requested day who have not been reminded yet
+func (u *reminderUseCase) sendReminders(ctx context.Context, req *request.RemindersRequest) (*dto.MissingEntriesResponse, error) {
+	date, cutoff, entries, err := u.findMissing(ctx, req, true)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed, notified := 0, 0
+	for _, entry := range entries {
+		// Claim the user's reminder for the day; earlier runs or other instances may have sent it
+		err := u.sentReminderRepo.Create(ctx, &entity.SentReminder{UserId: entry.User.Id, Date: date, CreatedAt: time.Now()})
+		if errors.Is(err, domain.ErrReminderAlreadySent) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to record reminder: %w", err)
+		}
+		claimed++
+
+		// A failed reminder should not keep the others from being sent
+		err = u.notificationService.Send(ctx, entity.NotificationReminder, entry.User.Id, &ReminderNotice{UserName: entry.User.Name, Date: date, Missing: missingItems(entry)})
+		if errors.Is(err, domain.ErrNotificationOptedOut) {
+			continue
+		}
+		if err != nil {
+			log.Printf("Failed to send notification: %v", err)
+			// Let the next run try again
+			if err := u.sentReminderRepo.Delete(ctx, entry.User.Id, date); err != nil {
+				log.Printf("Failed to release reminder: %v", err)
+			}
+			continue
+		}
+		notified++
+	}
+
+	// Admins get the whole picture whenever a run reminds someone, not just the newcomers
+	if claimed > 0 {
+		lines := make([]string, len(entries))
+		for i, entry := range entries {
+			lines[i] = fmt.Sprintf("%s: %s", entry.User.Name, strings.Join(missingItems(entry), "・"))
+		}
+		if err := u.notificationService.Send(ctx, entity.NotificationMissingSummary, 0, &MissingSummaryNotice{Date: date, Lines: lines}); err != nil {
+			log.Printf("Failed to send notification: %v", err)
+		}
+	}
+
+	response := dto.ToMissingEntriesResponse(date, cutoff, entries)
+	response.Notified = &notified
+	return response, nil
+}
+
+// findMissing loads everything needed to check the requested day and returns the day, the
+// cutoff that was applied and the missing entries. With due set and no cutoff in the request,
+// only the users whose shift has ended by now are returned, and nobody before the day's cutoff.
+func (u *reminderUseCase) findMissing(ctx context.Context, req *request.RemindersRequest, due bool) (time.Time, string, []*entity.MissingEntry, error) {
+	if err := req.Validate(); err != nil {
+		return time.Time{}, "", nil, fmt.Errorf("invalid request: %w", err)
+	}
+	date, err := ParseDate(req.Date)
+	if err != nil {
+		return time.Time{}, "", nil, err
+	}
+	cutoff := u.cutoff
+	if req.Cutoff != "" {
+		cutoff = req.Cutoff
+	}
+	cutoffMinutes, err := entity.ParseClock(cutoff)
+	if err != nil {
+		return time.Time{}, "", nil, fmt.Errorf("invalid reminder cutoff %q: %w", cutoff, err)
+	}
+	asOf := cutoffMinutes
+	if due && req.Cutoff == "" {
+		var started bool
+		if asOf, started = dueAsOf(date, cutoffMinutes, time.Now()); !started {
+			return date, cutoff, nil, nil
+		}
+	}
+
+	holidays, err := u.holidayRepo.FindByPeriod(ctx, date, date)
+	if err != nil {
+		return time.Time{}, "", nil, fmt.Errorf("failed to get holidays: %w", err)
+	}
+	users, err := u.userRepo.FindAll(ctx)
+	if err != nil {
+		return time.Time{}, "", nil, fmt.Errorf("failed to get users: %w", err)
+	}
+	attendances, err := u.attendanceRepo.FindAllByDatePeriod(ctx, date, date.AddDate(0, 0, 1).Add(-time.Second))
+	if err != nil {
+		return time.Time{}, "", nil, fmt.Errorf("failed to get attendances by period: %w", err)
+	}
+	reports, _, err := u.dailyReportRepo.FindByFilter(ctx, repository.DailyReportFilter{
+		StartDate: &date,
+		EndDate:   &date,
+		Status:    entity.ReportStatusPublished,
+		Limit:     len(users) + 1, // at most one report per user and day
+	})
+	if err != nil {
+		return time.Time{}, "", nil, fmt.Errorf("failed to get daily reports: %w", err)
+	}
+
+	entries := entity.FindMissingEntries(users, entity.NewWorkCalendar(holidays), date, asOf, attendances, reports)
+	return date, cutoff, entries, nil
+}
+
+// dueAsOf returns how many minutes of date have passed as of now, counting on into the
+// following days, or false if reminders for date have not started yet: they start at the cutoff
+// on date and then cover every shift that has ended, including those ending after midnight.
+func dueAsOf(date time.Time, cutoff int, now time.Time) (int, bool) {
+	start := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, now.Location())
+	elapsed := int(now.Sub(start).Minutes())
+	if elapsed < cutoff {
+		return 0, false
+	}
+	return elapsed, true
+}
+
+// missingItems names what the user still has to log, as shown in the reminders
+func missingItems(entry *entity.MissingEntry) []string {
+	var items []string
+	if entry.MissingAttendance {
+		items = append(items, "勤怠")
+	}
+	if entry.MissingReport {
+		items = append(items, "日報")
+	}
+	return items
+}
@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDueAsOf(t *testing.T) {
+	date := time.Date(2024, 4, 1, 0, 0, 0, 0, time.Local)
+	cutoff := 18 * 60
+
+	tests := []struct {
+		name    string
+		now     time.Time
+		minutes int
+		due     bool
+	}{
+		{"before the cutoff", date.Add(17*time.Hour + 59*time.Minute), 0, false},
+		{"at the cutoff", date.Add(18 * time.Hour), 18 * 60, true},
+		{"late in the evening", date.Add(23*time.Hour + 30*time.Minute), 23*60 + 30, true},
+		{"after midnight", date.Add(24*time.Hour + 18*time.Minute), 24*60 + 18, true},
+		{"the next evening", date.Add(42 * time.Hour), 42 * 60, true},
+		{"the day before", date.Add(-6 * time.Hour), 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minutes, due := dueAsOf(date, cutoff, tt.now)
+			if minutes != tt.minutes || due != tt.due {
+				t.Errorf("dueAsOf() = %d, %v, want %d, %v", minutes, due, tt.minutes, tt.due)
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/domain"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+)
+
+// ReportCommentUseCase handles feedback on daily reports: threaded comments and emoji reactions.
+// Anyone who can read a report can comment on it and react to it.
+type ReportCommentUseCase interface {
+	GetComments(ctx context.Context, reportID int, userID int) (*dto.ReportCommentsResponse, error)
+
+	// CreateComment adds a comment and notifies the report's author
+	CreateComment(ctx context.Context, reportID int, userID int, req *request.CreateReportCommentRequest) (*dto.ReportCommentResponse, error)
+
+	// UpdateComment edits a comment; its author and admins may edit it
+	UpdateComment(ctx context.Context, commentID int, userID int, req *request.UpdateReportCommentRequest) (*dto.ReportCommentResponse, error)
+
+	// DeleteComment deletes a comment with its replies; its author and admins may delete it
+	DeleteComment(ctx context.Context, commentID int, userID int) error
+
+	GetReactions(ctx context.Context, reportID int, userID int) (*dto.ReportReactionsResponse, error)
+
+	// AddReaction adds the user's reaction; adding the same emoji twice has no effect
+	AddReaction(ctx context.Context, reportID int, userID int, req *request.ReportReactionRequest) (*dto.ReportReactionsResponse, error)
+
+	// RemoveReaction removes the user's own reaction with the emoji
+	RemoveReaction(ctx context.Context, reportID int, userID int, emoji string) (*dto.ReportReactionsResponse, error)
+}
+
+type reportCommentUseCase struct {
+	dailyReportRepo     repository.DailyReportRepository
+	commentRepo         repository.ReportCommentRepository
+	reactionRepo        repository.ReportReactionRepository
+	userRepo            repository.UserRepository
+	notificationService NotificationService
+}
+
+func NewReportCommentUseCase(dailyReportRepo repository.DailyReportRepository, commentRepo repository.ReportCommentRepository, reactionRepo repository.ReportReactionRepository, userRepo repository.UserRepository, notificationService NotificationService) ReportCommentUseCase {
+	return &reportCommentUseCase{
+		dailyReportRepo:     dailyReportRepo,
+		commentRepo:         commentRepo,
+		reactionRepo:        reactionRepo,
+		userRepo:            userRepo,
+		notificationService: notificationService,
+	}
+}
+
+func (u *reportCommentUseCase) GetComments(ctx context.Context, reportID int, userID int) (*dto.ReportCommentsResponse, error) {
+	if _, err := findVisibleReport(ctx, u.dailyReportRepo, u.userRepo, reportID, userID); err != nil {
+		return nil, err
+	}
+
+	comments, err := u.commentRepo.FindByReportId(ctx, reportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comments: %w", err)
+	}
+	return dto.ToReportCommentsResponse(comments), nil
+}
+
+func (u *reportCommentUseCase) CreateComment(ctx context.Context, reportID int, userID int, req *request.CreateReportCommentRequest) (*dto.ReportCommentResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	report, err := findVisibleReport(ctx, u.dailyReportRepo, u.userRepo, reportID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	parentID := req.ParentId
+	if parentID != nil {
+		parent, err := u.commentRepo.FindById(ctx, *parentID)
+		if err != nil || parent.ReportId != reportID {
+			return nil, domain.ErrReportCommentNotFound
+		}
+		// Threads are one level deep: a reply to a reply joins the same thread
+		if parent.IsReply() {
+			parentID = parent.ParentId
+		}
+	}
+
+	comment, err := entity.NewReportComment(reportID, userID, parentID, req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	createdComment, err := u.commentRepo.Create(ctx, comment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create comment: %w", err)
+	}
+
+	if report.UserId != userID {
+		if err := u.notifyAuthor(ctx, report, createdComment); err != nil {
+			return nil, err
+		}
+	}
+
+	return dto.ToReportCommentResponse(createdComment), nil
+}
+
+// notifyAuthor queues a notification about a new comment for the report's author.
+// Comments on restricted reports are not quoted, as the notification goes to the shared channel.
+func (u *reportCommentUseCase) notifyAuthor(ctx context.Context, report *entity.DailyReport, comment *entity.ReportComment) error {
+	body := ""
+	if report.IsShared() {
+		body = comment.Body
+	}
+	return u.notificationService.Notify(ctx, entity.NotificationReportCommented, fmt.Sprintf("report-comment-created:%d", comment.Id), report.UserId, &CommentNotice{
+		ReportAuthor: report.UserName,
+		ReportDate:   report.Date,
+		Commenter:    comment.UserName,
+		Comment:      body,
+	})
+}
+
+func (u *reportCommentUseCase) UpdateComment(ctx context.Context, commentID int, userID int, req *request.UpdateReportCommentRequest) (*dto.ReportCommentResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	comment, err := u.editableComment(ctx, commentID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	comment.Body = strings.TrimSpace(req.Body)
+	if err := comment.Validate(); err != nil {
+		return nil, err
+	}
+
+	updatedComment, err := u.commentRepo.Update(ctx, comment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update comment: %w", err)
+	}
+	return dto.ToReportCommentResponse(updatedComment), nil
+}
+
+func (u *reportCommentUseCase) DeleteComment(ctx context.Context, commentID int, userID int) error {
+	if _, err := u.editableComment(ctx, commentID, userID); err != nil {
+		return err
+	}
+
+	if err := u.commentRepo.Delete(ctx, commentID); err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+	return nil
+}
+
+// editableComment returns the comment if the user wrote it or is an admin
+func (u *reportCommentUseCase) editableComment(ctx context.Context, commentID int, userID int) (*entity.ReportComment, error) {
+	comment, err := u.commentRepo.FindById(ctx, commentID)
+	if err != nil {
+		return nil, domain.ErrReportCommentNotFound
+	}
+	if _, err := findVisibleReport(ctx, u.dailyReportRepo, u.userRepo, comment.ReportId, userID); err != nil {
+		return nil, err
+	}
+
+	if comment.UserId != userID {
+		user, err := u.userRepo.FindById(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("user not found: %w", err)
+		}
+		if !user.IsAdmin() {
+			return nil, domain.ErrForbidden
+		}
+	}
+	return comment, nil
+}
+
+func (u *reportCommentUseCase) GetReactions(ctx context.Context, reportID int, userID int) (*dto.ReportReactionsResponse, error) {
+	if _, err := findVisibleReport(ctx, u.dailyReportRepo, u.userRepo, reportID, userID); err != nil {
+		return nil, err
+	}
+	return u.reactions(ctx, reportID, userID)
+}
+
+func (u *reportCommentUseCase) AddReaction(ctx context.Context, reportID int, userID int, req *request.ReportReactionRequest) (*dto.ReportReactionsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	if _, err := findVisibleReport(ctx, u.dailyReportRepo, u.userRepo, reportID, userID); err != nil {
+		return nil, err
+	}
+
+	reaction, err := entity.NewReportReaction(reportID, userID, req.Emoji)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := u.reactionRepo.Find(ctx, reportID, userID, reaction.Emoji)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reaction: %w", err)
+	}
+	if existing == nil {
+		if _, err := u.reactionRepo.Create(ctx, reaction); err != nil {
+			return nil, fmt.Errorf("failed to add reaction: %w", err)
+		}
+	}
+
+	return u.reactions(ctx, reportID, userID)
+}
+
+func (u *reportCommentUseCase) RemoveReaction(ctx context.Context, reportID int, userID int, emoji string) (*dto.ReportReactionsResponse, error) {
+	if _, err := findVisibleReport(ctx, u.dailyReportRepo, u.userRepo, reportID, userID); err != nil {
+		return nil, err
+	}
+
+	existing, err := u.reactionRepo.Find(ctx, reportID, userID, strings.TrimSpace(emoji))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reaction: %w", err)
+	}
+	if existing != nil {
+		if err := u.reactionRepo.Delete(ctx, existing.Id); err != nil {
+			return nil, fmt.Errorf("failed to remove reaction: %w", err)
+		}
+	}
+
+	return u.reactions(ctx, reportID, userID)
+}
+
+func (u *reportCommentUseCase) reactions(ctx context.Context, reportID int, userID int) (*dto.ReportReactionsResponse, error) {
+	reactions, err := u.reactionRepo.FindByReportId(ctx, reportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reactions: %w", err)
+	}
+	return dto.ToReportReactionsResponse(reactions, userID), nil
+}
@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+// Digests are written in Japanese like the rest of the user-facing text
+
+var digestFuncs = map[string]any{
+	"title":   digestTitle,
+	"date":    func(t time.Time) string { return t.Format(DateFormat) },
+	"day":     digestDay,
+	"minutes": formatMinutes,
+	"content": func(report *entity.DailyReport) string { return demoteHeadings(report.Content(), 2) },
+}
+
+var digestMarkdownTemplate = template.Must(template.New("digest").Funcs(digestFuncs).Parse(`# {{title .}}
+
+- 期間: {{date .StartDate}} 〜 {{date .EndDate}}
+{{- if .Department}}
+- 部署: {{.Department}}
+{{- end}}
+- 勤務日数: {{.WorkedDays}}日
+- 実働時間: {{minutes .TotalWorkMinutes}}
+- 日報: {{.ReportCount}}件
+{{- if .TopTags}}
+
+## よく使われたタグ
+
+{{range $i, $tag := .TopTags}}{{if $i}} {{end}}#{{$tag.Tag}} ({{$tag.Count}}){{end}}
+{{- end}}
+
+## 日別の勤務時間
+
+| 日付 | 実働 | 日報 |
+| --- | --- | --- |
+{{- range .Days}}
+| {{day .Date}} | {{if .WorkMinutes}}{{minutes .WorkMinutes}}{{else}}-{{end}} | {{if .Report}}あり{{else}}なし{{end}} |
+{{- end}}
+{{- range .Days}}{{if .Report}}
+
+### {{day .Date}}
+
+{{content .Report}}
+{{- end}}{{end}}
+`))
+
+var digestHTMLTemplate = htmltemplate.Must(htmltemplate.New("digest").Funcs(digestFuncs).Parse(`<!DOCTYPE html>
+<html lang="ja">
+<head>
+<meta charset="utf-8">
+<title>{{title .}}</title>
+<style>
+body { font-family: sans-serif; max-width: 48rem; margin: 2rem auto; line-height: 1.6; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 0.25rem 0.75rem; }
+.report { white-space: pre-wrap; font-family: monospace; }
+</style>
+</head>
+<body>
+<h1>{{title .}}</h1>
+<ul>
+<li>期間: {{date .StartDate}} 〜 {{date .EndDate}}</li>
+{{- if .Department}}
+<li>部署: {{.Department}}</li>
+{{- end}}
+<li>勤務日数: {{.WorkedDays}}日</li>
+<li>実働時間: {{minutes .TotalWorkMinutes}}</li>
+<li>日報: {{.ReportCount}}件</li>
+</ul>
+{{- if .TopTags}}
+<h2>よく使われたタグ</h2>
+<p>{{range $i, $tag := .TopTags}}{{if $i}} {{end}}#{{$tag.Tag}} ({{$tag.Count}}){{end}}</p>
+{{- end}}
+<h2>日別の勤務時間</h2>
+<table>
+<tr><th>日付</th><th>実働</th><th>日報</th></tr>
+{{- range .Days}}
+<tr><td>{{day .Date}}</td><td>{{if .WorkMinutes}}{{minutes .WorkMinutes}}{{else}}-{{end}}</td><td>{{if .Report}}あり{{else}}なし{{end}}</td></tr>
+{{- end}}
+</table>
+{{- range .Days}}{{if .Report}}
+<h3>{{day .Date}}</h3>
+<div class="report">{{.Report.Content}}</div>
+{{- end}}{{end}}
+</body>
+</html>
+`))
+
+// RenderDigestMarkdown renders the digest as one Markdown document. Headings inside the
+// reports are moved down so that they nest under each day.
+func RenderDigestMarkdown(digest *entity.ReportDigest) (string, error) {
+	var b bytes.Buffer
+	if err := digestMarkdownTemplate.Execute(&b, digest); err != nil {
+		return "", fmt.Errorf("failed to render digest: %w", err)
+	}
+	return b.String(), nil
+}
+
+// RenderDigestHTML renders the digest as a standalone HTML page. Only the frame is HTML:
+// report text is escaped and shown as its Markdown source in a preformatted block, since
+// rendering user-written Markdown would need a converter and an HTML sanitizer.
+func RenderDigestHTML(digest *entity.ReportDigest) (string, error) {
+	var b bytes.Buffer
+	if err := digestHTMLTemplate.Execute(&b, digest); err != nil {
+		return "", fmt.Errorf("failed to render digest: %w", err)
+	}
+	return b.String(), nil
+}
+
+func digestTitle(digest *entity.ReportDigest) string {
+	if digest.Period == entity.DigestPeriodMonth {
+		return fmt.Sprintf("%s 月報 %s", digest.StartDate.Format("2006年1月"), digest.UserName)
+	}
+	return fmt.Sprintf("%s週 週報 %s", digest.StartDate.Format("2006年1月2日"), digest.UserName)
+}
+
+var japaneseWeekdays = []string{"日", "月", "火", "水", "木", "金", "土"}
+
+// digestDay formats a date as e.g. 10/19(月)
+func digestDay(date time.Time) string {
+	return fmt.Sprintf("%d/%d(%s)", int(date.Month()), date.Day(), japaneseWeekdays[date.Weekday()])
+}
+
+// formatMinutes formats a duration as e.g. 7時間30分
+func formatMinutes(minutes int) string {
+	return fmt.Sprintf("%d時間%d分", minutes/60, minutes%60)
+}
+
+// demoteHeadings adds levels to every Markdown heading outside fenced code blocks
+func demoteHeadings(markdown string, levels int) string {
+	lines := strings.Split(markdown, "\n")
+	inFence := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if !inFence && strings.HasPrefix(line, "#") {
+			level := len(line) - len(strings.TrimLeft(line, "#"))
+			if level <= 6 && (len(line) == level || line[level] == ' ') {
+				lines[i] = strings.Repeat("#", min(level+levels, 6)-level) + line
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/domain"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+)
+
+// ReportDigestUseCase compiles a user's daily reports and attendance over a week or month
+type ReportDigestUseCase interface {
+	// GetMyDigest returns the user's own digest, including draft and private reports
+	GetMyDigest(ctx context.Context, userID int, req *request.GetReportDigestRequest) (*dto.ReportDigestResponse, error)
+
+	// GetUserDigest returns another user's digest as seen by viewerID (ADMIN only).
+	// Reports the viewer may not read are left out.
+	// NOTE: Caller must verify ADMIN role before calling this method
+	GetUserDigest(ctx context.Context, userID int, viewerID int, req *request.GetReportDigestRequest) (*dto.ReportDigestResponse, error)
+
+	// PostDigests posts a summary of every employed user's digest for the period to the
+	// REPORT_DIGEST notification channels.
+	// Only reports shared with the whole company are counted. Users with neither attendance
+	// nor reports in the period are skipped. It is run on a schedule by cmd/digest or by an admin.
+	// NOTE: Caller must verify ADMIN role before calling this method
+	PostDigests(ctx context.Context, req *request.PostReportDigestsRequest) (*dto.PostReportDigestsResponse, error)
+}
+
+type reportDigestUseCase struct {
+	dailyReportRepo     repository.DailyReportRepository
+	attendanceRepo      repository.AttendanceRepository
+	userRepo            repository.UserRepository
+	notificationService NotificationService
+}
+
+func NewReportDigestUseCase(dailyReportRepo repository.DailyReportRepository, attendanceRepo repository.AttendanceRepository, userRepo repository.UserRepository, notificationService NotificationService) ReportDigestUseCase {
+	return &reportDigestUseCase{
+		dailyReportRepo:     dailyReportRepo,
+		attendanceRepo:      attendanceRepo,
+		userRepo:            userRepo,
+		notificationService: notificationService,
+	}
+}
+
+func (u *reportDigestUseCase) GetMyDigest(ctx context.Context, userID int, req *request.GetReportDigestRequest) (*dto.ReportDigestResponse, error) {
+	return u.GetUserDigest(ctx, userID, userID, req)
+}
+
+func (u *reportDigestUseCase) GetUserDigest(ctx context.Context, userID int, viewerID int, req *request.GetReportDigestRequest) (*dto.ReportDigestResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	date, err := ParseDate(req.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := u.userRepo.FindById(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+	viewer := user
+	if viewerID != userID {
+		viewer, err = u.userRepo.FindById(ctx, viewerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user: %w", err)
+		}
+	}
+
+	digest, err := u.buildDigest(ctx, user, entity.DigestPeriod(req.Period), date, viewer)
+	if err != nil {
+		return nil, err
+	}
+
+	response := dto.ToReportDigestResponse(digest)
+	switch req.Format {
+	case "markdown":
+		response.Rendered, err = RenderDigestMarkdown(digest)
+	case "html":
+		response.Rendered, err = RenderDigestHTML(digest)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (u *reportDigestUseCase) PostDigests(ctx context.Context, req *request.PostReportDigestsRequest) (*dto.PostReportDigestsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	period := entity.DigestPeriod(req.Period)
+
+	// By default the period that has just ended, as the schedule runs at the start of the next one
+	date := period.Previous(time.Now())
+	if req.Date != "" {
+		var err error
+		if date, err = ParseDate(req.Date); err != nil {
+			return nil, err
+		}
+	}
+	start, end := period.Range(date)
+
+	users, err := u.userRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+
+	response := &dto.PostReportDigestsResponse{StartDate: start, EndDate: end}
+	for _, user := range users {
+		if !user.IsEmployedOn(start) && !user.IsEmployedOn(end) {
+			continue
+		}
+		digest, err := u.buildDigest(ctx, user, period, date, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(digest.Days) == 0 {
+			continue
+		}
+
+		topTags := make([]string, len(digest.TopTags))
+		for i, tag := range digest.TopTags {
+			topTags[i] = tag.Tag
+		}
+		err = u.notificationService.Send(ctx, entity.NotificationReportDigest, user.Id, &DigestNotice{
+			Title:       digestTitle(digest),
+			UserName:    digest.UserName,
+			WorkedDays:  digest.WorkedDays(),
+			WorkMinutes: digest.TotalWorkMinutes(),
+			ReportCount: digest.ReportCount(),
+			TopTags:     topTags,
+		})
+		if errors.Is(err, domain.ErrNotificationOptedOut) {
+			continue
+		}
+		if err != nil {
+			// One failed post should not hold back everyone else's digest
+			log.Printf("Failed to send notification: %v", err)
+			continue
+		}
+		response.Posted++
+	}
+	return response, nil
+}
+
+// buildDigest compiles the user's digest for the period containing date with the reports viewer
+// may read, or only the reports shared with the whole company if viewer is nil
+func (u *reportDigestUseCase) buildDigest(ctx context.Context, user *entity.User, period entity.DigestPeriod, date time.Time, viewer *entity.User) (*entity.ReportDigest, error) {
+	start, end := period.Range(date)
+
+	attendances, err := u.attendanceRepo.FindByDatePeriod(ctx, user.Id, start, end.AddDate(0, 0, 1).Add(-time.Second))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attendances by period: %w", err)
+	}
+
+	filter := repository.DailyReportFilter{
+		UserId:    user.Id,
+		StartDate: &start,
+		EndDate:   &end,
+		Viewer:    viewer,
+		Limit:     entity.CalendarDays(start, end), // at most one report per day
+	}
+	reports, _, err := u.dailyReportRepo.FindByFilter(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily reports: %w", err)
+	}
+	if viewer == nil {
+		shared := make([]*entity.DailyReport, 0, len(reports))
+		for _, report := range reports {
+			if report.IsShared() {
+				shared = append(shared, report)
+			}
+		}
+		reports = shared
+	}
+
+	return entity.NewReportDigest(user, period, date, attendances, reports), nil
+}
@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/application/transaction"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/search"
+)
+
+// ReportSearchService maintains the in-process full-text index of daily reports.
+// Each API process holds its own index: it is restored from the snapshot file at startup
+// (or built from the database without one) and then kept current as reports change.
+// cmd/reindex rebuilds the snapshot from scratch.
+type ReportSearchService interface {
+	// IndexReport (re)indexes a report once the surrounding transaction commits.
+	// Only published reports are searchable; others are removed from the index.
+	IndexReport(ctx context.Context, report *entity.DailyReport)
+
+	// RemoveReport drops a report from the index once the surrounding transaction commits
+	RemoveReport(ctx context.Context, id int)
+
+	// Search returns one page of matching report IDs by relevance and the total number of matches
+	Search(query string, offset, limit int) ([]search.Hit, int)
+
+	// Load restores the index from the snapshot and applies the changes made since it was written.
+	// Without a snapshot the index is rebuilt.
+	Load(ctx context.Context) error
+
+	// Rebuild reads every report into a new index, replaces the current one, and writes the
+	// snapshot if one is configured. It returns the number of indexed reports.
+	Rebuild(ctx context.Context) (int, error)
+}
+
+type reportSearchService struct {
+	dailyReportRepo repository.DailyReportRepository
+	snapshotPath    string
+	index           atomic.Pointer[search.Index]
+}
+
+// NewReportSearchService creates the service with an empty index. An empty snapshotPath
+// disables snapshots, so the index is built from the database on every start.
+func NewReportSearchService(dailyReportRepo repository.DailyReportRepository, snapshotPath string) ReportSearchService {
+	s := &reportSearchService{
+		dailyReportRepo: dailyReportRepo,
+		snapshotPath:    snapshotPath,
+	}
+	s.index.Store(search.NewIndex())
+	return s
+}
+
+func (s *reportSearchService) IndexReport(ctx context.Context, report *entity.DailyReport) {
+	transaction.AfterCommit(ctx, func() {
+		addReport(s.index.Load(), report)
+	})
+}
+
+func (s *reportSearchService) RemoveReport(ctx context.Context, id int) {
+	transaction.AfterCommit(ctx, func() {
+		s.index.Load().Remove(id)
+	})
+}
+
+func (s *reportSearchService) Search(query string, offset, limit int) ([]search.Hit, int) {
+	return s.index.Load().Search(query, offset, limit)
+}
+
+func (s *reportSearchService) Load(ctx context.Context) error {
+	if s.snapshotPath == "" {
+		_, err := s.Rebuild(ctx)
+		return err
+	}
+
+	file, err := os.Open(s.snapshotPath)
+	if errors.Is(err, os.ErrNotExist) {
+		log.Printf("Search index snapshot %s not found, rebuilding", s.snapshotPath)
+		_, err := s.Rebuild(ctx)
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open search index snapshot: %w", err)
+	}
+	defer file.Close()
+
+	index := search.NewIndex()
+	if err := index.Load(file); err != nil {
+		return fmt.Errorf("failed to load search index snapshot: %w", err)
+	}
+	if err := s.catchUp(ctx, index); err != nil {
+		return err
+	}
+	s.index.Store(index)
+	return nil
+}
+
+func (s *reportSearchService) Rebuild(ctx context.Context) (int, error) {
+	startedAt := time.Now()
+
+	reports, err := s.dailyReportRepo.FindAllPublished(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get daily reports: %w", err)
+	}
+
+	index := search.NewIndex()
+	for _, report := range reports {
+		addReport(index, report)
+	}
+	index.SetBuiltAt(startedAt)
+
+	// Pick up reports written while the index was being built
+	if err := s.catchUp(ctx, index); err != nil {
+		return 0, err
+	}
+	s.index.Store(index)
+
+	if s.snapshotPath != "" {
+		if err := s.save(index); err != nil {
+			return 0, err
+		}
+	}
+	return index.Len(), nil
+}
+
+// catchUp applies the reports changed or deleted since the index was built
+func (s *reportSearchService) catchUp(ctx context.Context, index *search.Index) error {
+	startedAt := time.Now()
+
+	changed, err := s.dailyReportRepo.FindUpdatedSince(ctx, index.BuiltAt())
+	if err != nil {
+		return fmt.Errorf("failed to get changed daily reports: %w", err)
+	}
+	for _, report := range changed {
+		addReport(index, report)
+	}
+
+	ids, err := s.dailyReportRepo.FindPublishedIds(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get daily report ids: %w", err)
+	}
+	existing := make(map[int]struct{}, len(ids))
+	for _, id := range ids {
+		existing[id] = struct{}{}
+	}
+	for _, id := range index.Ids() {
+		if _, ok := existing[id]; !ok {
+			index.Remove(id)
+		}
+	}
+
+	index.SetBuiltAt(startedAt)
+	return nil
+}
+
+// addReport indexes a published report and removes any other
+func addReport(index *search.Index, report *entity.DailyReport) {
+	if !report.IsPublished() {
+		index.Remove(report.Id)
+		return
+	}
+	index.Add(search.Document{Id: report.Id, Date: report.Date, Text: report.Content()})
+}
+
+// save writes the snapshot through a temporary file so that readers never see a partial one
+func (s *reportSearchService) save(index *search.Index) error {
+	tmpPath := s.snapshotPath + ".tmp"
+	file, err := os.Create(tmpPath)
+	if err != nil {
+		return fmt.Errorf("failed to create search index snapshot: %w", err)
+	}
+	if err := index.Save(file); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write search index snapshot: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write search index snapshot: %w", err)
+	}
+	if err := os.Rename(tmpPath, s.snapshotPath); err != nil {
+		return fmt.Errorf("failed to write search index snapshot: %w", err)
+	}
+	return nil
+}
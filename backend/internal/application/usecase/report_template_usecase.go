@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+)
+
+type ReportTemplateUseCase interface {
+	// GetReportTemplates returns the templates available for new reports, or all of them with includeInactive
+	GetReportTemplates(ctx context.Context, includeInactive bool) (*dto.ReportTemplatesResponse, error)
+
+	// CreateReportTemplate defines a new template (ADMIN only)
+	// NOTE: Caller must verify ADMIN role before calling this method
+	CreateReportTemplate(ctx context.Context, req *request.CreateReportTemplateRequest) (*dto.ReportTemplateResponse, error)
+
+	// UpdateReportTemplate changes a template (ADMIN only). Existing reports keep the sections they were written with.
+	// NOTE: Caller must verify ADMIN role before calling this method
+	UpdateReportTemplate(ctx context.Context, id int, req *request.UpdateReportTemplateRequest) (*dto.ReportTemplateResponse, error)
+
+	// DeleteReportTemplate deletes a template (ADMIN only); reports written from it keep their sections
+	// NOTE: Caller must verify ADMIN role before calling this method
+	DeleteReportTemplate(ctx context.Context, id int) error
+}
+
+type reportTemplateUseCase struct {
+	templateRepo repository.ReportTemplateRepository
+}
+
+func NewReportTemplateUseCase(templateRepo repository.ReportTemplateRepository) ReportTemplateUseCase {
+	return &reportTemplateUseCase{
+		templateRepo: templateRepo,
+	}
+}
+
+func (u *reportTemplateUseCase) GetReportTemplates(ctx context.Context, includeInactive bool) (*dto.ReportTemplatesResponse, error) {
+	templates, err := u.templateRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get report templates: %w", err)
+	}
+
+	if !includeInactive {
+		active := make([]*entity.ReportTemplate, 0, len(templates))
+		for _, template := range templates {
+			if template.Active {
+				active = append(active, template)
+			}
+		}
+		templates = active
+	}
+
+	return dto.ToReportTemplatesResponse(templates), nil
+}
+
+func (u *reportTemplateUseCase) CreateReportTemplate(ctx context.Context, req *request.CreateReportTemplateRequest) (*dto.ReportTemplateResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	template, err := entity.NewReportTemplate(req.Name, req.Description, toTemplateSections(req.Sections))
+	if err != nil {
+		return nil, err
+	}
+
+	createdTemplate, err := u.templateRepo.Create(ctx, template)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create report template: %w", err)
+	}
+
+	return dto.ToReportTemplateResponse(createdTemplate), nil
+}
+
+func (u *reportTemplateUseCase) UpdateReportTemplate(ctx context.Context, id int, req *request.UpdateReportTemplateRequest) (*dto.ReportTemplateResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	template, err := u.templateRepo.FindById(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("report template not found: %w", err)
+	}
+
+	if req.Name != nil {
+		template.Name = *req.Name
+	}
+	if req.Description != nil {
+		template.Description = *req.Description
+	}
+	if req.Sections != nil {
+		template.Sections = toTemplateSections(req.Sections)
+	}
+	if req.Active != nil {
+		template.Active = *req.Active
+	}
+
+	if err := template.Validate(); err != nil {
+		return nil, err
+	}
+
+	updatedTemplate, err := u.templateRepo.Update(ctx, template)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update report template: %w", err)
+	}
+
+	return dto.ToReportTemplateResponse(updatedTemplate), nil
+}
+
+func (u *reportTemplateUseCase) DeleteReportTemplate(ctx context.Context, id int) error {
+	if _, err := u.templateRepo.FindById(ctx, id); err != nil {
+		return fmt.Errorf("report template not found: %w", err)
+	}
+
+	if err := u.templateRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete report template: %w", err)
+	}
+	return nil
+}
+
+func toTemplateSections(sections []request.TemplateSectionRequest) []entity.TemplateSection {
+	result := make([]entity.TemplateSection, len(sections))
+	for i, section := range sections {
+		result[i] = entity.TemplateSection{Heading: section.Heading, Hint: section.Hint, Required: section.Required}
+	}
+	return result
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+// Names of the built-in jobs
+const (
+	JobReminders     = "reminders"
+	JobWeeklyDigest  = "weekly-digest"
+	JobMonthlyDigest = "monthly-digest"
+)
+
+// JobScheduleOff in a job's schedule setting leaves the job to manual runs
+const JobScheduleOff = "off"
+
+// RegisterJobs registers the built-in jobs. getenv looks up schedule overrides: the cron
+// expression in JOB_SCHEDULE_<NAME>, e.g. JOB_SCHEDULE_WEEKLY_DIGEST="0 8 * * 1", replaces
+// the job's default schedule. Reminders run every hour at reminderCutoff's minute by default,
+// so that users whose shift ends after the cutoff are reminded once it has ended; shifts ending
+// after the day's last run are reminded by the first run of the next day.
+func RegisterJobs(scheduler JobScheduler, getenv func(string) string, reminderUseCase ReminderUseCase, reminderCutoff string, digestUseCase ReportDigestUseCase) error {
+	if reminderCutoff == "" {
+		reminderCutoff = DefaultReminderCutoff
+	}
+	cutoff, err := entity.ParseClock(reminderCutoff)
+	if err != nil {
+		return fmt.Errorf("invalid reminder cutoff %q: %w", reminderCutoff, err)
+	}
+
+	jobs := []Job{
+		{
+			Name:        JobReminders,
+			Description: "Reminds users whose cutoff or shift end has passed without attendance or a daily report for today or yesterday, once a day each, and sends admins a summary",
+			Schedule:    fmt.Sprintf("%d * * * *", cutoff%60),
+			Run: func(ctx context.Context) (string, error) {
+				result, err := reminderUseCase.SendReminders(ctx, &request.RemindersRequest{})
+				if err != nil {
+					return "", err
+				}
+				message := fmt.Sprintf("%d users missing, %d reminded", len(result.Entries), *result.Notified)
+				if previous := result.Previous; previous != nil {
+					message += fmt.Sprintf("; %s: %d users missing, %d reminded", previous.Date.Format(DateFormat), len(previous.Entries), *previous.Notified)
+				}
+				return message, nil
+			},
+		},
+		{
+			Name:        JobWeeklyDigest,
+			Description: "Posts last week's report digests to the notification channels",
+			Schedule:    "0 9 * * 1",
+			Run:         postDigests(digestUseCase, "WEEK"),
+		},
+		{
+			Name:        JobMonthlyDigest,
+			Description: "Posts last month's report digests to the notification channels",
+			Schedule:    "0 9 1 * *",
+			Run:         postDigests(digestUseCase, "MONTH"),
+		},
+	}
+
+	for _, job := range jobs {
+		switch override := strings.TrimSpace(getenv(jobScheduleEnv(job.Name))); override {
+		case "":
+		case JobScheduleOff:
+			job.Schedule = ""
+		default:
+			job.Schedule = override
+		}
+		if err := scheduler.Register(job); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jobScheduleEnv returns the name of the variable overriding a job's schedule
+func jobScheduleEnv(jobName string) string {
+	return "JOB_SCHEDULE_" + strings.ToUpper(strings.ReplaceAll(jobName, "-", "_"))
+}
+
+func postDigests(digestUseCase ReportDigestUseCase, period string) func(ctx context.Context) (string, error) {
+	return func(ctx context.Context) (string, error) {
+		result, err := digestUseCase.PostDigests(ctx, &request.PostReportDigestsRequest{Period: period})
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("posted %d digests for %s to %s", result.Posted,
+			result.StartDate.Format(DateFormat), result.EndDate.Format(DateFormat)), nil
+	}
+}
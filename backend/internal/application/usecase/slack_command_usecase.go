@@ -0,0 +1,275 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/domain"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+)
+
+// wallClockFormat is how the frontend sends times, as wall-clock time without a zone;
+// attendance from Slack is recorded the same way
+const wallClockFormat = "2006-01-02T15:04:05"
+
+// SlackCommandUseCase runs the /kintai slash command and links Slack users to users of the app
+type SlackCommandUseCase interface {
+	// HandleCommand runs a command from a request whose signature has been verified and returns
+	// the reply. Commands that cannot run, e.g. clocking out without clocking in, get a reply
+	// explaining why; errors are failures.
+	//
+	//	/kintai in                       clock in
+	//	/kintai out [break] [report]     clock out, recording the attendance
+	//	/kintai status                   show today's attendance
+	//	/kintai link                     issue a code to link the Slack user to an account
+	HandleCommand(ctx context.Context, req *request.SlackCommandRequest) (*dto.SlackCommandResponse, error)
+
+	GetMySlackAccount(ctx context.Context, userID int) (*dto.SlackAccountResponse, error)
+	// LinkSlackAccount links the user to the Slack user a code was issued to
+	LinkSlackAccount(ctx context.Context, userID int, req *request.LinkSlackAccountRequest) (*dto.SlackAccountResponse, error)
+	UnlinkSlackAccount(ctx context.Context, userID int) error
+
+	// SetUserSlackAccount links a user to a Slack member (ADMIN only)
+	// NOTE: Caller must verify ADMIN role before calling this method
+	SetUserSlackAccount(ctx context.Context, userID int, req *request.SetSlackAccountRequest) (*dto.SlackAccountResponse, error)
+}
+
+type slackCommandUseCase struct {
+	slackAccountRepo  repository.SlackAccountRepository
+	clockInRepo       repository.ClockInRepository
+	userRepo          repository.UserRepository
+	attendanceUseCase AttendanceUseCase
+}
+
+func NewSlackCommandUseCase(slackAccountRepo repository.SlackAccountRepository, clockInRepo repository.ClockInRepository, userRepo repository.UserRepository, attendanceUseCase AttendanceUseCase) SlackCommandUseCase {
+	return &slackCommandUseCase{
+		slackAccountRepo:  slackAccountRepo,
+		clockInRepo:       clockInRepo,
+		userRepo:          userRepo,
+		attendanceUseCase: attendanceUseCase,
+	}
+}
+
+func (u *slackCommandUseCase) HandleCommand(ctx context.Context, req *request.SlackCommandRequest) (*dto.SlackCommandResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	command := req.Command
+	if command == "" {
+		command = "/kintai"
+	}
+
+	args := strings.Fields(req.Text)
+	if len(args) == 0 {
+		return dto.NewEphemeralSlackResponse(slackUsage(command)), nil
+	}
+	subcommand := strings.ToLower(args[0])
+	if subcommand == "link" {
+		return u.issueLinkCode(ctx, req)
+	}
+
+	account, err := u.slackAccountRepo.FindBySlackUser(ctx, req.TeamId, req.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get slack account: %w", err)
+	}
+	if account == nil {
+		return dto.NewEphemeralSlackResponse(fmt.Sprintf("Slackアカウントがまだ連携されていません。`%s link` で連携コードを発行してください。", command)), nil
+	}
+
+	// The report is everything after the break, with its line breaks and spacing kept
+	rest := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(req.Text), args[0]))
+	switch subcommand {
+	case "in":
+		return u.clockIn(ctx, account.UserId, command)
+	case "out":
+		return u.clockOut(ctx, account.UserId, command, rest)
+	case "status":
+		return u.status(ctx, account.UserId)
+	default:
+		return dto.NewEphemeralSlackResponse(slackUsage(command)), nil
+	}
+}
+
+func (u *slackCommandUseCase) issueLinkCode(ctx context.Context, req *request.SlackCommandRequest) (*dto.SlackCommandResponse, error) {
+	code, err := entity.NewSlackLinkCode(req.TeamId, req.UserId, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue slack link code: %w", err)
+	}
+	if err := u.slackAccountRepo.CreateLinkCode(ctx, code); err != nil {
+		return nil, fmt.Errorf("failed to save slack link code: %w", err)
+	}
+	return dto.NewEphemeralSlackResponse(fmt.Sprintf(
+		"連携コード: *%s*（%d分間有効）\nアプリにログインし、プロフィールの「Slack連携」でこのコードを入力してください。",
+		code.Code, int(entity.SlackLinkCodeTTL.Minutes()),
+	)), nil
+}
+
+func (u *slackCommandUseCase) clockIn(ctx context.Context, userID int, command string) (*dto.SlackCommandResponse, error) {
+	open, err := u.clockInRepo.FindByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get clock-in: %w", err)
+	}
+	if open != nil {
+		return dto.NewEphemeralSlackResponse(fmt.Sprintf("%sから出勤中です。退勤は `%s out` で記録できます。", formatSlackTime(open.StartedAt), command)), nil
+	}
+
+	// Seconds are not recorded, like attendance entered in the app
+	startedAt := time.Now().Truncate(time.Minute)
+	if _, err := u.clockInRepo.Create(ctx, &entity.ClockIn{UserId: userID, StartedAt: startedAt}); err != nil {
+		return nil, fmt.Errorf("failed to save clock-in: %w", err)
+	}
+	return dto.NewEphemeralSlackResponse(fmt.Sprintf("%s に出勤しました。", formatSlackTime(startedAt))), nil
+}
+
+// clockOut records the attendance from the open clock-in until now. args is "[break] [report]".
+func (u *slackCommandUseCase) clockOut(ctx context.Context, userID int, command string, args string) (*dto.SlackCommandResponse, error) {
+	open, err := u.clockInRepo.FindByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get clock-in: %w", err)
+	}
+	if open == nil {
+		return dto.NewEphemeralSlackResponse(fmt.Sprintf("出勤が記録されていません。`%s in` で出勤してください。", command)), nil
+	}
+
+	breakMinutes, report := 0, args
+	if fields := strings.Fields(args); len(fields) > 0 {
+		if minutes, err := strconv.Atoi(fields[0]); err == nil {
+			breakMinutes = minutes
+			report = strings.TrimSpace(strings.TrimPrefix(args, fields[0]))
+		}
+	}
+
+	startedAt := open.StartedAt.Local()
+	endedAt := time.Now().Truncate(time.Minute)
+	if breakMinutes < 0 || time.Duration(breakMinutes)*time.Minute > endedAt.Sub(startedAt) {
+		return dto.NewEphemeralSlackResponse(fmt.Sprintf("休憩時間（%d分）が勤務時間を超えています。", breakMinutes)), nil
+	}
+
+	// Shifts past midnight belong to the day they started on
+	attendance, err := u.attendanceUseCase.CreateAttendance(ctx, &request.CreateAttendanceRequest{
+		Date:         startedAt.Format(DateFormat),
+		StartTime:    startedAt.Format(wallClockFormat),
+		EndTime:      endedAt.Format(wallClockFormat),
+		BreakMinutes: breakMinutes,
+		Report:       report,
+	}, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.clockInRepo.Delete(ctx, open.Id); err != nil {
+		return nil, fmt.Errorf("failed to delete clock-in: %w", err)
+	}
+
+	workMinutes := int(attendance.EndTime.Sub(attendance.StartTime).Minutes()) - attendance.BreakMinutes
+	return dto.NewEphemeralSlackResponse(fmt.Sprintf(
+		"退勤を記録しました（%s - %s、休憩%d分、実働%d時間%d分）",
+		attendance.StartTime.Format("15:04"), attendance.EndTime.Format("15:04"), attendance.BreakMinutes, workMinutes/60, workMinutes%60,
+	)), nil
+}
+
+func (u *slackCommandUseCase) status(ctx context.Context, userID int) (*dto.SlackCommandResponse, error) {
+	open, err := u.clockInRepo.FindByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get clock-in: %w", err)
+	}
+
+	today := time.Now().Format(DateFormat)
+	month := today[:7]
+	attendances, err := u.attendanceUseCase.GetMyAttendances(ctx, userID, &month)
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []string
+	if open != nil {
+		lines = append(lines, fmt.Sprintf("出勤中（%sから）", formatSlackTime(open.StartedAt)))
+	}
+	for _, attendance := range attendances.Attendances {
+		if attendance.Date.Format(DateFormat) != today {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("• %s - %s（休憩%d分）", attendance.StartTime.Format("15:04"), attendance.EndTime.Format("15:04"), attendance.BreakMinutes))
+	}
+	if len(lines) == 0 {
+		return dto.NewEphemeralSlackResponse("今日の勤怠はまだありません。"), nil
+	}
+	return dto.NewEphemeralSlackResponse(fmt.Sprintf("%s の勤怠\n%s", today, strings.Join(lines, "\n"))), nil
+}
+
+func (u *slackCommandUseCase) GetMySlackAccount(ctx context.Context, userID int) (*dto.SlackAccountResponse, error) {
+	account, err := u.slackAccountRepo.FindByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get slack account: %w", err)
+	}
+	return dto.ToSlackAccountResponse(account), nil
+}
+
+func (u *slackCommandUseCase) LinkSlackAccount(ctx context.Context, userID int, req *request.LinkSlackAccountRequest) (*dto.SlackAccountResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	code, err := u.slackAccountRepo.TakeLinkCode(ctx, strings.ToUpper(strings.TrimSpace(req.Code)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get slack link code: %w", err)
+	}
+	if code == nil || code.IsExpired(time.Now()) {
+		return nil, domain.ErrSlackLinkCodeInvalid
+	}
+
+	account, err := entity.NewSlackAccount(userID, code.TeamId, code.SlackUserId)
+	if err != nil {
+		return nil, err
+	}
+	linkedAccount, err := u.slackAccountRepo.Link(ctx, account)
+	if err != nil {
+		return nil, fmt.Errorf("failed to link slack account: %w", err)
+	}
+	return dto.ToSlackAccountResponse(linkedAccount), nil
+}
+
+func (u *slackCommandUseCase) UnlinkSlackAccount(ctx context.Context, userID int) error {
+	if err := u.slackAccountRepo.DeleteByUser(ctx, userID); err != nil {
+		return fmt.Errorf("failed to unlink slack account: %w", err)
+	}
+	return nil
+}
+
+func (u *slackCommandUseCase) SetUserSlackAccount(ctx context.Context, userID int, req *request.SetSlackAccountRequest) (*dto.SlackAccountResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	if _, err := u.userRepo.FindById(ctx, userID); err != nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	account, err := entity.NewSlackAccount(userID, strings.TrimSpace(req.TeamId), strings.TrimSpace(req.SlackUserId))
+	if err != nil {
+		return nil, err
+	}
+	linkedAccount, err := u.slackAccountRepo.Link(ctx, account)
+	if err != nil {
+		return nil, fmt.Errorf("failed to link slack account: %w", err)
+	}
+	return dto.ToSlackAccountResponse(linkedAccount), nil
+}
+
+func slackUsage(command string) string {
+	return strings.Join([]string{
+		"使い方:",
+		fmt.Sprintf("• `%s in` 出勤", command),
+		fmt.Sprintf("• `%s out [休憩(分)] [業務報告]` 退勤（例: `%s out 60 今日の作業`）", command, command),
+		fmt.Sprintf("• `%s status` 今日の勤怠", command),
+		fmt.Sprintf("• `%s link` アカウント連携", command),
+	}, "\n")
+}
+
+func formatSlackTime(t time.Time) string {
+	return t.Local().Format("15:04")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/attendance_report_app/backend/internal/application/dto"
 	"github.com/attendance_report_app/backend/internal/application/dto/request"
@@ -28,8 +29,11 @@ type UserUseCase interface {
 }
 
 type userUseCase struct {
-	userRepo     repository.UserRepository
-	tokenService TokenService // JWT token service interface
+	userRepo       repository.UserRepository
+	goalRepo       repository.GoalRepository
+	tokenService   TokenService // JWT token service interface
+	webhookService WebhookService
+	summaryService MonthlySummaryService
 }
 
 // TokenService interface for JWT operations
@@ -38,10 +42,13 @@ type TokenService interface {
 	InvalidateToken(token string) error
 }
 
-func NewUserUseCase(userRepo repository.UserRepository, tokenService TokenService) UserUseCase {
+func NewUserUseCase(userRepo repository.UserRepository, goalRepo repository.GoalRepository, tokenService TokenService, webhookService WebhookService, summaryService MonthlySummaryService) UserUseCase {
 	return &userUseCase{
-		userRepo:     userRepo,
-		tokenService: tokenService,
+		userRepo:       userRepo,
+		goalRepo:       goalRepo,
+		tokenService:   tokenService,
+		webhookService: webhookService,
+		summaryService: summaryService,
 	}
 }
 
@@ -105,12 +112,21 @@ func (u *userUseCase) CreateUser(ctx context.Context, req *request.CreateUserReq
 
 	// Create user entity
 	user := &entity.User{
-		Name:     req.Name,
-		Email:    req.Email,
-		Password: hashedPassword,
-		Role:     entity.UserRole(req.Role),
-		PayType:  entity.PayType(req.PayType),
-		PayRate:  req.PayRate,
+		Name:                  req.Name,
+		Email:                 req.Email,
+		Password:              hashedPassword,
+		Role:                  entity.UserRole(req.Role),
+		PayType:               entity.PayType(req.PayType),
+		PayRate:               req.PayRate,
+		Department:            req.Department,
+		FixedOvertimeHours:    req.FixedOvertimeHours,
+		ProrationPolicy:       entity.ProrationPolicyCalendarDays,
+		ScheduledBreakMinutes: 60,
+	}
+
+	if err := applyEmploymentSettings(user, req.HireDate, req.TerminationDate, req.ProrationPolicy,
+		req.ScheduledStartTime, req.ScheduledEndTime, req.ScheduledBreakMinutes); err != nil {
+		return nil, err
 	}
 
 	// Validate user entity
@@ -124,6 +140,15 @@ func (u *userUseCase) CreateUser(ctx context.Context, req *request.CreateUserReq
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
+	// Months already built do not include the new employee yet
+	if err := u.summaryService.MarkStale(ctx, createdUser.Id, time.Time{}, time.Time{}); err != nil {
+		return nil, err
+	}
+
+	if err := u.webhookService.Publish(ctx, entity.WebhookUserCreated, newWebhookUserData(createdUser)); err != nil {
+		return nil, err
+	}
+
 	return dto.ToUserResponse(createdUser), nil
 }
 
@@ -175,16 +200,58 @@ func (u *userUseCase) UpdateUser(ctx context.Context, userID int, req *request.U
 		user.PayRate = *req.PayRate
 	}
 
+	if req.FixedOvertimeHours != nil {
+		user.FixedOvertimeHours = *req.FixedOvertimeHours
+	}
+
+	if req.Department != nil {
+		user.Department = *req.Department
+	}
+
+	if req.PayType != nil || req.FixedOvertimeHours != nil {
+		if err := user.ValidatePayTerms(); err != nil {
+			return nil, err
+		}
+	}
+
 	if req.Goal != nil {
+		// The legacy goal is a monthly income goal; record it in the goal history as well
+		goal, err := entity.NewGoal(user.Id, entity.GoalTypeIncome, entity.GoalPeriodMonth, *req.Goal)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := replaceGoal(ctx, u.goalRepo, goal); err != nil {
+			return nil, err
+		}
 		user.Goal = *req.Goal
 	}
 
+	if err := applyEmploymentSettings(user, req.HireDate, req.TerminationDate, req.ProrationPolicy,
+		req.ScheduledStartTime, req.ScheduledEndTime, req.ScheduledBreakMinutes); err != nil {
+		return nil, err
+	}
+	if req.HasEmploymentChanges() {
+		if err := user.ValidateEmployment(); err != nil {
+			return nil, err
+		}
+	}
+
 	// Update in repository
 	updatedUser, err := u.userRepo.Update(ctx, user)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update user: %w", err)
 	}
 
+	if req.HasPayrollChanges() {
+		if err := u.summaryService.MarkStale(ctx, updatedUser.Id, time.Time{}, time.Time{}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := u.webhookService.Publish(ctx, entity.WebhookUserUpdated, newWebhookUserData(updatedUser)); err != nil {
+		return nil, err
+	}
+
 	return dto.ToUserResponse(updatedUser), nil
 }
 
@@ -192,7 +259,7 @@ func (u *userUseCase) UpdateUser(ctx context.Context, userID int, req *request.U
 // NOTE: Caller must verify ADMIN role before calling this method
 func (u *userUseCase) DeleteUser(ctx context.Context, userID int) error {
 	// Check if user exists
-	_, err := u.userRepo.FindById(ctx, userID)
+	user, err := u.userRepo.FindById(ctx, userID)
 	if err != nil {
 		return fmt.Errorf("user not found: %w", err)
 	}
@@ -202,7 +269,7 @@ func (u *userUseCase) DeleteUser(ctx context.Context, userID int) error {
 		return fmt.Errorf("failed to delete user: %w", err)
 	}
 
-	return nil
+	return u.webhookService.Publish(ctx, entity.WebhookUserDeleted, newWebhookUserData(user))
 }
 
 // ChangePassword changes a user's password
@@ -236,3 +303,55 @@ func (u *userUseCase) ChangePassword(ctx context.Context, userID int, req *reque
 	return nil
 }
 
+// applyEmploymentSettings copies the optional employment and schedule fields of a
+// create/update request onto user. An empty date or time string clears the value.
+func applyEmploymentSettings(user *entity.User, hireDate, terminationDate, prorationPolicy, scheduledStart, scheduledEnd *string, scheduledBreakMinutes *int) error {
+	if hireDate != nil {
+		date, err := parseOptionalDate(*hireDate)
+		if err != nil {
+			return err
+		}
+		user.HireDate = date
+	}
+
+	if terminationDate != nil {
+		date, err := parseOptionalDate(*terminationDate)
+		if err != nil {
+			return err
+		}
+		user.TerminationDate = date
+	}
+
+	if prorationPolicy != nil {
+		policy := entity.ProrationPolicy(*prorationPolicy)
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+		user.ProrationPolicy = policy
+	}
+
+	if scheduledStart != nil {
+		user.ScheduledStartTime = *scheduledStart
+	}
+
+	if scheduledEnd != nil {
+		user.ScheduledEndTime = *scheduledEnd
+	}
+
+	if scheduledBreakMinutes != nil {
+		user.ScheduledBreakMinutes = *scheduledBreakMinutes
+	}
+
+	return nil
+}
+
+func parseOptionalDate(dateStr string) (*time.Time, error) {
+	if dateStr == "" {
+		return nil, nil
+	}
+	date, err := ParseDate(dateStr)
+	if err != nil {
+		return nil, err
+	}
+	return &date, nil
+}
@@ -30,13 +30,26 @@ func CalculateWorkingHours(attendance *entity.Attendance) float64 {
 	return workingHours
 }
 
-// CalculateSalary calculates the salary based on pay type and working hours
-func CalculateSalary(payType entity.PayType, payRate int, workingHours float64) int {
-	if payType == entity.PayTypeHourly {
+// CalculateSalary calculates the salary based on pay type, working hours and days worked
+func CalculateSalary(payType entity.PayType, payRate int, workingHours float64, workedDays int) int {
+	switch payType {
+	case entity.PayTypeHourly:
 		return int(workingHours * float64(payRate))
+	case entity.PayTypeDaily:
+		return payRate * workedDays
+	default:
+		// Monthly pay types
+		return payRate
 	}
-	// Monthly pay type
-	return payRate
+}
+
+// CountWorkedDays returns the number of distinct dates with at least one attendance
+func CountWorkedDays(attendances []*entity.Attendance) int {
+	days := make(map[string]struct{}, len(attendances))
+	for _, attendance := range attendances {
+		days[attendance.Date.Format(DateFormat)] = struct{}{}
+	}
+	return len(days)
 }
 
 // ValidateRole validates if the role is valid
@@ -49,7 +62,7 @@ func ValidateRole(role entity.UserRole) error {
 
 // ValidatePayType validates if the pay type is valid
 func ValidatePayType(payType entity.PayType) error {
-	if payType != entity.PayTypeHourly && payType != entity.PayTypeSalary {
+	if err := payType.Validate(); err != nil {
 		return errors.New("invalid pay type")
 	}
 	return nil
@@ -0,0 +1,227 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/webhook"
+)
+
+// OutboxKindWebhook is the outbox message kind of an event for one webhook subscription
+const OutboxKindWebhook = "webhook"
+
+// WebhookService posts attendance and user events to the webhook subscriptions filtering on
+// them. Every attempt is logged with the receiver's response; failed deliveries are retried by
+// the outbox.
+type WebhookService interface {
+	// Publish queues the event for each enabled subscription in the transaction in ctx; the
+	// deliveries are sent once it commits
+	Publish(ctx context.Context, event entity.WebhookEvent, data interface{}) error
+	// SendTest posts a ping event to the subscription now and returns the logged delivery
+	SendTest(ctx context.Context, subscription *entity.WebhookSubscription) (*entity.WebhookDelivery, error)
+}
+
+// WebhookEnvelope is the JSON body of every delivery
+type WebhookEnvelope struct {
+	Id        string              `json:"id"`
+	Event     entity.WebhookEvent `json:"event"`
+	CreatedAt time.Time           `json:"created_at"`
+	Data      interface{}         `json:"data"`
+}
+
+// WebhookAttendanceData is the data of attendance events. Daily reports are left out, as
+// receivers cannot tell who may read them.
+type WebhookAttendanceData struct {
+	Id           int       `json:"id"`
+	UserId       int       `json:"user_id"`
+	Date         string    `json:"date"` // YYYY-MM-DD
+	StartTime    time.Time `json:"start_time"`
+	EndTime      time.Time `json:"end_time"`
+	BreakMinutes int       `json:"break_minutes"`
+}
+
+// WebhookUserData is the data of user events; pay settings are left out
+type WebhookUserData struct {
+	Id         int    `json:"id"`
+	Email      string `json:"email"`
+	Name       string `json:"name"`
+	Role       string `json:"role"`
+	Department string `json:"department"`
+}
+
+func newWebhookAttendanceData(attendance *entity.Attendance) *WebhookAttendanceData {
+	return &WebhookAttendanceData{
+		Id:           attendance.Id,
+		UserId:       attendance.UserId,
+		Date:         attendance.Date.Format("2006-01-02"),
+		StartTime:    attendance.StartTime,
+		EndTime:      attendance.EndTime,
+		BreakMinutes: attendance.BreakMinutes,
+	}
+}
+
+func newWebhookUserData(user *entity.User) *WebhookUserData {
+	return &WebhookUserData{
+		Id:         user.Id,
+		Email:      user.Email,
+		Name:       user.Name,
+		Role:       string(user.Role),
+		Department: user.Department,
+	}
+}
+
+// webhookPayload is the outbox payload of an event for one subscription. The body is signed
+// when it is sent, so that a rotated secret applies to retries.
+type webhookPayload struct {
+	SubscriptionId int             `json:"subscription_id"`
+	EventId        string          `json:"event_id"`
+	Event          string          `json:"event"`
+	Body           json.RawMessage `json:"body"`
+}
+
+type webhookService struct {
+	subscriptionRepo repository.WebhookSubscriptionRepository
+	deliveryRepo     repository.WebhookDeliveryRepository
+	outboxService    OutboxService
+	sender           *webhook.Sender
+}
+
+// NewWebhookService creates the service and registers its outbox handler
+func NewWebhookService(subscriptionRepo repository.WebhookSubscriptionRepository, deliveryRepo repository.WebhookDeliveryRepository, outboxService OutboxService, sender *webhook.Sender) WebhookService {
+	s := &webhookService{
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+		outboxService:    outboxService,
+		sender:           sender,
+	}
+	outboxService.RegisterHandler(OutboxKindWebhook, s.deliverQueued)
+	return s
+}
+
+func (s *webhookService) Publish(ctx context.Context, event entity.WebhookEvent, data interface{}) error {
+	subscriptions, err := s.subscriptionRepo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get webhook subscriptions: %w", err)
+	}
+
+	var envelope *WebhookEnvelope
+	var body []byte
+	for _, subscription := range subscriptions {
+		if !subscription.Enabled || !subscription.Subscribes(event) {
+			continue
+		}
+		// Subscribers of one event receive the same envelope
+		if envelope == nil {
+			if envelope, err = newWebhookEnvelope(event, data); err != nil {
+				return err
+			}
+			if body, err = json.Marshal(envelope); err != nil {
+				return fmt.Errorf("failed to encode webhook event: %w", err)
+			}
+		}
+
+		key := fmt.Sprintf("webhook:%s:%d", envelope.Id, subscription.Id)
+		err := s.outboxService.Enqueue(ctx, OutboxKindWebhook, key, &webhookPayload{
+			SubscriptionId: subscription.Id,
+			EventId:        envelope.Id,
+			Event:          string(event),
+			Body:           body,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *webhookService) SendTest(ctx context.Context, subscription *entity.WebhookSubscription) (*entity.WebhookDelivery, error) {
+	envelope, err := newWebhookEnvelope(entity.WebhookPing, map[string]interface{}{
+		"subscription_id": subscription.Id,
+		"name":            subscription.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(envelope)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode webhook event: %w", err)
+	}
+
+	// The outcome is reported in the delivery rather than as an error
+	delivery, _ := s.send(ctx, subscription, envelope.Id, entity.WebhookPing, body)
+	return s.logDelivery(ctx, delivery)
+}
+
+// deliverQueued is the outbox handler of webhook deliveries
+func (s *webhookService) deliverQueued(ctx context.Context, data []byte) error {
+	var payload webhookPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return &PermanentError{Err: fmt.Errorf("failed to decode webhook payload: %w", err)}
+	}
+
+	subscription, err := s.subscriptionRepo.FindById(ctx, payload.SubscriptionId)
+	if err != nil {
+		return &PermanentError{Err: fmt.Errorf("failed to get webhook subscription %d: %w", payload.SubscriptionId, err)}
+	}
+	if !subscription.Enabled {
+		return &PermanentError{Err: errors.New("webhook subscription is disabled")}
+	}
+
+	delivery, sendErr := s.send(ctx, subscription, payload.EventId, entity.WebhookEvent(payload.Event), payload.Body)
+	if _, err := s.logDelivery(ctx, delivery); err != nil {
+		return err
+	}
+	return sendErr
+}
+
+// send posts body to the subscription and returns the attempt, ready to be logged
+func (s *webhookService) send(ctx context.Context, subscription *entity.WebhookSubscription, eventId string, event entity.WebhookEvent, body []byte) (*entity.WebhookDelivery, error) {
+	response, err := s.sender.Send(ctx, &webhook.Request{
+		URL:     subscription.URL,
+		Secret:  subscription.Secret,
+		EventId: eventId,
+		Event:   string(event),
+		Body:    body,
+	})
+
+	delivery := &entity.WebhookDelivery{
+		SubscriptionId: subscription.Id,
+		EventId:        eventId,
+		Event:          event,
+		StatusCode:     response.StatusCode,
+		Response:       response.Body,
+		DurationMs:     int(response.Duration.Milliseconds()),
+	}
+	if err != nil {
+		delivery.Error = err.Error()
+	}
+	return delivery, err
+}
+
+func (s *webhookService) logDelivery(ctx context.Context, delivery *entity.WebhookDelivery) (*entity.WebhookDelivery, error) {
+	logged, err := s.deliveryRepo.Create(ctx, delivery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to log webhook delivery: %w", err)
+	}
+	return logged, nil
+}
+
+func newWebhookEnvelope(event entity.WebhookEvent, data interface{}) (*WebhookEnvelope, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("failed to generate webhook event ID: %w", err)
+	}
+	return &WebhookEnvelope{
+		Id:        hex.EncodeToString(b),
+		Event:     event,
+		CreatedAt: time.Now(),
+		Data:      data,
+	}, nil
+}
@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/domain"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+)
+
+// WebhookUseCase lets admins subscribe internal tools to attendance and user events
+type WebhookUseCase interface {
+	// GetSubscriptions lists the subscriptions without their secrets (ADMIN only)
+	// NOTE: Caller must verify ADMIN role before calling this method
+	GetSubscriptions(ctx context.Context) (*dto.WebhookSubscriptionsResponse, error)
+
+	// CreateSubscription adds a subscription and returns it with its secret (ADMIN only)
+	// NOTE: Caller must verify ADMIN role before calling this method
+	CreateSubscription(ctx context.Context, req *request.CreateWebhookSubscriptionRequest) (*dto.WebhookSubscriptionResponse, error)
+
+	// UpdateSubscription changes a subscription; the secret is returned if it was changed (ADMIN only)
+	// NOTE: Caller must verify ADMIN role before calling this method
+	UpdateSubscription(ctx context.Context, id int, req *request.UpdateWebhookSubscriptionRequest) (*dto.WebhookSubscriptionResponse, error)
+
+	// DeleteSubscription deletes a subscription and its delivery log (ADMIN only)
+	// NOTE: Caller must verify ADMIN role before calling this method
+	DeleteSubscription(ctx context.Context, id int) error
+
+	// GetDeliveries lists the subscription's delivery attempts, newest first (ADMIN only)
+	// NOTE: Caller must verify ADMIN role before calling this method
+	GetDeliveries(ctx context.Context, id int, req *request.GetWebhookDeliveriesRequest) (*dto.PaginationResponse, error)
+
+	// TestSubscription sends a ping event to the subscription now, even if it is disabled,
+	// and returns the logged delivery (ADMIN only)
+	// NOTE: Caller must verify ADMIN role before calling this method
+	TestSubscription(ctx context.Context, id int) (*dto.WebhookDeliveryResponse, error)
+}
+
+type webhookUseCase struct {
+	subscriptionRepo repository.WebhookSubscriptionRepository
+	deliveryRepo     repository.WebhookDeliveryRepository
+	webhookService   WebhookService
+}
+
+func NewWebhookUseCase(subscriptionRepo repository.WebhookSubscriptionRepository, deliveryRepo repository.WebhookDeliveryRepository, webhookService WebhookService) WebhookUseCase {
+	return &webhookUseCase{
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+		webhookService:   webhookService,
+	}
+}
+
+func (u *webhookUseCase) GetSubscriptions(ctx context.Context) (*dto.WebhookSubscriptionsResponse, error) {
+	subscriptions, err := u.subscriptionRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook subscriptions: %w", err)
+	}
+	return dto.ToWebhookSubscriptionsResponse(subscriptions), nil
+}
+
+func (u *webhookUseCase) CreateSubscription(ctx context.Context, req *request.CreateWebhookSubscriptionRequest) (*dto.WebhookSubscriptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	subscription, err := entity.NewWebhookSubscription(strings.TrimSpace(req.Name), strings.TrimSpace(req.URL), req.Secret, toWebhookEvents(req.Events))
+	if err != nil {
+		return nil, err
+	}
+	if req.Enabled != nil {
+		subscription.Enabled = *req.Enabled
+	}
+
+	createdSubscription, err := u.subscriptionRepo.Create(ctx, subscription)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook subscription: %w", err)
+	}
+	return dto.ToWebhookSubscriptionResponse(createdSubscription, true), nil
+}
+
+func (u *webhookUseCase) UpdateSubscription(ctx context.Context, id int, req *request.UpdateWebhookSubscriptionRequest) (*dto.WebhookSubscriptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	subscription, err := u.subscriptionRepo.FindById(ctx, id)
+	if err != nil {
+		return nil, domain.ErrWebhookSubscriptionNotFound
+	}
+
+	if req.Name != nil {
+		subscription.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.URL != nil {
+		subscription.URL = strings.TrimSpace(*req.URL)
+	}
+	if req.Events != nil {
+		subscription.Events = toWebhookEvents(req.Events)
+	}
+	if req.Enabled != nil {
+		subscription.Enabled = *req.Enabled
+	}
+	secretChanged := req.Secret != nil || req.RotateSecret
+	if req.Secret != nil {
+		subscription.Secret = *req.Secret
+	}
+	if req.RotateSecret {
+		if subscription.Secret, err = entity.NewWebhookSecret(); err != nil {
+			return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+		}
+	}
+
+	if err := subscription.Validate(); err != nil {
+		return nil, err
+	}
+
+	updatedSubscription, err := u.subscriptionRepo.Update(ctx, subscription)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update webhook subscription: %w", err)
+	}
+	return dto.ToWebhookSubscriptionResponse(updatedSubscription, secretChanged), nil
+}
+
+func (u *webhookUseCase) DeleteSubscription(ctx context.Context, id int) error {
+	if _, err := u.subscriptionRepo.FindById(ctx, id); err != nil {
+		return domain.ErrWebhookSubscriptionNotFound
+	}
+
+	if err := u.subscriptionRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete webhook subscription: %w", err)
+	}
+	return nil
+}
+
+func (u *webhookUseCase) GetDeliveries(ctx context.Context, id int, req *request.GetWebhookDeliveriesRequest) (*dto.PaginationResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	if _, err := u.subscriptionRepo.FindById(ctx, id); err != nil {
+		return nil, domain.ErrWebhookSubscriptionNotFound
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	deliveries, total, err := u.deliveryRepo.FindBySubscription(ctx, id, offset, req.PerPage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook deliveries: %w", err)
+	}
+	return dto.ToPaginationResponse(dto.ToWebhookDeliveryResponses(deliveries), total, req.Page, req.PerPage), nil
+}
+
+func (u *webhookUseCase) TestSubscription(ctx context.Context, id int) (*dto.WebhookDeliveryResponse, error) {
+	subscription, err := u.subscriptionRepo.FindById(ctx, id)
+	if err != nil {
+		return nil, domain.ErrWebhookSubscriptionNotFound
+	}
+
+	delivery, err := u.webhookService.SendTest(ctx, subscription)
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToWebhookDeliveryResponse(delivery), nil
+}
+
+func toWebhookEvents(events []string) []entity.WebhookEvent {
+	webhookEvents := make([]entity.WebhookEvent, len(events))
+	for i, event := range events {
+		webhookEvents[i] = entity.WebhookEvent(event)
+	}
+	return webhookEvents
+}
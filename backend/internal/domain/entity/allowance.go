@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// Allowance is an allowance definition (手当) such as commuting, housing or role allowance
+type Allowance struct {
+	Id            int
+	Name          string
+	Taxable       bool
+	DefaultAmount int
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func NewAllowance(name string, taxable bool, defaultAmount int) (*Allowance, error) {
+	allowance := &Allowance{
+		Name:          name,
+		Taxable:       taxable,
+		DefaultAmount: defaultAmount,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	if err := allowance.Validate(); err != nil {
+		return nil, err
+	}
+	return allowance, nil
+}
+
+func (a *Allowance) Validate() error {
+	if a.Name == "" {
+		return errors.New("name cannot be empty")
+	}
+	if a.DefaultAmount < 0 {
+		return errors.New("default amount cannot be negative")
+	}
+	return nil
+}
+
+// UserAllowance assigns a monthly allowance amount to a user for an effective period
+type UserAllowance struct {
+	Id            int
+	UserId        int
+	AllowanceId   int
+	Allowance     *Allowance
+	Amount        int
+	EffectiveFrom time.Time
+	EffectiveTo   *time.Time // nil means open-ended
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (a *UserAllowance) Validate() error {
+	if a.UserId <= 0 {
+		return errors.New("invalid user ID")
+	}
+	if a.AllowanceId <= 0 {
+		return errors.New("invalid allowance ID")
+	}
+	if a.Amount < 0 {
+		return errors.New("amount cannot be negative")
+	}
+	if a.EffectiveFrom.IsZero() {
+		return errors.New("effective from date cannot be empty")
+	}
+	if a.EffectiveTo != nil && a.EffectiveTo.Before(a.EffectiveFrom) {
+		return errors.New("effective to date cannot be before effective from date")
+	}
+	return nil
+}
+
+// IsEffectiveDuring reports whether the assignment overlaps [start, end]
+func (a *UserAllowance) IsEffectiveDuring(start, end time.Time) bool {
+	if truncateToDay(a.EffectiveFrom).After(truncateToDay(end)) {
+		return false
+	}
+	if a.EffectiveTo != nil && truncateToDay(*a.EffectiveTo).Before(truncateToDay(start)) {
+		return false
+	}
+	return true
+}
@@ -12,12 +12,11 @@ type Attendance struct {
 	StartTime    time.Time
 	EndTime      time.Time
 	BreakMinutes int
-	Report       string
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 }
 
-func NewAttendance(userId int, date, startTime, endTime time.Time, breakMinutes int, report string) (*Attendance, error) {
+func NewAttendance(userId int, date, startTime, endTime time.Time, breakMinutes int) (*Attendance, error) {
 	if userId <= 0 {
 		return nil, errors.New("invalid user ID")
 	}
@@ -34,7 +33,6 @@ func NewAttendance(userId int, date, startTime, endTime time.Time, breakMinutes
 		StartTime:    startTime,
 		EndTime:      endTime,
 		BreakMinutes: breakMinutes,
-		Report:       report,
 		CreatedAt:    time.Now(),
 		UpdatedAt:    time.Now(),
 	}, nil
@@ -49,8 +47,13 @@ func (a *Attendance) Validate() error {
 	if a.BreakMinutes < 0 {
 		return errors.New("break minutes cannot be negative")
 	}
-	if a.Report == "" {
-		return errors.New("report cannot be empty")
-	}
 	return nil
 }
+
+// AttendanceSummary is the aggregated attendance of one user over a period
+type AttendanceSummary struct {
+	UserId      int
+	PeriodStart time.Time // start of the group, e.g. the Monday of a week; zero when not grouped by period
+	TotalHours  float64
+	WorkedDays  int // distinct dates with attendance
+}
@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// Bonus is a one-off bonus payment (賞与) included in the payroll of the month it is paid
+type Bonus struct {
+	Id          int
+	UserId      int
+	Name        string
+	Amount      int
+	PaymentDate time.Time
+	Taxable     bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func NewBonus(userId int, name string, amount int, paymentDate time.Time, taxable bool) (*Bonus, error) {
+	if userId <= 0 {
+		return nil, errors.New("invalid user ID")
+	}
+	if name == "" {
+		return nil, errors.New("name cannot be empty")
+	}
+	if amount <= 0 {
+		return nil, errors.New("amount must be greater than zero")
+	}
+	if paymentDate.IsZero() {
+		return nil, errors.New("payment date cannot be empty")
+	}
+
+	return &Bonus{
+		UserId:      userId,
+		Name:        name,
+		Amount:      amount,
+		PaymentDate: truncateToDay(paymentDate),
+		Taxable:     taxable,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}, nil
+}
@@ -0,0 +1,11 @@
+package entity
+
+import "time"
+
+// ClockIn is a shift that was started but not finished yet. Attendance records need both ends
+// of the shift, so the start is kept here until the user clocks out.
+type ClockIn struct {
+	Id        int
+	UserId    int
+	StartedAt time.Time
+}
@@ -0,0 +1,221 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxReportLength limits the Markdown content of a report in characters
+const MaxReportLength = 20000
+
+type ReportStatus string
+
+const (
+	ReportStatusDraft     ReportStatus = "DRAFT"
+	ReportStatusPublished ReportStatus = "PUBLISHED"
+)
+
+func (s ReportStatus) Validate() error {
+	switch s {
+	case ReportStatusDraft, ReportStatusPublished:
+		return nil
+	default:
+		return errors.New("invalid report status")
+	}
+}
+
+// ReportVisibility decides who besides the author can read a published report
+type ReportVisibility string
+
+const (
+	// ReportVisibilityCompany makes the report readable by everyone (the default)
+	ReportVisibilityCompany ReportVisibility = "COMPANY"
+	// ReportVisibilityDepartment limits the report to the author's department and admins
+	ReportVisibilityDepartment ReportVisibility = "DEPARTMENT"
+	// ReportVisibilityManagers limits the report to admins
+	ReportVisibilityManagers ReportVisibility = "MANAGERS"
+	// ReportVisibilityPrivate keeps the report to its author, e.g. for personal notes
+	ReportVisibilityPrivate ReportVisibility = "PRIVATE"
+)
+
+func (v ReportVisibility) Validate() error {
+	switch v {
+	case ReportVisibilityCompany, ReportVisibilityDepartment, ReportVisibilityManagers, ReportVisibilityPrivate:
+		return nil
+	default:
+		return errors.New("invalid report visibility")
+	}
+}
+
+// ReportSection is one filled-in section of a template, e.g. やったこと
+type ReportSection struct {
+	Heading string
+	Body    string // Markdown
+}
+
+// DailyReport is a user's report for one day (日報). Only published reports are visible to others.
+type DailyReport struct {
+	Id     int
+	UserId int
+	// Attendance of the same day, if one has been recorded
+	AttendanceId *int
+	Date         time.Time
+	TemplateId   *int
+	Sections     []ReportSection
+	Body         string // free-form Markdown after the sections
+	Status       ReportStatus
+	Visibility   ReportVisibility
+	// Version starts at 1 and increases with every edit; earlier versions are kept as revisions
+	Version     int
+	PublishedAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+
+	// Author details, filled in when reports are listed
+	UserName   string
+	Department string
+
+	// Feedback counts, filled in when reports are listed
+	CommentCount  int
+	ReactionCount int
+	// Read tells whether the listing user has read the report; nil when not looked up
+	Read *bool
+}
+
+func NewDailyReport(userId int, date time.Time, templateId *int, sections []ReportSection, body string, status ReportStatus) (*DailyReport, error) {
+	report := &DailyReport{
+		UserId:     userId,
+		Date:       truncateToDay(date),
+		TemplateId: templateId,
+		Sections:   sections,
+		Body:       body,
+		Status:     ReportStatusDraft,
+		Visibility: ReportVisibilityCompany,
+		Version:    1,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+	if err := status.Validate(); err != nil {
+		return nil, err
+	}
+	if status == ReportStatusPublished {
+		report.Publish(time.Now())
+	}
+	if err := report.Validate(); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (r *DailyReport) Validate() error {
+	if r.UserId <= 0 {
+		return errors.New("invalid user ID")
+	}
+	if r.Date.IsZero() {
+		return errors.New("date cannot be empty")
+	}
+	if err := r.Status.Validate(); err != nil {
+		return err
+	}
+	if err := r.Visibility.Validate(); err != nil {
+		return err
+	}
+	for _, section := range r.Sections {
+		if strings.TrimSpace(section.Heading) == "" {
+			return errors.New("section heading cannot be empty")
+		}
+	}
+	content := r.Content()
+	if r.IsPublished() && strings.TrimSpace(content) == "" {
+		return errors.New("report cannot be empty")
+	}
+	if utf8.RuneCountInString(content) > MaxReportLength {
+		return errors.New("report is too long")
+	}
+	return nil
+}
+
+func (r *DailyReport) IsPublished() bool {
+	return r.Status == ReportStatusPublished
+}
+
+// VisibleTo reports whether viewer may read the report. Authors can always read their own
+// reports; anyone else only published ones within the report's visibility. The report's
+// Department must be filled in for department visibility to work.
+func (r *DailyReport) VisibleTo(viewer *User) bool {
+	if viewer.Id == r.UserId {
+		return true
+	}
+	if !r.IsPublished() {
+		return false
+	}
+	switch r.Visibility {
+	case ReportVisibilityCompany:
+		return true
+	case ReportVisibilityDepartment:
+		return viewer.IsAdmin() || (r.Department != "" && viewer.Department == r.Department)
+	case ReportVisibilityManagers:
+		return viewer.IsAdmin()
+	default:
+		return false
+	}
+}
+
+// IsShared reports whether the report may be quoted in company-wide places such as the shared Slack channel
+func (r *DailyReport) IsShared() bool {
+	return r.IsPublished() && r.Visibility == ReportVisibilityCompany
+}
+
+// Publish makes the report visible to others. Publishing is one-way.
+func (r *DailyReport) Publish(now time.Time) {
+	if r.IsPublished() {
+		return
+	}
+	r.Status = ReportStatusPublished
+	r.PublishedAt = &now
+}
+
+// Content renders the whole report as Markdown: each section under a level-2 heading, then the body
+func (r *DailyReport) Content() string {
+	var b strings.Builder
+	for _, section := range r.Sections {
+		if strings.TrimSpace(section.Body) == "" {
+			continue
+		}
+		b.WriteString("## ")
+		b.WriteString(section.Heading)
+		b.WriteString("\n\n")
+		b.WriteString(strings.TrimSpace(section.Body))
+		b.WriteString("\n\n")
+	}
+	b.WriteString(strings.TrimSpace(r.Body))
+	return strings.TrimSpace(b.String())
+}
+
+// Revision captures the report as it is now, before it gets edited by editorId
+func (r *DailyReport) Revision(editorId int) *DailyReportRevision {
+	return &DailyReportRevision{
+		ReportId:  r.Id,
+		Version:   r.Version,
+		Sections:  r.Sections,
+		Body:      r.Body,
+		Status:    r.Status,
+		EditedBy:  editorId,
+		CreatedAt: time.Now(),
+	}
+}
+
+// DailyReportRevision is an earlier version of a report (edit history)
+type DailyReportRevision struct {
+	Id       int
+	ReportId int
+	Version  int
+	Sections []ReportSection
+	Body     string
+	Status   ReportStatus
+	// User whose edit replaced this version
+	EditedBy  int
+	CreatedAt time.Time
+}
@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+type GoalType string
+
+const (
+	GoalTypeHours  GoalType = "HOURS"
+	GoalTypeIncome GoalType = "INCOME" // yen
+)
+
+func (t GoalType) Validate() error {
+	switch t {
+	case GoalTypeHours, GoalTypeIncome:
+		return nil
+	default:
+		return errors.New("invalid goal type")
+	}
+}
+
+type GoalPeriod string
+
+const (
+	GoalPeriodMonth GoalPeriod = "MONTH"
+	GoalPeriodYear  GoalPeriod = "YEAR"
+)
+
+func (p GoalPeriod) Validate() error {
+	switch p {
+	case GoalPeriodMonth, GoalPeriodYear:
+		return nil
+	default:
+		return errors.New("invalid goal period")
+	}
+}
+
+// Goal is a user's target for a type and period, e.g. 200,000 yen per month.
+// Goals are never edited in place: setting a new target closes the active goal
+// of the same type and period (ActiveTo) and creates a new one, keeping history.
+type Goal struct {
+	Id         int
+	UserId     int
+	Type       GoalType
+	Period     GoalPeriod
+	Target     int
+	ActiveFrom time.Time
+	ActiveTo   *time.Time // nil while the goal is active
+	CreatedAt  time.Time
+}
+
+func NewGoal(userId int, goalType GoalType, period GoalPeriod, target int) (*Goal, error) {
+	if userId <= 0 {
+		return nil, errors.New("invalid user ID")
+	}
+	if err := goalType.Validate(); err != nil {
+		return nil, err
+	}
+	if err := period.Validate(); err != nil {
+		return nil, err
+	}
+	if target < 0 {
+		return nil, errors.New("target cannot be negative")
+	}
+
+	now := time.Now()
+	return &Goal{
+		UserId:     userId,
+		Type:       goalType,
+		Period:     period,
+		Target:     target,
+		ActiveFrom: now,
+		CreatedAt:  now,
+	}, nil
+}
+
+func (g *Goal) IsActive() bool {
+	return g.ActiveTo == nil
+}
+
+// Supersede closes the goal when a new target replaces it
+func (g *Goal) Supersede(at time.Time) {
+	g.ActiveTo = &at
+}
+
+// PeriodRange returns the first and last day of the goal period containing date
+func (g *Goal) PeriodRange(date time.Time) (time.Time, time.Time) {
+	if g.Period == GoalPeriodYear {
+		start := time.Date(date.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(1, 0, -1)
+	}
+	start := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 1, -1)
+}
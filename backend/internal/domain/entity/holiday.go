@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// Holiday is a company-wide non-working day (祝日・会社休日)
+type Holiday struct {
+	Id        int
+	Date      time.Time
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewHoliday(date time.Time, name string) (*Holiday, error) {
+	if date.IsZero() {
+		return nil, errors.New("date cannot be empty")
+	}
+	if name == "" {
+		return nil, errors.New("name cannot be empty")
+	}
+
+	return &Holiday{
+		Date:      truncateToDay(date),
+		Name:      name,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+// WorkCalendar decides which days are scheduled working days.
+// Weekends and registered holidays are non-working days.
+type WorkCalendar struct {
+	holidays map[string]struct{}
+}
+
+func NewWorkCalendar(holidays []*Holiday) *WorkCalendar {
+	calendar := &WorkCalendar{holidays: make(map[string]struct{}, len(holidays))}
+	for _, holiday := range holidays {
+		calendar.holidays[holiday.Date.Format("2006-01-02")] = struct{}{}
+	}
+	return calendar
+}
+
+// IsWorkingDay reports whether date is a scheduled working day
+func (c *WorkCalendar) IsWorkingDay(date time.Time) bool {
+	switch date.Weekday() {
+	case time.Saturday, time.Sunday:
+		return false
+	}
+	_, isHoliday := c.holidays[date.Format("2006-01-02")]
+	return !isHoliday
+}
+
+// WorkingDays returns the number of working days between start and end (inclusive)
+func (c *WorkCalendar) WorkingDays(start, end time.Time) int {
+	count := 0
+	for day := truncateToDay(start); !day.After(truncateToDay(end)); day = day.AddDate(0, 0, 1) {
+		if c.IsWorkingDay(day) {
+			count++
+		}
+	}
+	return count
+}
+
+// CalendarDays returns the number of days between start and end (inclusive)
+func CalendarDays(start, end time.Time) int {
+	count := 0
+	for day := truncateToDay(start); !day.After(truncateToDay(end)); day = day.AddDate(0, 0, 1) {
+		count++
+	}
+	return count
+}
+
+func truncateToDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
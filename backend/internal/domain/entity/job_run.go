@@ -0,0 +1,76 @@
+package entity
+
+import "time"
+
+// JobTrigger is what started a job run
+type JobTrigger string
+
+const (
+	JobTriggerSchedule JobTrigger = "SCHEDULE"
+	JobTriggerManual   JobTrigger = "MANUAL"
+)
+
+type JobRunStatus string
+
+const (
+	JobRunStatusRunning   JobRunStatus = "RUNNING"
+	JobRunStatusSucceeded JobRunStatus = "SUCCEEDED"
+	JobRunStatusFailed    JobRunStatus = "FAILED"
+	// JobRunStatusSkipped means the scheduled run did not start because the previous one was still running
+	JobRunStatusSkipped JobRunStatus = "SKIPPED"
+)
+
+// JobRun is one execution of a scheduled job
+type JobRun struct {
+	Id      int
+	JobName string
+	Trigger JobTrigger
+	// ScheduledAt is the minute the schedule fired; nil for manual runs. Each job runs at most
+	// once per scheduled minute, however many instances are up.
+	ScheduledAt *time.Time
+	TriggeredBy *int   // admin who started a manual run
+	Instance    string // process that ran the job
+	Status      JobRunStatus
+	Output      string // short summary of what the job did
+	Error       string
+	StartedAt   time.Time
+	FinishedAt  *time.Time
+}
+
+func NewJobRun(jobName string, trigger JobTrigger, scheduledAt *time.Time, triggeredBy *int, instance string) *JobRun {
+	return &JobRun{
+		JobName:     jobName,
+		Trigger:     trigger,
+		ScheduledAt: scheduledAt,
+		TriggeredBy: triggeredBy,
+		Instance:    instance,
+		Status:      JobRunStatusRunning,
+		StartedAt:   time.Now(),
+	}
+}
+
+// Finish records the outcome of the run
+func (r *JobRun) Finish(output string, err error, now time.Time) {
+	r.Output = output
+	r.Status = JobRunStatusSucceeded
+	if err != nil {
+		r.Status = JobRunStatusFailed
+		r.Error = err.Error()
+	}
+	r.FinishedAt = &now
+}
+
+// Skip records that the run did not start
+func (r *JobRun) Skip(reason string, now time.Time) {
+	r.Status = JobRunStatusSkipped
+	r.Error = reason
+	r.FinishedAt = &now
+}
+
+// Duration is how long the run took, or has been running so far
+func (r *JobRun) Duration(now time.Time) time.Duration {
+	if r.FinishedAt != nil {
+		return r.FinishedAt.Sub(r.StartedAt)
+	}
+	return now.Sub(r.StartedAt)
+}
@@ -0,0 +1,45 @@
+package entity
+
+import "time"
+
+// MonthlySummary is a materialized per-user aggregate of one month's attendance and pay.
+// It is refreshed whenever the user's attendance in that month changes, marked stale when
+// pay terms, allowances, bonuses or holidays affecting the month change, and can be rebuilt
+// for any range with cmd/recalculate.
+//
+// The hour buckets overlap: TotalHours = RegularHours + OvertimeHours, while HolidayHours
+// (worked on non-working days) and LateNightHours (22:00-05:00) are subsets of TotalHours.
+type MonthlySummary struct {
+	Id             int
+	UserId         int
+	Month          string // YYYY-MM
+	TotalHours     float64
+	RegularHours   float64
+	OvertimeHours  float64 // hours beyond the daily scheduled or statutory hours
+	HolidayHours   float64
+	LateNightHours float64
+	WorkedDays     int
+	EstimatedPay   int
+	CalculatedAt   time.Time
+	Stale          bool // an input changed since CalculatedAt
+}
+
+// IsCurrentAsOf reports whether the summary was calculated after the month ended,
+// i.e. it no longer depends on days that had not yet happened when it was computed,
+// and has not been marked stale since
+func (s *MonthlySummary) IsCurrentAsOf(monthStart time.Time) bool {
+	return !s.Stale && !s.CalculatedAt.Before(monthStart.AddDate(0, 1, 0))
+}
+
+// MonthlySummaryBuild records that the summaries of every employee were built for a month,
+// so that a month without employees is not rebuilt on every read
+type MonthlySummaryBuild struct {
+	Month        string // YYYY-MM
+	CalculatedAt time.Time
+	Stale        bool // an input of any of the month's summaries changed since CalculatedAt
+}
+
+// IsCurrentAsOf reports whether the build was made after the month ended and has not been marked stale since
+func (b *MonthlySummaryBuild) IsCurrentAsOf(monthStart time.Time) bool {
+	return !b.Stale && !b.CalculatedAt.Before(monthStart.AddDate(0, 1, 0))
+}
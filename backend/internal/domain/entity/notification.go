@@ -0,0 +1,161 @@
+package entity
+
+import (
+	"errors"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"time"
+)
+
+// NotificationEvent is a type of event users and admins are notified about
+type NotificationEvent string
+
+const (
+	NotificationAttendanceCreated NotificationEvent = "ATTENDANCE_CREATED"
+	NotificationAttendanceUpdated NotificationEvent = "ATTENDANCE_UPDATED"
+	NotificationReportCommented   NotificationEvent = "REPORT_COMMENTED"
+	NotificationReportMentioned   NotificationEvent = "REPORT_MENTIONED"
+	NotificationReportDigest      NotificationEvent = "REPORT_DIGEST"
+	NotificationReminder          NotificationEvent = "REMINDER"
+	NotificationMissingSummary    NotificationEvent = "MISSING_SUMMARY"
+	// NotificationTest is sent by admins to check a channel
+	NotificationTest NotificationEvent = "TEST"
+)
+
+// NotificationEvents lists the events that can be routed to channels
+var NotificationEvents = []NotificationEvent{
+	NotificationAttendanceCreated,
+	NotificationAttendanceUpdated,
+	NotificationReportCommented,
+	NotificationReportMentioned,
+	NotificationReportDigest,
+	NotificationReminder,
+	NotificationMissingSummary,
+}
+
+func (e NotificationEvent) Validate() error {
+	for _, event := range NotificationEvents {
+		if e == event {
+			return nil
+		}
+	}
+	return errors.New("invalid notification event")
+}
+
+// Notification is a rendered message, independent of the channel it is sent through
+type Notification struct {
+	Event NotificationEvent
+	// Title is the headline; channels without rich formatting send it as the subject or first line
+	Title  string
+	Color  string // "#RRGGBB"
+	Fields []NotificationField
+	// SlackMentions are the Slack member IDs of the users the notification is about; Slack
+	// messages @-mention them
+	SlackMentions []string
+}
+
+type NotificationField struct {
+	Title string
+	Value string
+	Short bool // may be laid out next to other short fields
+}
+
+// NotificationChannelType selects the adapter that delivers to a channel
+type NotificationChannelType string
+
+const (
+	// NotificationChannelSlack posts to a Slack incoming webhook, or to a Slack channel with the bot token
+	NotificationChannelSlack NotificationChannelType = "SLACK"
+	// NotificationChannelTeams posts to a Microsoft Teams incoming webhook
+	NotificationChannelTeams NotificationChannelType = "TEAMS"
+	// NotificationChannelDiscord posts to a Discord webhook
+	NotificationChannelDiscord NotificationChannelType = "DISCORD"
+	// NotificationChannelEmail mails the addresses in the target through the SMTP server
+	NotificationChannelEmail NotificationChannelType = "EMAIL"
+)
+
+func (t NotificationChannelType) Validate() error {
+	switch t {
+	case NotificationChannelSlack, NotificationChannelTeams, NotificationChannelDiscord, NotificationChannelEmail:
+		return nil
+	default:
+		return errors.New("invalid notification channel type")
+	}
+}
+
+// NotificationChannel is a destination admins route events to
+type NotificationChannel struct {
+	Id   int
+	Name string
+	Type NotificationChannelType
+	// Target is the webhook URL, a Slack channel ID for channels posted to with the bot token,
+	// or a comma-separated list of email addresses
+	Target string
+	// Disabled channels keep their routes but receive nothing
+	Enabled   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewNotificationChannel(name string, channelType NotificationChannelType, target string) (*NotificationChannel, error) {
+	channel := &NotificationChannel{
+		Name:      name,
+		Type:      channelType,
+		Target:    target,
+		Enabled:   true,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if err := channel.Validate(); err != nil {
+		return nil, err
+	}
+	return channel, nil
+}
+
+func (c *NotificationChannel) Validate() error {
+	if c.Name == "" {
+		return errors.New("name cannot be empty")
+	}
+	if err := c.Type.Validate(); err != nil {
+		return err
+	}
+	if c.Type == NotificationChannelEmail {
+		if _, err := mail.ParseAddressList(c.Target); err != nil {
+			return errors.New("target must be a comma-separated list of email addresses")
+		}
+		return nil
+	}
+	if c.Type == NotificationChannelSlack && IsSlackConversationId(c.Target) {
+		return nil
+	}
+	target, err := url.Parse(c.Target)
+	if err != nil || (target.Scheme != "https" && target.Scheme != "http") || target.Host == "" {
+		return errors.New("target must be a webhook URL")
+	}
+	return nil
+}
+
+// NotificationRoute sends an event to a channel
+type NotificationRoute struct {
+	Id        int
+	Event     NotificationEvent
+	ChannelId int
+	// Department limits the route to notifications about users of the department; routes
+	// without one apply to every notification of the event
+	Department string
+}
+
+// Applies reports whether the route sends notifications about users of the department
+func (r *NotificationRoute) Applies(department string) bool {
+	return r.Department == "" || r.Department == department
+}
+
+// slackConversationId matches the IDs of Slack channels (C…, G…), DMs (D…) and users (U…, W…),
+// which the bot can post to
+var slackConversationId = regexp.MustCompile(`^[CGDUW][A-Z0-9]{6,}$`)
+
+// IsSlackConversationId reports whether target is a Slack channel or member ID rather than a webhook URL
+func IsSlackConversationId(target string) bool {
+	return slackConversationId.MatchString(target)
+}
@@ -0,0 +1,97 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// PreferredChannel is where a user wants the notifications about them to go
+type PreferredChannel string
+
+const (
+	// PreferredChannelDefault sends to the channels admins routed the event to
+	PreferredChannelDefault PreferredChannel = "DEFAULT"
+	// PreferredChannelEmail sends to the user's own email address instead
+	PreferredChannelEmail PreferredChannel = "EMAIL"
+	// PreferredChannelSlackDM sends a Slack direct message from the bot to the user's linked account
+	PreferredChannelSlackDM PreferredChannel = "SLACK_DM"
+)
+
+func (c PreferredChannel) Validate() error {
+	switch c {
+	case PreferredChannelDefault, PreferredChannelEmail, PreferredChannelSlackDM:
+		return nil
+	default:
+		return errors.New("invalid preferred channel")
+	}
+}
+
+// NotificationPreference is a user's setting for one notification event
+type NotificationPreference struct {
+	Id      int
+	UserId  int
+	Event   NotificationEvent
+	Channel PreferredChannel
+	// OptOut stops the notification altogether
+	OptOut bool
+	// QuietStart and QuietEnd ("HH:MM") hold back notifications until the end of the quiet
+	// hours; the period may span midnight. Both are empty when there are no quiet hours.
+	QuietStart string
+	QuietEnd   string
+	UpdatedAt  time.Time
+}
+
+// DefaultNotificationPreference is the setting of users who have not changed anything
+func DefaultNotificationPreference(userId int, event NotificationEvent) *NotificationPreference {
+	return &NotificationPreference{
+		UserId:  userId,
+		Event:   event,
+		Channel: PreferredChannelDefault,
+	}
+}
+
+func (p *NotificationPreference) Validate() error {
+	if err := p.Event.Validate(); err != nil {
+		return err
+	}
+	if err := p.Channel.Validate(); err != nil {
+		return err
+	}
+	if p.QuietStart == "" && p.QuietEnd == "" {
+		return nil
+	}
+	start, err := ParseClock(p.QuietStart)
+	if err != nil {
+		return errors.New("invalid quiet hours start")
+	}
+	end, err := ParseClock(p.QuietEnd)
+	if err != nil {
+		return errors.New("invalid quiet hours end")
+	}
+	if start == end {
+		return errors.New("quiet hours must not start and end at the same time")
+	}
+	return nil
+}
+
+// QuietUntil returns the end of the quiet hours if now falls within them
+func (p *NotificationPreference) QuietUntil(now time.Time) (time.Time, bool) {
+	start, err1 := ParseClock(p.QuietStart)
+	end, err2 := ParseClock(p.QuietEnd)
+	if err1 != nil || err2 != nil || start == end {
+		return time.Time{}, false
+	}
+
+	minute := now.Hour()*60 + now.Minute()
+	day := now.Day()
+	switch {
+	case start < end && minute >= start && minute < end:
+	case start > end && minute >= start:
+		// Quiet overnight; it ends tomorrow
+		day++
+	case start > end && minute < end:
+	default:
+		return time.Time{}, false
+	}
+	return time.Date(now.Year(), now.Month(), day, end/60, end%60, 0, 0, now.Location()), true
+}
@@ -0,0 +1,104 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+type OutboxStatus string
+
+const (
+	// OutboxStatusPending messages wait for their next attempt
+	OutboxStatusPending OutboxStatus = "PENDING"
+	OutboxStatusSent    OutboxStatus = "SENT"
+	// OutboxStatusDead messages gave up after MaxOutboxAttempts and wait for an admin to resend them
+	OutboxStatusDead OutboxStatus = "DEAD"
+)
+
+func (s OutboxStatus) Validate() error {
+	switch s {
+	case OutboxStatusPending, OutboxStatusSent, OutboxStatusDead:
+		return nil
+	default:
+		return errors.New("invalid outbox status")
+	}
+}
+
+// Retry policy of outbox deliveries: the wait doubles after every failed attempt
+const (
+	MaxOutboxAttempts   = 8
+	OutboxRetryBaseWait = 30 * time.Second
+	OutboxRetryMaxWait  = time.Hour
+)
+
+// OutboxMessage is a notification stored in the same transaction as the change it reports,
+// and delivered by the outbox dispatcher once that transaction has committed
+type OutboxMessage struct {
+	Id int
+	// IdempotencyKey identifies the event, e.g. attendance-created:42; a second message
+	// with the same key is dropped
+	IdempotencyKey string
+	// Kind selects the handler that delivers the message, e.g. slack.attendance
+	Kind          string
+	Payload       string // JSON
+	Status        OutboxStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	CreatedAt     time.Time
+	SentAt        *time.Time
+}
+
+func NewOutboxMessage(kind, idempotencyKey, payload string) (*OutboxMessage, error) {
+	if kind == "" {
+		return nil, errors.New("outbox message kind cannot be empty")
+	}
+	if idempotencyKey == "" {
+		return nil, errors.New("outbox message idempotency key cannot be empty")
+	}
+	now := time.Now()
+	return &OutboxMessage{
+		IdempotencyKey: idempotencyKey,
+		Kind:           kind,
+		Payload:        payload,
+		Status:         OutboxStatusPending,
+		NextAttemptAt:  now,
+		CreatedAt:      now,
+	}, nil
+}
+
+// MarkSent records a successful delivery
+func (m *OutboxMessage) MarkSent(now time.Time) {
+	m.Attempts++
+	m.Status = OutboxStatusSent
+	m.LastError = ""
+	m.SentAt = &now
+}
+
+// MarkFailed records a failed delivery and schedules the next attempt, or dead-letters the
+// message once it has used up its attempts. Permanent failures are dead-lettered at once.
+func (m *OutboxMessage) MarkFailed(err error, permanent bool, now time.Time) {
+	m.Attempts++
+	m.LastError = err.Error()
+	if permanent || m.Attempts >= MaxOutboxAttempts {
+		m.Status = OutboxStatusDead
+		return
+	}
+
+	wait := OutboxRetryBaseWait << (m.Attempts - 1)
+	if wait > OutboxRetryMaxWait || wait <= 0 {
+		wait = OutboxRetryMaxWait
+	}
+	m.NextAttemptAt = now.Add(wait)
+}
+
+// Resend puts a dead-lettered message back in the queue with a fresh set of attempts
+func (m *OutboxMessage) Resend(now time.Time) error {
+	if m.Status != OutboxStatusDead {
+		return errors.New("only dead-lettered messages can be resent")
+	}
+	m.Status = OutboxStatusPending
+	m.Attempts = 0
+	m.NextAttemptAt = now
+	return nil
+}
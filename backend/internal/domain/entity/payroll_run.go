@@ -0,0 +1,229 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+type PayrollRunStatus string
+
+const (
+	PayrollRunStatusDraft     PayrollRunStatus = "DRAFT"
+	PayrollRunStatusFinalized PayrollRunStatus = "FINALIZED"
+	PayrollRunStatusReopened  PayrollRunStatus = "REOPENED"
+)
+
+func (s PayrollRunStatus) Validate() error {
+	switch s {
+	case PayrollRunStatusDraft, PayrollRunStatusFinalized, PayrollRunStatusReopened:
+		return nil
+	default:
+		return errors.New("invalid payroll run status")
+	}
+}
+
+type PayrollRunAction string
+
+const (
+	PayrollRunActionCreate   PayrollRunAction = "CREATE"
+	PayrollRunActionFinalize PayrollRunAction = "FINALIZE"
+	PayrollRunActionReopen   PayrollRunAction = "REOPEN"
+)
+
+// PayrollRun is a payroll calculation for one month.
+// A DRAFT run is recomputed on every read; a FINALIZED run is backed by
+// snapshotted PayrollLines and closes the month for attendance edits.
+// Reopening a run moves it to REOPENED, which keeps its snapshot for
+// history and allows a new draft to be created for the same month.
+type PayrollRun struct {
+	Id           int
+	Month        string // YYYY-MM
+	Status       PayrollRunStatus
+	TotalPayroll int
+	CreatedBy    int
+	FinalizedBy  *int
+	FinalizedAt  *time.Time
+	ReopenedBy   *int
+	ReopenedAt   *time.Time
+	ReopenReason string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type PayrollItemKind string
+
+const (
+	PayrollItemKindEarning   PayrollItemKind = "EARNING"
+	PayrollItemKindDeduction PayrollItemKind = "DEDUCTION"
+)
+
+// Payroll item codes
+const (
+	PayrollItemCodeBasePay            = "BASE_PAY"
+	PayrollItemCodeHourlyPay          = "HOURLY_PAY"
+	PayrollItemCodeDailyPay           = "DAILY_PAY"
+	PayrollItemCodeOvertimeExcessPay  = "FIXED_OVERTIME_EXCESS_PAY"
+	PayrollItemCodeAbsenceDeduction   = "ABSENCE_DEDUCTION"
+	PayrollItemCodeLateEarlyDeduction = "LATE_EARLY_DEDUCTION"
+	PayrollItemCodeAllowance          = "ALLOWANCE"
+	PayrollItemCodeBonus              = "BONUS"
+)
+
+// PayrollLine is one employee's payroll figures for a month.
+// Within a finalized run it is a persisted snapshot.
+type PayrollLine struct {
+	Id               int
+	RunId            int
+	UserId           int
+	UserName         string
+	PayType          PayType
+	PayRate          int
+	TotalHours       float64
+	OvertimeHours    float64 // hours beyond the daily scheduled or statutory hours
+	WorkedDays       int     // distinct dates with attendance
+	WorkingDays      int     // scheduled working days in the month
+	AbsenceDays      int
+	LateEarlyMinutes int
+	TotalSalary      int
+	Items            []*PayrollItem
+	CreatedAt        time.Time
+}
+
+// PayrollItem is a single earning or deduction on a payroll line
+type PayrollItem struct {
+	Id      int
+	LineId  int
+	Code    string
+	Name    string
+	Kind    PayrollItemKind
+	Amount  int // always non-negative; the kind decides the sign
+	Taxable bool
+}
+
+// AddItem appends a taxable earning or a deduction to the line
+func (l *PayrollLine) AddItem(code, name string, kind PayrollItemKind, amount int) {
+	l.addItem(code, name, kind, amount, true)
+}
+
+// AddNonTaxableItem appends a non-taxable earning (e.g. commuting allowance) to the line
+func (l *PayrollLine) AddNonTaxableItem(code, name string, amount int) {
+	l.addItem(code, name, PayrollItemKindEarning, amount, false)
+}
+
+func (l *PayrollLine) addItem(code, name string, kind PayrollItemKind, amount int, taxable bool) {
+	l.Items = append(l.Items, &PayrollItem{
+		Code:    code,
+		Name:    name,
+		Kind:    kind,
+		Amount:  amount,
+		Taxable: taxable,
+	})
+}
+
+// Total returns earnings minus deductions, never below zero
+func (l *PayrollLine) Total() int {
+	total := 0
+	for _, item := range l.Items {
+		if item.Kind == PayrollItemKindDeduction {
+			total -= item.Amount
+		} else {
+			total += item.Amount
+		}
+	}
+	if total < 0 {
+		return 0
+	}
+	return total
+}
+
+// Earnings returns the taxable and non-taxable earning totals
+func (l *PayrollLine) Earnings() (taxable int, nonTaxable int) {
+	for _, item := range l.Items {
+		if item.Kind != PayrollItemKindEarning {
+			continue
+		}
+		if item.Taxable {
+			taxable += item.Amount
+		} else {
+			nonTaxable += item.Amount
+		}
+	}
+	return taxable, nonTaxable
+}
+
+// Deductions returns the sum of all deduction items
+func (l *PayrollLine) Deductions() int {
+	total := 0
+	for _, item := range l.Items {
+		if item.Kind == PayrollItemKindDeduction {
+			total += item.Amount
+		}
+	}
+	return total
+}
+
+// PayrollRunAudit records a state change of a payroll run
+type PayrollRunAudit struct {
+	Id        int
+	RunId     int
+	Action    PayrollRunAction
+	ActorId   int
+	Reason    string
+	CreatedAt time.Time
+}
+
+func NewPayrollRun(month string, createdBy int) (*PayrollRun, error) {
+	if _, err := time.Parse("2006-01", month); err != nil {
+		return nil, errors.New("invalid month format")
+	}
+	if createdBy <= 0 {
+		return nil, errors.New("invalid user ID")
+	}
+
+	return &PayrollRun{
+		Month:     month,
+		Status:    PayrollRunStatusDraft,
+		CreatedBy: createdBy,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+func (r *PayrollRun) IsDraft() bool {
+	return r.Status == PayrollRunStatusDraft
+}
+
+func (r *PayrollRun) IsFinalized() bool {
+	return r.Status == PayrollRunStatusFinalized
+}
+
+// Finalize marks the run as finalized by the given admin
+func (r *PayrollRun) Finalize(adminId int, totalPayroll int) error {
+	if !r.IsDraft() {
+		return errors.New("only draft payroll runs can be finalized")
+	}
+
+	now := time.Now()
+	r.Status = PayrollRunStatusFinalized
+	r.TotalPayroll = totalPayroll
+	r.FinalizedBy = &adminId
+	r.FinalizedAt = &now
+	return nil
+}
+
+// Reopen marks a finalized run as reopened; a reason is mandatory
+func (r *PayrollRun) Reopen(adminId int, reason string) error {
+	if !r.IsFinalized() {
+		return errors.New("only finalized payroll runs can be reopened")
+	}
+	if reason == "" {
+		return errors.New("reopen reason cannot be empty")
+	}
+
+	now := time.Now()
+	r.Status = PayrollRunStatusReopened
+	r.ReopenedBy = &adminId
+	r.ReopenedAt = &now
+	r.ReopenReason = reason
+	return nil
+}
@@ -0,0 +1,68 @@
+package entity
+
+import "time"
+
+// MissingEntry is a user who has not logged attendance or a published daily report for a working day
+type MissingEntry struct {
+	User              *User
+	Date              time.Time
+	MissingAttendance bool
+	MissingReport     bool
+}
+
+// SentReminder records that a user was reminded about a day, so that each user is reminded
+// at most once per day however often the reminders run
+type SentReminder struct {
+	Id        int
+	UserId    int
+	Date      time.Time
+	CreatedAt time.Time
+}
+
+// FindMissingEntries returns the users who are behind on date as of asOf, in minutes since
+// the start of date; past 24*60 it is the following day. Nobody is expected to log anything on
+// non-working days of the calendar or outside their employment. Users with a fixed schedule
+// are expected to log attendance and a report every working day once their shift has ended,
+// which is on the following day for shifts crossing midnight; users without one only need a
+// report on the days they recorded attendance. Drafts do not count as reports.
+func FindMissingEntries(users []*User, calendar *WorkCalendar, date time.Time, asOf int, attendances []*Attendance, reports []*DailyReport) []*MissingEntry {
+	if !calendar.IsWorkingDay(date) {
+		return nil
+	}
+
+	worked := make(map[int]bool)
+	for _, attendance := range attendances {
+		worked[attendance.UserId] = true
+	}
+	reported := make(map[int]bool)
+	for _, report := range reports {
+		if report.IsPublished() {
+			reported[report.UserId] = true
+		}
+	}
+
+	var entries []*MissingEntry
+	for _, user := range users {
+		if !user.IsEmployedOn(date) {
+			continue
+		}
+		scheduled := user.HasSchedule()
+		if scheduled {
+			if start, err := ParseClock(user.ScheduledStartTime); err == nil && start+user.ScheduledShiftMinutes() > asOf {
+				// Still on shift; a later run will catch the user
+				continue
+			}
+		}
+
+		entry := &MissingEntry{
+			User:              user,
+			Date:              truncateToDay(date),
+			MissingAttendance: scheduled && !worked[user.Id],
+			MissingReport:     (scheduled || worked[user.Id]) && !reported[user.Id],
+		}
+		if entry.MissingAttendance || entry.MissingReport {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
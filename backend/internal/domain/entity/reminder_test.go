@@ -0,0 +1,51 @@
+package entity_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+func TestFindMissingEntries(t *testing.T) {
+	monday := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	dayShift := &entity.User{Id: 1, Name: "日勤", ScheduledStartTime: "09:00", ScheduledEndTime: "18:00"}
+	lateShift := &entity.User{Id: 2, Name: "遅番", ScheduledStartTime: "14:30", ScheduledEndTime: "23:30"}
+	nightShift := &entity.User{Id: 3, Name: "夜勤", ScheduledStartTime: "22:00", ScheduledEndTime: "06:00"}
+	unscheduled := &entity.User{Id: 4, Name: "シフトなし"}
+	reported := &entity.User{Id: 5, Name: "報告済み", ScheduledStartTime: "09:00", ScheduledEndTime: "18:00"}
+	users := []*entity.User{dayShift, lateShift, nightShift, unscheduled, reported}
+
+	attendances := []*entity.Attendance{{UserId: unscheduled.Id, Date: monday}, {UserId: reported.Id, Date: monday}}
+	reports := []*entity.DailyReport{
+		{UserId: dayShift.Id, Status: entity.ReportStatusDraft},
+		{UserId: reported.Id, Status: entity.ReportStatusPublished},
+	}
+
+	tests := []struct {
+		name string
+		date time.Time
+		asOf int
+		want []int
+	}{
+		{"at the cutoff", monday, 18 * 60, []int{1, 4}},
+		{"before the late shift ends", monday, 23 * 60, []int{1, 4}},
+		{"after the late shift ends", monday, 23*60 + 30, []int{1, 2, 4}},
+		{"after midnight", monday, 24*60 + 1, []int{1, 2, 4}},
+		{"after the night shift ends", monday, 30 * 60, []int{1, 2, 3, 4}},
+		{"on a weekend", monday.AddDate(0, 0, 5), 30 * 60, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			for _, entry := range entity.FindMissingEntries(users, entity.NewWorkCalendar(nil), tt.date, tt.asOf, attendances, reports) {
+				got = append(got, entry.User.Id)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("FindMissingEntries() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
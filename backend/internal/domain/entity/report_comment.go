@@ -0,0 +1,105 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxCommentLength limits a comment in characters
+const MaxCommentLength = 2000
+
+// ReportComment is feedback on a daily report. Replies point at a top-level comment through ParentId,
+// so threads are one level deep.
+type ReportComment struct {
+	Id        int
+	ReportId  int
+	UserId    int
+	ParentId  *int
+	Body      string // Markdown
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// Author name, filled in when comments are listed
+	UserName string
+}
+
+func NewReportComment(reportId, userId int, parentId *int, body string) (*ReportComment, error) {
+	comment := &ReportComment{
+		ReportId:  reportId,
+		UserId:    userId,
+		ParentId:  parentId,
+		Body:      strings.TrimSpace(body),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if err := comment.Validate(); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+func (c *ReportComment) Validate() error {
+	if c.ReportId <= 0 {
+		return errors.New("invalid report ID")
+	}
+	if c.UserId <= 0 {
+		return errors.New("invalid user ID")
+	}
+	if c.Body == "" {
+		return errors.New("comment cannot be empty")
+	}
+	if utf8.RuneCountInString(c.Body) > MaxCommentLength {
+		return errors.New("comment is too long")
+	}
+	return nil
+}
+
+func (c *ReportComment) IsReply() bool {
+	return c.ParentId != nil
+}
+
+// MaxEmojiLength limits the emoji of a reaction in bytes; shortcodes such as :+1: and
+// unicode emoji with modifiers both fit
+const MaxEmojiLength = 64
+
+// ReportReaction is one user's emoji reaction on a daily report. A user can add each emoji once.
+type ReportReaction struct {
+	Id        int
+	ReportId  int
+	UserId    int
+	Emoji     string
+	CreatedAt time.Time
+
+	UserName string
+}
+
+func NewReportReaction(reportId, userId int, emoji string) (*ReportReaction, error) {
+	reaction := &ReportReaction{
+		ReportId:  reportId,
+		UserId:    userId,
+		Emoji:     strings.TrimSpace(emoji),
+		CreatedAt: time.Now(),
+	}
+	if err := reaction.Validate(); err != nil {
+		return nil, err
+	}
+	return reaction, nil
+}
+
+func (r *ReportReaction) Validate() error {
+	if r.ReportId <= 0 {
+		return errors.New("invalid report ID")
+	}
+	if r.UserId <= 0 {
+		return errors.New("invalid user ID")
+	}
+	if r.Emoji == "" {
+		return errors.New("emoji cannot be empty")
+	}
+	if len(r.Emoji) > MaxEmojiLength || strings.ContainsAny(r.Emoji, " \t\r\n") {
+		return errors.New("invalid emoji")
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package entity
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// DigestPeriod is the span of days compiled into one report digest
+type DigestPeriod string
+
+const (
+	// DigestPeriodWeek runs from Monday to Sunday
+	DigestPeriodWeek  DigestPeriod = "WEEK"
+	DigestPeriodMonth DigestPeriod = "MONTH"
+)
+
+// DigestTopTags is the number of tags listed in a digest
+const DigestTopTags = 10
+
+func (p DigestPeriod) Validate() error {
+	switch p {
+	case DigestPeriodWeek, DigestPeriodMonth:
+		return nil
+	default:
+		return errors.New("invalid digest period")
+	}
+}
+
+// Range returns the first and last day of the period containing date
+func (p DigestPeriod) Range(date time.Time) (time.Time, time.Time) {
+	day := truncateToDay(date)
+	if p == DigestPeriodMonth {
+		start := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location())
+		return start, start.AddDate(0, 1, -1)
+	}
+	// time.Weekday starts on Sunday; weeks here start on Monday
+	offset := (int(day.Weekday()) + 6) % 7
+	start := day.AddDate(0, 0, -offset)
+	return start, start.AddDate(0, 0, 6)
+}
+
+// Previous returns a date in the period before the one containing date,
+// e.g. to compile last week's digest
+func (p DigestPeriod) Previous(date time.Time) time.Time {
+	start, _ := p.Range(date)
+	return start.AddDate(0, 0, -1)
+}
+
+// ReportDigest compiles one user's daily reports and attendance over a week or month
+type ReportDigest struct {
+	UserId     int
+	UserName   string
+	Department string
+	Period     DigestPeriod
+	StartDate  time.Time
+	EndDate    time.Time
+	// Days lists every day of the period that has attendance or a report, in date order
+	Days    []*DigestDay
+	TopTags []*TagCount
+}
+
+// DigestDay is one day of a digest
+type DigestDay struct {
+	Date        time.Time
+	WorkMinutes int // attendance of the day, excluding breaks
+	Report      *DailyReport
+}
+
+// NewReportDigest compiles the digest from the user's attendances and reports within the period
+func NewReportDigest(user *User, period DigestPeriod, date time.Time, attendances []*Attendance, reports []*DailyReport) *ReportDigest {
+	start, end := period.Range(date)
+	digest := &ReportDigest{
+		UserId:     user.Id,
+		UserName:   user.Name,
+		Department: user.Department,
+		Period:     period,
+		StartDate:  start,
+		EndDate:    end,
+	}
+
+	days := make(map[string]*DigestDay)
+	dayOf := func(date time.Time) *DigestDay {
+		key := date.Format("2006-01-02")
+		if days[key] == nil {
+			days[key] = &DigestDay{Date: truncateToDay(date)}
+			digest.Days = append(digest.Days, days[key])
+		}
+		return days[key]
+	}
+	for _, attendance := range attendances {
+		day := dayOf(attendance.Date)
+		if minutes := int(attendance.EndTime.Sub(attendance.StartTime).Minutes()) - attendance.BreakMinutes; minutes > 0 {
+			day.WorkMinutes += minutes
+		}
+	}
+
+	tagCounts := make(map[string]int)
+	for _, report := range reports {
+		dayOf(report.Date).Report = report
+		for _, tag := range report.Tags() {
+			tagCounts[tag]++
+		}
+	}
+
+	sort.Slice(digest.Days, func(i, j int) bool {
+		return digest.Days[i].Date.Before(digest.Days[j].Date)
+	})
+
+	for tag, count := range tagCounts {
+		digest.TopTags = append(digest.TopTags, &TagCount{Tag: tag, Count: count})
+	}
+	sort.Slice(digest.TopTags, func(i, j int) bool {
+		if digest.TopTags[i].Count != digest.TopTags[j].Count {
+			return digest.TopTags[i].Count > digest.TopTags[j].Count
+		}
+		return digest.TopTags[i].Tag < digest.TopTags[j].Tag
+	})
+	if len(digest.TopTags) > DigestTopTags {
+		digest.TopTags = digest.TopTags[:DigestTopTags]
+	}
+
+	return digest
+}
+
+// TotalWorkMinutes is the attendance of the whole period
+func (d *ReportDigest) TotalWorkMinutes() int {
+	total := 0
+	for _, day := range d.Days {
+		total += day.WorkMinutes
+	}
+	return total
+}
+
+// WorkedDays counts the days with attendance
+func (d *ReportDigest) WorkedDays() int {
+	count := 0
+	for _, day := range d.Days {
+		if day.WorkMinutes > 0 {
+			count++
+		}
+	}
+	return count
+}
+
+// ReportCount counts the days with a report
+func (d *ReportDigest) ReportCount() int {
+	count := 0
+	for _, day := range d.Days {
+		if day.Report != nil {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+// ReportRead records that a user has read a published daily report. Authors never get
+// a record for their own reports; those always count as read.
+type ReportRead struct {
+	Id       int
+	ReportId int
+	UserId   int
+	ReadAt   time.Time
+
+	// Reader details, filled in when readers are listed
+	UserName   string
+	Department string
+}
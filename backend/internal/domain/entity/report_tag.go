@@ -0,0 +1,97 @@
+package entity
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxTagLength limits hashtags and mention handles in characters; longer ones are ignored
+const MaxTagLength = 50
+
+// TagCount is how many published reports use a hashtag
+type TagCount struct {
+	Tag   string
+	Count int
+}
+
+// ParseReportText extracts the #hashtags and @mentions from Markdown report text, in order of
+// first appearance and without duplicates. Tags are lowercased. Full-width ＃ and ＠ are accepted,
+// fenced code blocks are skipped, and markers glued to a preceding word (mail@example.com,
+// C#) or Markdown headings ("## Heading") are not tags.
+func ParseReportText(text string) (tags []string, mentions []string) {
+	seenTags := make(map[string]bool)
+	seenMentions := make(map[string]bool)
+
+	inFence := false
+	for _, line := range strings.Split(text, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		runes := []rune(line)
+		for i := 0; i < len(runes); i++ {
+			isTag := runes[i] == '#' || runes[i] == '＃'
+			isMention := runes[i] == '@' || runes[i] == '＠'
+			if !isTag && !isMention {
+				continue
+			}
+			if i > 0 && (isWordRune(runes[i-1]) || runes[i-1] == '#' || runes[i-1] == '@') {
+				continue
+			}
+
+			end := i + 1
+			for end < len(runes) && (isWordRune(runes[end]) || runes[end] == '-' || (isMention && runes[end] == '.')) {
+				end++
+			}
+			word := strings.TrimRight(string(runes[i+1:end]), "-.")
+			i = end - 1
+			if word == "" || utf8.RuneCountInString(word) > MaxTagLength {
+				continue
+			}
+
+			if isTag {
+				// #1 and the like are numbering, not tags
+				if strings.IndexFunc(word, func(r rune) bool { return !unicode.IsDigit(r) }) < 0 {
+					continue
+				}
+				tag := strings.ToLower(word)
+				if !seenTags[tag] {
+					seenTags[tag] = true
+					tags = append(tags, tag)
+				}
+			} else if !seenMentions[strings.ToLower(word)] {
+				seenMentions[strings.ToLower(word)] = true
+				mentions = append(mentions, word)
+			}
+		}
+	}
+	return tags, mentions
+}
+
+// NormalizeTag turns user input such as "#ProjectX" into the stored form of a tag
+func NormalizeTag(tag string) string {
+	tag = strings.TrimSpace(tag)
+	tag = strings.TrimPrefix(strings.TrimPrefix(tag, "#"), "＃")
+	return strings.ToLower(tag)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r) || r == '_'
+}
+
+// Tags returns the hashtags used in the report
+func (r *DailyReport) Tags() []string {
+	tags, _ := ParseReportText(r.Content())
+	return tags
+}
+
+// Mentions returns the @mention handles used in the report
+func (r *DailyReport) Mentions() []string {
+	_, mentions := ParseReportText(r.Content())
+	return mentions
+}
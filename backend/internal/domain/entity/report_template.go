@@ -0,0 +1,90 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TemplateSection is a section that reports written from a template must contain
+type TemplateSection struct {
+	Heading  string
+	Hint     string // placeholder text shown to the writer
+	Required bool   // must be filled in before publishing
+}
+
+// ReportTemplate is an admin-defined structure for daily reports,
+// e.g. やったこと / わかったこと / 次やること
+type ReportTemplate struct {
+	Id          int
+	Name        string
+	Description string
+	Sections    []TemplateSection
+	// Inactive templates are kept for existing reports but cannot be used for new ones
+	Active    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewReportTemplate(name, description string, sections []TemplateSection) (*ReportTemplate, error) {
+	template := &ReportTemplate{
+		Name:        name,
+		Description: description,
+		Sections:    sections,
+		Active:      true,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	if err := template.Validate(); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+func (t *ReportTemplate) Validate() error {
+	if t.Name == "" {
+		return errors.New("name cannot be empty")
+	}
+	if len(t.Sections) == 0 {
+		return errors.New("template must have at least one section")
+	}
+	headings := make(map[string]struct{}, len(t.Sections))
+	for _, section := range t.Sections {
+		heading := strings.TrimSpace(section.Heading)
+		if heading == "" {
+			return errors.New("section heading cannot be empty")
+		}
+		if _, ok := headings[heading]; ok {
+			return fmt.Errorf("duplicate section heading: %s", heading)
+		}
+		headings[heading] = struct{}{}
+	}
+	return nil
+}
+
+// CheckSections verifies that sections follow the template: the same headings in the same order,
+// with the required ones filled in if the report is to be published
+func (t *ReportTemplate) CheckSections(sections []ReportSection, publishing bool) error {
+	if len(sections) != len(t.Sections) {
+		return fmt.Errorf("template %s has %d sections", t.Name, len(t.Sections))
+	}
+	for i, section := range t.Sections {
+		if sections[i].Heading != section.Heading {
+			return fmt.Errorf("section %d must be %s", i+1, section.Heading)
+		}
+		if publishing && section.Required && strings.TrimSpace(sections[i].Body) == "" {
+			return fmt.Errorf("section %s is required", section.Heading)
+		}
+	}
+	return nil
+}
+
+// EmptySections returns the template's sections with empty bodies, to start a new report from
+func (t *ReportTemplate) EmptySections() []ReportSection {
+	sections := make([]ReportSection, len(t.Sections))
+	for i, section := range t.Sections {
+		sections[i] = ReportSection{Heading: section.Heading}
+	}
+	return sections
+}
@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"time"
+)
+
+// SlackLinkCodeTTL is how long a link code issued in Slack can be redeemed
+const SlackLinkCodeTTL = 10 * time.Minute
+
+// slackLinkCodeAlphabet leaves out characters that are easily mistaken for each other
+const slackLinkCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// SlackAccount links a Slack user to a user of the app, so that slash commands act as that user
+type SlackAccount struct {
+	Id          int
+	UserId      int
+	TeamId      string // Slack workspace
+	SlackUserId string // Slack member ID, e.g. U012ABCDEF
+	CreatedAt   time.Time
+}
+
+func NewSlackAccount(userId int, teamId, slackUserId string) (*SlackAccount, error) {
+	account := &SlackAccount{
+		UserId:      userId,
+		TeamId:      teamId,
+		SlackUserId: slackUserId,
+		CreatedAt:   time.Now(),
+	}
+	if err := account.Validate(); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+func (a *SlackAccount) Validate() error {
+	if a.UserId <= 0 {
+		return errors.New("invalid user ID")
+	}
+	if a.TeamId == "" || a.SlackUserId == "" {
+		return errors.New("slack team and user IDs cannot be empty")
+	}
+	return nil
+}
+
+// SlackLinkCode is issued to a Slack user by "/kintai link". Redeeming it while signed in to the
+// app links the two accounts; Slack vouches for one side and the app's login for the other.
+type SlackLinkCode struct {
+	Code        string
+	TeamId      string
+	SlackUserId string
+	ExpiresAt   time.Time
+}
+
+// NewSlackLinkCode issues a random single-use code for the Slack user
+func NewSlackLinkCode(teamId, slackUserId string, now time.Time) (*SlackLinkCode, error) {
+	code := make([]byte, 8)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(slackLinkCodeAlphabet))))
+		if err != nil {
+			return nil, err
+		}
+		code[i] = slackLinkCodeAlphabet[n.Int64()]
+	}
+	return &SlackLinkCode{
+		Code:        string(code),
+		TeamId:      teamId,
+		SlackUserId: slackUserId,
+		ExpiresAt:   now.Add(SlackLinkCodeTTL),
+	}, nil
+}
+
+func (c *SlackLinkCode) IsExpired(now time.Time) bool {
+	return !now.Before(c.ExpiresAt)
+}
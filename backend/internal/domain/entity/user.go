@@ -2,6 +2,7 @@ package entity
 
 import (
 	"errors"
+	"strings"
 	"time"
 )
 
@@ -17,6 +18,19 @@ type PayType string
 const (
 	PayTypeHourly PayType = "HOURLY"
 	PayTypeSalary PayType = "MONTHLY"
+	// PayTypeDaily pays PayRate per day worked (日給)
+	PayTypeDaily PayType = "DAILY"
+	// PayTypeFixedOvertime is a monthly salary that already includes
+	// FixedOvertimeHours of overtime (みなし残業); excess hours are paid separately
+	PayTypeFixedOvertime PayType = "MONTHLY_FIXED_OVERTIME"
+)
+
+// ProrationPolicy decides how a MONTHLY salary is prorated for partial months of employment
+type ProrationPolicy string
+
+const (
+	ProrationPolicyCalendarDays ProrationPolicy = "CALENDAR_DAYS"
+	ProrationPolicyWorkingDays  ProrationPolicy = "WORKING_DAYS"
 )
 
 func (r UserRole) Validate() error {
@@ -30,24 +44,50 @@ func (r UserRole) Validate() error {
 
 func (p PayType) Validate() error {
 	switch p {
-	case PayTypeHourly, PayTypeSalary:
+	case PayTypeHourly, PayTypeSalary, PayTypeDaily, PayTypeFixedOvertime:
 		return nil
 	default:
 		return errors.New("invalid pay type")
 	}
 }
 
+// IsMonthly reports whether the pay type is a monthly salary
+func (p PayType) IsMonthly() bool {
+	return p == PayTypeSalary || p == PayTypeFixedOvertime
+}
+
+func (p ProrationPolicy) Validate() error {
+	switch p {
+	case ProrationPolicyCalendarDays, ProrationPolicyWorkingDays:
+		return nil
+	default:
+		return errors.New("invalid proration policy")
+	}
+}
+
 type User struct {
-	Id        int
-	Name      string
-	Email     string
-	Password  string
-	Role      UserRole
-	PayType   PayType
-	PayRate   int
-	Goal      int
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	Id       int
+	Name     string
+	Email    string
+	Password string
+	Role     UserRole
+	PayType  PayType
+	PayRate  int
+	Goal     int
+	// Department the user belongs to; empty if unassigned
+	Department string
+	// Overtime hours per month included in the salary for PayTypeFixedOvertime
+	FixedOvertimeHours int
+	HireDate           *time.Time
+	TerminationDate    *time.Time
+	ProrationPolicy    ProrationPolicy
+	// Scheduled working hours ("HH:MM"), used for late-arrival/early-leave deductions.
+	// Empty means the user has no fixed schedule.
+	ScheduledStartTime    string
+	ScheduledEndTime      string
+	ScheduledBreakMinutes int
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
 }
 
 func NewUser(name, email, password string, role UserRole, payType PayType, payRate int) (*User, error) {
@@ -59,15 +99,16 @@ func NewUser(name, email, password string, role UserRole, payType PayType, payRa
 	}
 
 	return &User{
-		Name:      name,
-		Email:     email,
-		Password:  password,
-		Role:      role,
-		PayType:   payType,
-		PayRate:   payRate,
-		Goal:      0, // Default goal is 0
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		Name:            name,
+		Email:           email,
+		Password:        password,
+		Role:            role,
+		PayType:         payType,
+		PayRate:         payRate,
+		Goal:            0, // Default goal is 0
+		ProrationPolicy: ProrationPolicyCalendarDays,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
 	}, nil
 }
 
@@ -78,6 +119,35 @@ func (u *User) IsUser() bool {
 	return u.Role == UserRoleUser
 }
 
+// MatchesMention reports whether an @mention in report text refers to the user. A mention
+// matches the local part of the user's email (@tanaka for tanaka@example.com) or the user's
+// name without spaces (@田中太郎), ignoring case and a trailing honorific.
+func (u *User) MatchesMention(handle string) bool {
+	if handle == "" {
+		return false
+	}
+	name := strings.Join(strings.Fields(u.Name), "")
+	for _, h := range []string{handle, trimHonorific(handle)} {
+		if local, _, ok := strings.Cut(u.Email, "@"); ok && strings.EqualFold(local, h) {
+			return true
+		}
+		if strings.EqualFold(name, h) {
+			return true
+		}
+	}
+	return false
+}
+
+// trimHonorific drops a Japanese honorific written straight after a mention (@田中さん)
+func trimHonorific(handle string) string {
+	for _, suffix := range []string{"さん", "さま", "様", "くん", "君", "氏"} {
+		if trimmed := strings.TrimSuffix(handle, suffix); trimmed != handle && trimmed != "" {
+			return trimmed
+		}
+	}
+	return handle
+}
+
 func (u *User) Validate() error {
 	if u.Name == "" {
 		return errors.New("name cannot be empty")
@@ -97,5 +167,100 @@ func (u *User) Validate() error {
 	if u.PayRate <= 0 {
 		return errors.New("pay rate must be greater than zero")
 	}
+	if err := u.ValidatePayTerms(); err != nil {
+		return err
+	}
+	return u.ValidateEmployment()
+}
+
+// ValidatePayTerms validates settings that depend on the pay type
+func (u *User) ValidatePayTerms() error {
+	if u.FixedOvertimeHours < 0 {
+		return errors.New("fixed overtime hours cannot be negative")
+	}
+	if u.PayType == PayTypeFixedOvertime && u.FixedOvertimeHours == 0 {
+		return errors.New("fixed overtime hours are required for fixed overtime pay")
+	}
 	return nil
 }
+
+// ValidateEmployment validates the employment period, proration policy and schedule
+func (u *User) ValidateEmployment() error {
+	if err := u.ProrationPolicy.Validate(); err != nil {
+		return err
+	}
+	if u.HireDate != nil && u.TerminationDate != nil && u.TerminationDate.Before(*u.HireDate) {
+		return errors.New("termination date cannot be before hire date")
+	}
+	return u.validateSchedule()
+}
+
+func (u *User) validateSchedule() error {
+	if u.ScheduledStartTime == "" && u.ScheduledEndTime == "" {
+		return nil
+	}
+	start, err := ParseClock(u.ScheduledStartTime)
+	if err != nil {
+		return errors.New("invalid scheduled start time")
+	}
+	end, err := ParseClock(u.ScheduledEndTime)
+	if err != nil {
+		return errors.New("invalid scheduled end time")
+	}
+	// An end before the start is a shift that crosses midnight, e.g. 22:00-06:00
+	if end == start {
+		return errors.New("scheduled end time must differ from start time")
+	}
+	if u.ScheduledBreakMinutes < 0 || u.ScheduledBreakMinutes >= u.ScheduledShiftMinutes() {
+		return errors.New("invalid scheduled break minutes")
+	}
+	return nil
+}
+
+// HasSchedule reports whether the user has fixed scheduled working hours
+func (u *User) HasSchedule() bool {
+	return u.ScheduledStartTime != "" && u.ScheduledEndTime != ""
+}
+
+// ScheduledShiftMinutes returns the minutes from the scheduled start to the scheduled end, break included,
+// or 0 without a schedule. A shift ending before it starts ends on the next day.
+func (u *User) ScheduledShiftMinutes() int {
+	if !u.HasSchedule() {
+		return 0
+	}
+	start, _ := ParseClock(u.ScheduledStartTime)
+	end, _ := ParseClock(u.ScheduledEndTime)
+	if end <= start {
+		end += 24 * 60
+	}
+	return end - start
+}
+
+// ScheduledDailyMinutes returns the scheduled working minutes per day, or 0 without a schedule
+func (u *User) ScheduledDailyMinutes() int {
+	if !u.HasSchedule() {
+		return 0
+	}
+	return u.ScheduledShiftMinutes() - u.ScheduledBreakMinutes
+}
+
+// IsEmployedOn reports whether date falls between the hire and termination dates (inclusive)
+func (u *User) IsEmployedOn(date time.Time) bool {
+	day := truncateToDay(date)
+	if u.HireDate != nil && day.Before(truncateToDay(*u.HireDate)) {
+		return false
+	}
+	if u.TerminationDate != nil && day.After(truncateToDay(*u.TerminationDate)) {
+		return false
+	}
+	return true
+}
+
+// ParseClock parses an "HH:MM" string into minutes since midnight
+func ParseClock(clock string) (int, error) {
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
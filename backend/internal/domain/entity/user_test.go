@@ -0,0 +1,51 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+func TestUserSchedule(t *testing.T) {
+	tests := []struct {
+		name         string
+		start, end   string
+		breakMinutes int
+		valid        bool
+		shiftMinutes int
+		dailyMinutes int
+	}{
+		{name: "day shift", start: "09:00", end: "18:00", breakMinutes: 60, valid: true, shiftMinutes: 540, dailyMinutes: 480},
+		{name: "night shift", start: "22:00", end: "06:00", breakMinutes: 60, valid: true, shiftMinutes: 480, dailyMinutes: 420},
+		{name: "ends at midnight", start: "16:00", end: "00:00", breakMinutes: 45, valid: true, shiftMinutes: 480, dailyMinutes: 435},
+		{name: "starts and ends at the same time", start: "09:00", end: "09:00"},
+		{name: "break as long as the night shift", start: "22:00", end: "06:00", breakMinutes: 480},
+		{name: "negative break", start: "09:00", end: "18:00", breakMinutes: -1},
+		{name: "invalid end", start: "09:00", end: "25:00"},
+		{name: "no schedule", valid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := &entity.User{
+				ProrationPolicy:       entity.ProrationPolicyCalendarDays,
+				ScheduledStartTime:    tt.start,
+				ScheduledEndTime:      tt.end,
+				ScheduledBreakMinutes: tt.breakMinutes,
+			}
+			err := user.ValidateEmployment()
+			if (err == nil) != tt.valid {
+				t.Fatalf("ValidateEmployment() = %v, want valid = %v", err, tt.valid)
+			}
+			if !tt.valid {
+				return
+			}
+			if got := user.ScheduledShiftMinutes(); got != tt.shiftMinutes {
+				t.Errorf("ScheduledShiftMinutes() = %d, want %d", got, tt.shiftMinutes)
+			}
+			if got := user.ScheduledDailyMinutes(); got != tt.dailyMinutes {
+				t.Errorf("ScheduledDailyMinutes() = %d, want %d", got, tt.dailyMinutes)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package entity
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"time"
+)
+
+// WebhookEvent is a change internal tools can subscribe to
+type WebhookEvent string
+
+const (
+	WebhookAttendanceCreated WebhookEvent = "attendance.created"
+	WebhookAttendanceUpdated WebhookEvent = "attendance.updated"
+	WebhookUserCreated       WebhookEvent = "user.created"
+	WebhookUserUpdated       WebhookEvent = "user.updated"
+	WebhookUserDeleted       WebhookEvent = "user.deleted"
+	// WebhookPing is sent by admins to test a subscription; every subscription receives it
+	WebhookPing WebhookEvent = "ping"
+)
+
+// WebhookEvents lists the events subscriptions can filter on
+var WebhookEvents = []WebhookEvent{
+	WebhookAttendanceCreated,
+	WebhookAttendanceUpdated,
+	WebhookUserCreated,
+	WebhookUserUpdated,
+	WebhookUserDeleted,
+}
+
+func (e WebhookEvent) Validate() error {
+	for _, event := range WebhookEvents {
+		if e == event {
+			return nil
+		}
+	}
+	return errors.New("invalid webhook event")
+}
+
+// minWebhookSecretLength keeps signatures from being guessed
+const minWebhookSecretLength = 16
+
+// WebhookSubscription posts the events it filters on to an admin-registered URL, signed with its secret
+type WebhookSubscription struct {
+	Id     int
+	Name   string
+	URL    string
+	Secret string
+	Events []WebhookEvent
+	// Disabled subscriptions are kept but receive nothing
+	Enabled   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewWebhookSubscription creates an enabled subscription; a random secret is generated if secret is empty
+func NewWebhookSubscription(name, url, secret string, events []WebhookEvent) (*WebhookSubscription, error) {
+	if secret == "" {
+		var err error
+		if secret, err = NewWebhookSecret(); err != nil {
+			return nil, err
+		}
+	}
+	subscription := &WebhookSubscription{
+		Name:      name,
+		URL:       url,
+		Secret:    secret,
+		Events:    events,
+		Enabled:   true,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if err := subscription.Validate(); err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+// NewWebhookSecret returns a random secret of 32 bytes, hex-encoded
+func NewWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func (s *WebhookSubscription) Validate() error {
+	if s.Name == "" {
+		return errors.New("name cannot be empty")
+	}
+	if err := ValidateWebhookURL(s.URL); err != nil {
+		return err
+	}
+	if err := ValidateWebhookSecret(s.Secret); err != nil {
+		return err
+	}
+	if len(s.Events) == 0 {
+		return errors.New("events cannot be empty")
+	}
+	for _, event := range s.Events {
+		if err := event.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ValidateWebhookURL(rawURL string) error {
+	target, err := url.Parse(rawURL)
+	if err != nil || (target.Scheme != "https" && target.Scheme != "http") || target.Host == "" {
+		return errors.New("url must be an http or https URL")
+	}
+	return nil
+}
+
+func ValidateWebhookSecret(secret string) error {
+	if len(secret) < minWebhookSecretLength {
+		return errors.New("secret must be at least 16 characters")
+	}
+	return nil
+}
+
+// Subscribes reports whether the subscription receives the event
+func (s *WebhookSubscription) Subscribes(event WebhookEvent) bool {
+	if event == WebhookPing {
+		return true
+	}
+	for _, e := range s.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDelivery is the log of one attempt to post an event to a subscription
+type WebhookDelivery struct {
+	Id             int
+	SubscriptionId int
+	// EventId identifies the event; retries of the same event share it
+	EventId    string
+	Event      WebhookEvent
+	StatusCode int    // 0 if no response was received
+	Response   string // start of the response body
+	Error      string // empty for successful deliveries
+	DurationMs int
+	CreatedAt  time.Time
+}
+
+// Succeeded reports whether the receiver answered with a 2xx status
+func (d *WebhookDelivery) Succeeded() bool {
+	return d.StatusCode >= 200 && d.StatusCode < 300
+}
@@ -12,4 +12,28 @@ var (
 	ErrEmailAlreadyExists = errors.New("email already exists")
 	ErrUnauthorized       = errors.New("unauthorized")
 	ErrForbidden          = errors.New("forbidden")
+
+	ErrPayrollRunExists    = errors.New("an open payroll run already exists for this month")
+	ErrPayrollPeriodLocked = errors.New("attendance period is locked by a finalized payroll run")
+
+	ErrDailyReportNotFound   = errors.New("daily report not found")
+	ErrDailyReportExists     = errors.New("a daily report already exists for this date")
+	ErrReportCommentNotFound = errors.New("comment not found")
+
+	ErrJobNotFound   = errors.New("job not found")
+	ErrJobRunning    = errors.New("job is already running")
+	ErrJobAlreadyRan = errors.New("job already ran for this schedule")
+
+	ErrOutboxMessageNotFound = errors.New("outbox message not found")
+	ErrOutboxNotResendable   = errors.New("only dead-lettered messages can be resent")
+
+	ErrNotificationChannelNotFound = errors.New("notification channel not found")
+	ErrNotificationEventNotFound   = errors.New("notification event not found")
+	ErrNotificationOptedOut        = errors.New("user opted out of the notification")
+
+	ErrReminderAlreadySent = errors.New("user was already reminded about this day")
+
+	ErrSlackLinkCodeInvalid = errors.New("invalid or expired slack link code")
+
+	ErrWebhookSubscriptionNotFound = errors.New("webhook subscription not found")
 )
\ No newline at end of file
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type AllowanceRepository interface {
+	FindAll(ctx context.Context) ([]*entity.Allowance, error)
+	FindById(ctx context.Context, id int) (*entity.Allowance, error)
+	Create(ctx context.Context, allowance *entity.Allowance) (*entity.Allowance, error)
+	Update(ctx context.Context, allowance *entity.Allowance) (*entity.Allowance, error)
+	Delete(ctx context.Context, id int) error
+
+	// User assignments are returned with their Allowance populated
+	FindUserAllowancesByUserId(ctx context.Context, userId int) ([]*entity.UserAllowance, error)
+	FindUserAllowancesByPeriod(ctx context.Context, startDate, endDate time.Time) ([]*entity.UserAllowance, error)
+	FindUserAllowanceById(ctx context.Context, id int) (*entity.UserAllowance, error)
+	CreateUserAllowance(ctx context.Context, userAllowance *entity.UserAllowance) (*entity.UserAllowance, error)
+	UpdateUserAllowance(ctx context.Context, userAllowance *entity.UserAllowance) (*entity.UserAllowance, error)
+	DeleteUserAllowance(ctx context.Context, id int) error
+}
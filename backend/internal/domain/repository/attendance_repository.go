@@ -11,6 +11,12 @@ type AttendanceRepository interface {
 	FindAll(ctx context.Context) ([]*entity.Attendance, error)
 	FindByUserId(ctx context.Context, userId int) ([]*entity.Attendance, error)
 	FindByDatePeriod(ctx context.Context, userId int, startDate, endDate time.Time) ([]*entity.Attendance, error)
+	FindAllByDatePeriod(ctx context.Context, startDate, endDate time.Time) ([]*entity.Attendance, error)
+
+	// Aggregations are computed in the database; hours exclude breaks and are never negative per record
+	SummarizeByUser(ctx context.Context, startDate, endDate time.Time) ([]*entity.AttendanceSummary, error)
+	// SummarizeByUserAndWeek groups by user and Monday-to-Sunday week
+	SummarizeByUserAndWeek(ctx context.Context, startDate, endDate time.Time) ([]*entity.AttendanceSummary, error)
 	FindById(ctx context.Context, id int) (*entity.Attendance, error)
 	Create(ctx context.Context, attendance *entity.Attendance) (*entity.Attendance, error)
 	Update(ctx context.Context, attendance *entity.Attendance) (*entity.Attendance, error)
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type BonusRepository interface {
+	FindAll(ctx context.Context) ([]*entity.Bonus, error)
+	FindByPeriod(ctx context.Context, startDate, endDate time.Time) ([]*entity.Bonus, error)
+	FindByUserId(ctx context.Context, userId int) ([]*entity.Bonus, error)
+	FindById(ctx context.Context, id int) (*entity.Bonus, error)
+	Create(ctx context.Context, bonus *entity.Bonus) (*entity.Bonus, error)
+	Delete(ctx context.Context, id int) error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type ClockInRepository interface {
+	// FindByUser returns the user's open shift, or nil if the user is not clocked in
+	FindByUser(ctx context.Context, userId int) (*entity.ClockIn, error)
+	Create(ctx context.Context, clockIn *entity.ClockIn) (*entity.ClockIn, error)
+	Delete(ctx context.Context, id int) error
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+// DailyReportFilter narrows down a daily report query. Zero values are not applied.
+type DailyReportFilter struct {
+	UserId     int
+	Department string
+	StartDate  *time.Time
+	EndDate    *time.Time
+	Keyword    string
+	Status     entity.ReportStatus
+	Tag        string // normalized hashtag, see entity.NormalizeTag
+	// Viewer keeps only the reports the user may read, see entity.DailyReport.VisibleTo
+	Viewer *entity.User
+	// ReaderId selects each report's read state for that user; Unread then keeps only
+	// reports by others that the reader has not read yet
+	ReaderId int
+	Unread   bool
+	Offset   int
+	Limit    int
+}
+
+type DailyReportRepository interface {
+	// FindByFilter returns one page of reports with their author details, newest first,
+	// and the total number of matches
+	FindByFilter(ctx context.Context, filter DailyReportFilter) ([]*entity.DailyReport, int64, error)
+	// CountUnread counts the reports matching the filter that filter.ReaderId has not read
+	CountUnread(ctx context.Context, filter DailyReportFilter) (int64, error)
+	FindById(ctx context.Context, id int) (*entity.DailyReport, error)
+	// FindByUserAndDate returns nil without an error if the user has no report for the date
+	FindByUserAndDate(ctx context.Context, userId int, date time.Time) (*entity.DailyReport, error)
+	FindByAttendanceIds(ctx context.Context, attendanceIds []int) ([]*entity.DailyReport, error)
+	// FindByIds returns the reports among ids with their author details, in no particular order
+	FindByIds(ctx context.Context, ids []int) ([]*entity.DailyReport, error)
+	// FindVisibleIds returns the ids the viewer may read, in the order given
+	FindVisibleIds(ctx context.Context, ids []int, viewer *entity.User) ([]int, error)
+	FindAllPublished(ctx context.Context) ([]*entity.DailyReport, error)
+	// FindUpdatedSince returns reports of any status changed at or after since
+	FindUpdatedSince(ctx context.Context, since time.Time) ([]*entity.DailyReport, error)
+	FindPublishedIds(ctx context.Context) ([]int, error)
+	Create(ctx context.Context, report *entity.DailyReport) (*entity.DailyReport, error)
+	Update(ctx context.Context, report *entity.DailyReport) (*entity.DailyReport, error)
+	Delete(ctx context.Context, id int) error
+
+	// LinkAttendance points the user's report for date at the attendance, replacing
+	// any link the attendance had to a report of another date
+	LinkAttendance(ctx context.Context, userId int, date time.Time, attendanceId int) error
+
+	CreateRevision(ctx context.Context, revision *entity.DailyReportRevision) (*entity.DailyReportRevision, error)
+	// FindRevisions returns the earlier versions of a report, newest first
+	FindRevisions(ctx context.Context, reportId int) ([]*entity.DailyReportRevision, error)
+}
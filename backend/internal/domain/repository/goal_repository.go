@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type GoalRepository interface {
+	// FindByUserId returns all goals of the user including superseded ones, newest first
+	FindByUserId(ctx context.Context, userId int) ([]*entity.Goal, error)
+	FindActiveByUserId(ctx context.Context, userId int) ([]*entity.Goal, error)
+	Create(ctx context.Context, goal *entity.Goal) (*entity.Goal, error)
+	Update(ctx context.Context, goal *entity.Goal) (*entity.Goal, error)
+}
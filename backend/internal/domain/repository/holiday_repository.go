@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type HolidayRepository interface {
+	FindByPeriod(ctx context.Context, startDate, endDate time.Time) ([]*entity.Holiday, error)
+	FindById(ctx context.Context, id int) (*entity.Holiday, error)
+	Create(ctx context.Context, holiday *entity.Holiday) (*entity.Holiday, error)
+	Delete(ctx context.Context, id int) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// JobLockRepository keeps a job from running on two instances at once. Locks are leases:
+// a lock whose holder stopped without releasing it can be taken once it expires.
+type JobLockRepository interface {
+	// Acquire takes the job's lock for owner until the given time and reports whether it got it
+	Acquire(ctx context.Context, jobName string, owner string, until time.Time) (bool, error)
+	// Release gives up the lock if owner still holds it
+	Release(ctx context.Context, jobName string, owner string) error
+}
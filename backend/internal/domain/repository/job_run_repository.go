@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type JobRunRepository interface {
+	// Create stores a new run. It returns domain.ErrJobAlreadyRan if the job already has a run
+	// for the same scheduled minute, e.g. started by another instance.
+	Create(ctx context.Context, run *entity.JobRun) (*entity.JobRun, error)
+	Update(ctx context.Context, run *entity.JobRun) (*entity.JobRun, error)
+	// FindByJob returns the job's latest runs, newest first
+	FindByJob(ctx context.Context, jobName string, limit int) ([]*entity.JobRun, error)
+	// FindLatest returns the latest run of every job that has run
+	FindLatest(ctx context.Context) ([]*entity.JobRun, error)
+	// FailRunning marks the job's runs still recorded as running as failed, for runs whose
+	// instance stopped before finishing. The run with id exceptId, if not 0, is left alone.
+	FailRunning(ctx context.Context, jobName string, exceptId int, reason string, now time.Time) error
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type MonthlySummaryRepository interface {
+	FindByMonth(ctx context.Context, month string) ([]*entity.MonthlySummary, error)
+	// FindByUserId returns the user's summaries for months between from and to (YYYY-MM, inclusive)
+	FindByUserId(ctx context.Context, userId int, from, to string) ([]*entity.MonthlySummary, error)
+	// Upsert inserts or replaces the summary of the user and month, clearing its stale flag
+	Upsert(ctx context.Context, summary *entity.MonthlySummary) error
+	DeleteByUserAndMonth(ctx context.Context, userId int, month string) error
+
+	// FindBuild returns the month's build record, or nil if the month was never built
+	FindBuild(ctx context.Context, month string) (*entity.MonthlySummaryBuild, error)
+	// UpsertBuild inserts or replaces the month's build record
+	UpsertBuild(ctx context.Context, build *entity.MonthlySummaryBuild) error
+	// MarkStale flags the summaries of the user (every user if userId is 0) and the build records
+	// for months between from and to (YYYY-MM, inclusive; an empty bound is open)
+	MarkStale(ctx context.Context, userId int, from, to string) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type NotificationChannelRepository interface {
+	FindAll(ctx context.Context) ([]*entity.NotificationChannel, error)
+	FindById(ctx context.Context, id int) (*entity.NotificationChannel, error)
+	Create(ctx context.Context, channel *entity.NotificationChannel) (*entity.NotificationChannel, error)
+	Update(ctx context.Context, channel *entity.NotificationChannel) (*entity.NotificationChannel, error)
+	// Delete removes the channel together with its routes
+	Delete(ctx context.Context, id int) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type NotificationPreferenceRepository interface {
+	// FindByUser returns the preferences the user has set; events without one use the default
+	FindByUser(ctx context.Context, userId int) ([]*entity.NotificationPreference, error)
+	// Find returns the user's preference for the event, or nil if the user has not set one
+	Find(ctx context.Context, userId int, event entity.NotificationEvent) (*entity.NotificationPreference, error)
+	// Upsert stores the preference, replacing the user's previous one for the event
+	Upsert(ctx context.Context, preference *entity.NotificationPreference) error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type NotificationRouteRepository interface {
+	FindAll(ctx context.Context) ([]*entity.NotificationRoute, error)
+	FindByEvent(ctx context.Context, event entity.NotificationEvent) ([]*entity.NotificationRoute, error)
+	// ReplaceForEvent replaces the event's routes with the given ones
+	ReplaceForEvent(ctx context.Context, event entity.NotificationEvent, routes []*entity.NotificationRoute) error
+}
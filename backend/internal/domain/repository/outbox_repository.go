@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type OutboxRepository interface {
+	// Enqueue stores the message, or does nothing if a message with the same idempotency key
+	// exists. It joins the transaction in ctx so that the message commits with the change it reports.
+	Enqueue(ctx context.Context, message *entity.OutboxMessage) error
+	// ClaimDue reserves up to limit pending messages that are due at now for claimant until the
+	// given time, so that other instances leave them alone, and returns them oldest first
+	ClaimDue(ctx context.Context, claimant string, now time.Time, until time.Time, limit int) ([]*entity.OutboxMessage, error)
+	// Update saves the outcome of a delivery or a resend and releases the claim
+	Update(ctx context.Context, message *entity.OutboxMessage) (*entity.OutboxMessage, error)
+	FindById(ctx context.Context, id int) (*entity.OutboxMessage, error)
+	// FindByStatus returns one page of messages in the status, newest first, and the total
+	FindByStatus(ctx context.Context, status entity.OutboxStatus, offset, limit int) ([]*entity.OutboxMessage, int64, error)
+}
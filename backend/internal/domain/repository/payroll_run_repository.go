@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type PayrollRunRepository interface {
+	FindAll(ctx context.Context) ([]*entity.PayrollRun, error)
+	FindById(ctx context.Context, id int) (*entity.PayrollRun, error)
+	// FindByIdForUpdate is FindById locking the run until the transaction in ctx ends, so that
+	// concurrent state changes of the run are serialized
+	FindByIdForUpdate(ctx context.Context, id int) (*entity.PayrollRun, error)
+	FindByMonth(ctx context.Context, month string) ([]*entity.PayrollRun, error)
+	// FindByMonthForShare is FindByMonth holding a shared lock on the runs until the transaction
+	// in ctx ends; a run being finalized is read once it is committed
+	FindByMonthForShare(ctx context.Context, month string) ([]*entity.PayrollRun, error)
+	// Create stores a new run. It returns domain.ErrPayrollRunExists if the month already has a
+	// draft or finalized run.
+	Create(ctx context.Context, run *entity.PayrollRun) (*entity.PayrollRun, error)
+	Update(ctx context.Context, run *entity.PayrollRun) (*entity.PayrollRun, error)
+
+	CreateLines(ctx context.Context, lines []*entity.PayrollLine) error
+	FindLinesByRunId(ctx context.Context, runId int) ([]*entity.PayrollLine, error)
+
+	CreateAudit(ctx context.Context, audit *entity.PayrollRunAudit) error
+	FindAuditsByRunId(ctx context.Context, runId int) ([]*entity.PayrollRunAudit, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type ReportCommentRepository interface {
+	// FindByReportId returns the report's comments and replies, oldest first
+	FindByReportId(ctx context.Context, reportId int) ([]*entity.ReportComment, error)
+	FindById(ctx context.Context, id int) (*entity.ReportComment, error)
+	Create(ctx context.Context, comment *entity.ReportComment) (*entity.ReportComment, error)
+	Update(ctx context.Context, comment *entity.ReportComment) (*entity.ReportComment, error)
+	// Delete removes the comment together with its replies
+	Delete(ctx context.Context, id int) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type ReportReactionRepository interface {
+	// FindByReportId returns the report's reactions, oldest first
+	FindByReportId(ctx context.Context, reportId int) ([]*entity.ReportReaction, error)
+	// Find returns the user's reaction with the emoji, or nil if there is none
+	Find(ctx context.Context, reportId, userId int, emoji string) (*entity.ReportReaction, error)
+	Create(ctx context.Context, reaction *entity.ReportReaction) (*entity.ReportReaction, error)
+	Delete(ctx context.Context, id int) error
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type ReportReadRepository interface {
+	// MarkRead records that the user read the report; reading it again keeps the first time
+	MarkRead(ctx context.Context, reportId, userId int, readAt time.Time) error
+	// MarkAllRead marks every published report by others that the viewer may read as read,
+	// and returns how many were newly marked
+	MarkAllRead(ctx context.Context, viewer *entity.User, readAt time.Time) (int64, error)
+	// FindByReportId returns the report's readers with their details, earliest first
+	FindByReportId(ctx context.Context, reportId int) ([]*entity.ReportRead, error)
+}
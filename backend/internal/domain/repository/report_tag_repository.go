@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+// TagCountFilter narrows down the reports counted for the tag cloud. Zero values are not applied.
+type TagCountFilter struct {
+	StartDate  *time.Time
+	EndDate    *time.Time
+	Department string
+	// Viewer counts only the reports the user may read
+	Viewer *entity.User
+	Limit  int
+}
+
+// ReportTagRepository stores the hashtags and mentions parsed from daily reports
+type ReportTagRepository interface {
+	// ReplaceTags makes tags the report's complete set of hashtags
+	ReplaceTags(ctx context.Context, reportId int, tags []string) error
+	// ReplaceMentions makes userIds the report's complete set of mentioned users.
+	// Users who were already mentioned keep their notification state.
+	ReplaceMentions(ctx context.Context, reportId int, userIds []int) error
+	// FindUnnotifiedMentions returns the mentioned users who have not been notified yet
+	FindUnnotifiedMentions(ctx context.Context, reportId int) ([]int, error)
+	MarkMentionsNotified(ctx context.Context, reportId int, userIds []int, notifiedAt time.Time) error
+	// CountTags counts the published reports per hashtag, most used first
+	CountTags(ctx context.Context, filter TagCountFilter) ([]*entity.TagCount, error)
+}
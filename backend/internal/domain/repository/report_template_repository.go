@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type ReportTemplateRepository interface {
+	FindAll(ctx context.Context) ([]*entity.ReportTemplate, error)
+	FindById(ctx context.Context, id int) (*entity.ReportTemplate, error)
+	Create(ctx context.Context, template *entity.ReportTemplate) (*entity.ReportTemplate, error)
+	Update(ctx context.Context, template *entity.ReportTemplate) (*entity.ReportTemplate, error)
+	Delete(ctx context.Context, id int) error
+}
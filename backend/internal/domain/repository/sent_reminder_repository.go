@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type SentReminderRepository interface {
+	// Create records a reminder. It returns domain.ErrReminderAlreadySent if the user was
+	// already reminded about the day, e.g. by another instance.
+	Create(ctx context.Context, reminder *entity.SentReminder) error
+	// Delete removes the record of a reminder that could not be sent, so that it is retried
+	Delete(ctx context.Context, userId int, date time.Time) error
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type SlackAccountRepository interface {
+	// FindBySlackUser returns the account linked to the Slack user, or nil if there is none
+	FindBySlackUser(ctx context.Context, teamId, slackUserId string) (*entity.SlackAccount, error)
+	// FindByUser returns the user's linked account, or nil if there is none
+	FindByUser(ctx context.Context, userId int) (*entity.SlackAccount, error)
+	// Link stores the account, replacing any previous link of the user or of the Slack user
+	Link(ctx context.Context, account *entity.SlackAccount) (*entity.SlackAccount, error)
+	DeleteByUser(ctx context.Context, userId int) error
+
+	CreateLinkCode(ctx context.Context, code *entity.SlackLinkCode) error
+	// TakeLinkCode deletes the code and returns it, or nil if it does not exist
+	TakeLinkCode(ctx context.Context, code string) (*entity.SlackLinkCode, error)
+}
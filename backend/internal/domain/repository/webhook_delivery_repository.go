@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type WebhookDeliveryRepository interface {
+	Create(ctx context.Context, delivery *entity.WebhookDelivery) (*entity.WebhookDelivery, error)
+	// FindBySubscription returns a page of the subscription's deliveries, newest first, and the total count
+	FindBySubscription(ctx context.Context, subscriptionId int, offset, limit int) ([]*entity.WebhookDelivery, int64, error)
+}
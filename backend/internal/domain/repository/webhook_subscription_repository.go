@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type WebhookSubscriptionRepository interface {
+	FindAll(ctx context.Context) ([]*entity.WebhookSubscription, error)
+	FindById(ctx context.Context, id int) (*entity.WebhookSubscription, error)
+	Create(ctx context.Context, subscription *entity.WebhookSubscription) (*entity.WebhookSubscription, error)
+	Update(ctx context.Context, subscription *entity.WebhookSubscription) (*entity.WebhookSubscription, error)
+	// Delete deletes the subscription and its delivery log
+	Delete(ctx context.Context, id int) error
+}
@@ -0,0 +1,107 @@
+package model
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type Allowance struct {
+	Id            int       `gorm:"primaryKey;column:id;autoIncrement"`
+	Name          string    `gorm:"column:name;not null;size:255"`
+	Taxable       bool      `gorm:"column:taxable;not null"`
+	DefaultAmount int       `gorm:"column:default_amount;not null;default:0"`
+	CreatedAt     time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt     time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (Allowance) TableName() string {
+	return "allowances"
+}
+
+func (a *Allowance) ToEntity() *entity.Allowance {
+	return &entity.Allowance{
+		Id:            a.Id,
+		Name:          a.Name,
+		Taxable:       a.Taxable,
+		DefaultAmount: a.DefaultAmount,
+		CreatedAt:     a.CreatedAt,
+		UpdatedAt:     a.UpdatedAt,
+	}
+}
+
+func (a *Allowance) FromEntity(allowance *entity.Allowance) {
+	a.Id = allowance.Id
+	a.Name = allowance.Name
+	a.Taxable = allowance.Taxable
+	a.DefaultAmount = allowance.DefaultAmount
+}
+
+type UserAllowance struct {
+	Id            int        `gorm:"primaryKey;column:id;autoIncrement"`
+	UserId        int        `gorm:"column:user_id;not null;index"`
+	User          User       `gorm:"foreignKey:UserId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	AllowanceId   int        `gorm:"column:allowance_id;not null;index"`
+	Allowance     Allowance  `gorm:"foreignKey:AllowanceId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Amount        int        `gorm:"column:amount;not null;default:0"`
+	EffectiveFrom time.Time  `gorm:"column:effective_from;type:date;not null"`
+	EffectiveTo   *time.Time `gorm:"column:effective_to;type:date"`
+	CreatedAt     time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt     time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (UserAllowance) TableName() string {
+	return "user_allowances"
+}
+
+func (a *UserAllowance) ToEntity() *entity.UserAllowance {
+	return &entity.UserAllowance{
+		Id:            a.Id,
+		UserId:        a.UserId,
+		AllowanceId:   a.AllowanceId,
+		Allowance:     a.Allowance.ToEntity(),
+		Amount:        a.Amount,
+		EffectiveFrom: a.EffectiveFrom,
+		EffectiveTo:   a.EffectiveTo,
+		CreatedAt:     a.CreatedAt,
+		UpdatedAt:     a.UpdatedAt,
+	}
+}
+
+func (a *UserAllowance) FromEntity(userAllowance *entity.UserAllowance) {
+	a.Id = userAllowance.Id
+	a.UserId = userAllowance.UserId
+	a.AllowanceId = userAllowance.AllowanceId
+	a.Amount = userAllowance.Amount
+	a.EffectiveFrom = userAllowance.EffectiveFrom
+	a.EffectiveTo = userAllowance.EffectiveTo
+}
+
+// Helper functions for conversion
+func ToAllowanceEntities(allowances []Allowance) []*entity.Allowance {
+	entities := make([]*entity.Allowance, len(allowances))
+	for i, a := range allowances {
+		entities[i] = a.ToEntity()
+	}
+	return entities
+}
+
+func FromAllowanceEntity(allowance *entity.Allowance) *Allowance {
+	a := &Allowance{}
+	a.FromEntity(allowance)
+	return a
+}
+
+func ToUserAllowanceEntities(userAllowances []UserAllowance) []*entity.UserAllowance {
+	entities := make([]*entity.UserAllowance, len(userAllowances))
+	for i, a := range userAllowances {
+		entities[i] = a.ToEntity()
+	}
+	return entities
+}
+
+func FromUserAllowanceEntity(userAllowance *entity.UserAllowance) *UserAllowance {
+	a := &UserAllowance{}
+	a.FromEntity(userAllowance)
+	return a
+}
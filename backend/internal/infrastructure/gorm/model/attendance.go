@@ -8,17 +8,44 @@ import (
 
 type Attendance struct {
 	Id           int       `gorm:"primaryKey;column:id;autoIncrement"`
-	UserId       int       `gorm:"column:user_id;not null"`
+	UserId       int       `gorm:"column:user_id;not null;index:idx_attendances_user_date,priority:1"`
 	User         User      `gorm:"foreignKey:UserId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
-	Date         time.Time `gorm:"column:date;not null"`
+	Date         time.Time `gorm:"column:date;not null;index:idx_attendances_user_date,priority:2;index:idx_attendances_date"`
 	StartTime    time.Time `gorm:"column:start_time;not null"`
 	EndTime      time.Time `gorm:"column:end_time;not null"`
 	BreakMinutes int       `gorm:"column:break_minutes;not null;default:0"`
-	Report       string    `gorm:"column:report;not null;size:500"`
 	CreatedAt    time.Time `gorm:"column:created_at;autoCreateTime"`
 	UpdatedAt    time.Time `gorm:"column:updated_at;autoUpdateTime"`
 }
 
+// AttendanceSummary is the result row of the attendance aggregation queries
+type AttendanceSummary struct {
+	UserId      int        `gorm:"column:user_id"`
+	PeriodStart *time.Time `gorm:"column:period_start"`
+	TotalHours  float64    `gorm:"column:total_hours"`
+	WorkedDays  int        `gorm:"column:worked_days"`
+}
+
+func (s *AttendanceSummary) ToEntity() *entity.AttendanceSummary {
+	summary := &entity.AttendanceSummary{
+		UserId:     s.UserId,
+		TotalHours: s.TotalHours,
+		WorkedDays: s.WorkedDays,
+	}
+	if s.PeriodStart != nil {
+		summary.PeriodStart = *s.PeriodStart
+	}
+	return summary
+}
+
+func ToAttendanceSummaryEntities(summaries []AttendanceSummary) []*entity.AttendanceSummary {
+	entities := make([]*entity.AttendanceSummary, len(summaries))
+	for i, s := range summaries {
+		entities[i] = s.ToEntity()
+	}
+	return entities
+}
+
 func (Attendance) TableName() string {
 	return "attendances"
 }
@@ -31,7 +58,6 @@ func (a *Attendance) ToEntity() *entity.Attendance {
 		StartTime:    a.StartTime,
 		EndTime:      a.EndTime,
 		BreakMinutes: a.BreakMinutes,
-		Report:       a.Report,
 		CreatedAt:    a.CreatedAt,
 		UpdatedAt:    a.UpdatedAt,
 	}
@@ -43,7 +69,6 @@ func (a *Attendance) FromEntity(attendance *entity.Attendance) {
 	a.StartTime = attendance.StartTime
 	a.EndTime = attendance.EndTime
 	a.BreakMinutes = attendance.BreakMinutes
-	a.Report = attendance.Report
 }
 
 // Helper functions for conversion
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type Bonus struct {
+	Id          int       `gorm:"primaryKey;column:id;autoIncrement"`
+	UserId      int       `gorm:"column:user_id;not null;index"`
+	User        User      `gorm:"foreignKey:UserId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Name        string    `gorm:"column:name;not null;size:255"`
+	Amount      int       `gorm:"column:amount;not null"`
+	PaymentDate time.Time `gorm:"column:payment_date;type:date;not null;index"`
+	Taxable     bool      `gorm:"column:taxable;not null"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (Bonus) TableName() string {
+	return "bonuses"
+}
+
+func (b *Bonus) ToEntity() *entity.Bonus {
+	return &entity.Bonus{
+		Id:          b.Id,
+		UserId:      b.UserId,
+		Name:        b.Name,
+		Amount:      b.Amount,
+		PaymentDate: b.PaymentDate,
+		Taxable:     b.Taxable,
+		CreatedAt:   b.CreatedAt,
+		UpdatedAt:   b.UpdatedAt,
+	}
+}
+
+func (b *Bonus) FromEntity(bonus *entity.Bonus) {
+	b.Id = bonus.Id
+	b.UserId = bonus.UserId
+	b.Name = bonus.Name
+	b.Amount = bonus.Amount
+	b.PaymentDate = bonus.PaymentDate
+	b.Taxable = bonus.Taxable
+}
+
+// Helper functions for conversion
+func ToBonusEntities(bonuses []Bonus) []*entity.Bonus {
+	entities := make([]*entity.Bonus, len(bonuses))
+	for i, b := range bonuses {
+		entities[i] = b.ToEntity()
+	}
+	return entities
+}
+
+func FromBonusEntity(bonus *entity.Bonus) *Bonus {
+	b := &Bonus{}
+	b.FromEntity(bonus)
+	return b
+}
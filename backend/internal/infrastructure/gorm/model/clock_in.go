@@ -0,0 +1,34 @@
+package model
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type ClockIn struct {
+	Id        int       `gorm:"primaryKey;column:id;autoIncrement"`
+	UserId    int       `gorm:"column:user_id;not null;uniqueIndex"`
+	StartedAt time.Time `gorm:"column:started_at;not null"`
+	User      User      `gorm:"foreignKey:UserId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (ClockIn) TableName() string {
+	return "clock_ins"
+}
+
+func (c *ClockIn) ToEntity() *entity.ClockIn {
+	return &entity.ClockIn{
+		Id:        c.Id,
+		UserId:    c.UserId,
+		StartedAt: c.StartedAt,
+	}
+}
+
+func FromClockInEntity(clockIn *entity.ClockIn) *ClockIn {
+	return &ClockIn{
+		Id:        clockIn.Id,
+		UserId:    clockIn.UserId,
+		StartedAt: clockIn.StartedAt,
+	}
+}
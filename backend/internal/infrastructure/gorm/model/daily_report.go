@@ -0,0 +1,175 @@
+package model
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+// ReportSection is stored as JSON in the sections column
+type ReportSection struct {
+	Heading string `json:"heading"`
+	Body    string `json:"body"`
+}
+
+type DailyReport struct {
+	Id           int             `gorm:"primaryKey;column:id;autoIncrement"`
+	UserId       int             `gorm:"column:user_id;not null;uniqueIndex:idx_daily_reports_user_date,priority:1"`
+	AttendanceId *int            `gorm:"column:attendance_id;index"`
+	Date         time.Time       `gorm:"column:date;type:date;not null;uniqueIndex:idx_daily_reports_user_date,priority:2;index:idx_daily_reports_date"`
+	TemplateId   *int            `gorm:"column:template_id;index"`
+	Sections     []ReportSection `gorm:"column:sections;type:json;serializer:json"`
+	Body         string          `gorm:"column:body;type:mediumtext"`
+	// Content is the rendered Markdown of sections and body, used for listing and keyword search
+	Content     string     `gorm:"column:content;type:mediumtext"`
+	Status      string     `gorm:"column:status;not null;size:20;index"`
+	Visibility  string     `gorm:"column:visibility;not null;size:20;default:COMPANY;index"`
+	Version     int        `gorm:"column:version;not null"`
+	PublishedAt *time.Time `gorm:"column:published_at"`
+	CreatedAt   time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt   time.Time  `gorm:"column:updated_at;autoUpdateTime;index"`
+
+	// Author details, read from users when the query joins them
+	UserName   string `gorm:"->;-:migration;column:user_name"`
+	Department string `gorm:"->;-:migration;column:department"`
+
+	// Feedback counts, read from subqueries when reports are listed
+	CommentCount  int `gorm:"->;-:migration;column:comment_count"`
+	ReactionCount int `gorm:"->;-:migration;column:reaction_count"`
+	// Read state of the listing user, selected only when a reader is given
+	IsRead *bool `gorm:"->;-:migration;column:is_read"`
+
+	// Relations
+	User       User            `gorm:"foreignKey:UserId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Attendance *Attendance     `gorm:"foreignKey:AttendanceId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	Template   *ReportTemplate `gorm:"foreignKey:TemplateId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+}
+
+func (DailyReport) TableName() string {
+	return "daily_reports"
+}
+
+func (r *DailyReport) ToEntity() *entity.DailyReport {
+	return &entity.DailyReport{
+		Id:           r.Id,
+		UserId:       r.UserId,
+		AttendanceId: r.AttendanceId,
+		Date:         r.Date,
+		TemplateId:   r.TemplateId,
+		Sections:     toReportSectionEntities(r.Sections),
+		Body:         r.Body,
+		Status:       entity.ReportStatus(r.Status),
+		Visibility:   entity.ReportVisibility(r.Visibility),
+		Version:      r.Version,
+		PublishedAt:  r.PublishedAt,
+		CreatedAt:    r.CreatedAt,
+		UpdatedAt:    r.UpdatedAt,
+		UserName:     r.UserName,
+		Department:   r.Department,
+
+		CommentCount:  r.CommentCount,
+		ReactionCount: r.ReactionCount,
+		Read:          r.IsRead,
+	}
+}
+
+func (r *DailyReport) FromEntity(report *entity.DailyReport) {
+	r.Id = report.Id
+	r.UserId = report.UserId
+	r.AttendanceId = report.AttendanceId
+	r.Date = report.Date
+	r.TemplateId = report.TemplateId
+	r.Sections = fromReportSectionEntities(report.Sections)
+	r.Body = report.Body
+	r.Content = report.Content()
+	r.Status = string(report.Status)
+	r.Visibility = string(report.Visibility)
+	r.Version = report.Version
+	r.PublishedAt = report.PublishedAt
+}
+
+type DailyReportRevision struct {
+	Id        int             `gorm:"primaryKey;column:id;autoIncrement"`
+	ReportId  int             `gorm:"column:report_id;not null;index"`
+	Version   int             `gorm:"column:version;not null"`
+	Sections  []ReportSection `gorm:"column:sections;type:json;serializer:json"`
+	Body      string          `gorm:"column:body;type:mediumtext"`
+	Status    string          `gorm:"column:status;not null;size:20"`
+	EditedBy  int             `gorm:"column:edited_by;not null"`
+	CreatedAt time.Time       `gorm:"column:created_at;autoCreateTime"`
+
+	// Relations
+	Report DailyReport `gorm:"foreignKey:ReportId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (DailyReportRevision) TableName() string {
+	return "daily_report_revisions"
+}
+
+func (r *DailyReportRevision) ToEntity() *entity.DailyReportRevision {
+	return &entity.DailyReportRevision{
+		Id:        r.Id,
+		ReportId:  r.ReportId,
+		Version:   r.Version,
+		Sections:  toReportSectionEntities(r.Sections),
+		Body:      r.Body,
+		Status:    entity.ReportStatus(r.Status),
+		EditedBy:  r.EditedBy,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+func (r *DailyReportRevision) FromEntity(revision *entity.DailyReportRevision) {
+	r.Id = revision.Id
+	r.ReportId = revision.ReportId
+	r.Version = revision.Version
+	r.Sections = fromReportSectionEntities(revision.Sections)
+	r.Body = revision.Body
+	r.Status = string(revision.Status)
+	r.EditedBy = revision.EditedBy
+}
+
+func toReportSectionEntities(sections []ReportSection) []entity.ReportSection {
+	entities := make([]entity.ReportSection, len(sections))
+	for i, s := range sections {
+		entities[i] = entity.ReportSection{Heading: s.Heading, Body: s.Body}
+	}
+	return entities
+}
+
+func fromReportSectionEntities(sections []entity.ReportSection) []ReportSection {
+	models := make([]ReportSection, len(sections))
+	for i, s := range sections {
+		models[i] = ReportSection{Heading: s.Heading, Body: s.Body}
+	}
+	return models
+}
+
+// Helper functions for conversion
+func ToDailyReportEntities(reports []DailyReport) []*entity.DailyReport {
+	entities := make([]*entity.DailyReport, len(reports))
+	for i, r := range reports {
+		entities[i] = r.ToEntity()
+	}
+	return entities
+}
+
+func FromDailyReportEntity(report *entity.DailyReport) *DailyReport {
+	r := &DailyReport{}
+	r.FromEntity(report)
+	return r
+}
+
+func ToDailyReportRevisionEntities(revisions []DailyReportRevision) []*entity.DailyReportRevision {
+	entities := make([]*entity.DailyReportRevision, len(revisions))
+	for i, r := range revisions {
+		entities[i] = r.ToEntity()
+	}
+	return entities
+}
+
+func FromDailyReportRevisionEntity(revision *entity.DailyReportRevision) *DailyReportRevision {
+	r := &DailyReportRevision{}
+	r.FromEntity(revision)
+	return r
+}
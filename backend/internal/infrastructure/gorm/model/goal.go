@@ -0,0 +1,63 @@
+package model
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type Goal struct {
+	Id         int        `gorm:"primaryKey;column:id;autoIncrement"`
+	UserId     int        `gorm:"column:user_id;not null;index"`
+	Type       string     `gorm:"column:type;not null;size:20"`
+	Period     string     `gorm:"column:period;not null;size:20"`
+	Target     int        `gorm:"column:target;not null"`
+	ActiveFrom time.Time  `gorm:"column:active_from;not null"`
+	ActiveTo   *time.Time `gorm:"column:active_to"`
+	CreatedAt  time.Time  `gorm:"column:created_at;autoCreateTime"`
+
+	// Relations
+	User User `gorm:"foreignKey:UserId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (Goal) TableName() string {
+	return "goals"
+}
+
+func (g *Goal) ToEntity() *entity.Goal {
+	return &entity.Goal{
+		Id:         g.Id,
+		UserId:     g.UserId,
+		Type:       entity.GoalType(g.Type),
+		Period:     entity.GoalPeriod(g.Period),
+		Target:     g.Target,
+		ActiveFrom: g.ActiveFrom,
+		ActiveTo:   g.ActiveTo,
+		CreatedAt:  g.CreatedAt,
+	}
+}
+
+func (g *Goal) FromEntity(goal *entity.Goal) {
+	g.Id = goal.Id
+	g.UserId = goal.UserId
+	g.Type = string(goal.Type)
+	g.Period = string(goal.Period)
+	g.Target = goal.Target
+	g.ActiveFrom = goal.ActiveFrom
+	g.ActiveTo = goal.ActiveTo
+}
+
+// Helper functions for conversion
+func ToGoalEntities(goals []Goal) []*entity.Goal {
+	entities := make([]*entity.Goal, len(goals))
+	for i, g := range goals {
+		entities[i] = g.ToEntity()
+	}
+	return entities
+}
+
+func FromGoalEntity(goal *entity.Goal) *Goal {
+	g := &Goal{}
+	g.FromEntity(goal)
+	return g
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type Holiday struct {
+	Id        int       `gorm:"primaryKey;column:id;autoIncrement"`
+	Date      time.Time `gorm:"column:date;type:date;not null;uniqueIndex"`
+	Name      string    `gorm:"column:name;not null;size:255"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (Holiday) TableName() string {
+	return "holidays"
+}
+
+func (h *Holiday) ToEntity() *entity.Holiday {
+	return &entity.Holiday{
+		Id:        h.Id,
+		Date:      h.Date,
+		Name:      h.Name,
+		CreatedAt: h.CreatedAt,
+		UpdatedAt: h.UpdatedAt,
+	}
+}
+
+func (h *Holiday) FromEntity(holiday *entity.Holiday) {
+	h.Id = holiday.Id
+	h.Date = holiday.Date
+	h.Name = holiday.Name
+}
+
+// Helper functions for conversion
+func ToHolidayEntities(holidays []Holiday) []*entity.Holiday {
+	entities := make([]*entity.Holiday, len(holidays))
+	for i, h := range holidays {
+		entities[i] = h.ToEntity()
+	}
+	return entities
+}
+
+func FromHolidayEntity(holiday *entity.Holiday) *Holiday {
+	h := &Holiday{}
+	h.FromEntity(holiday)
+	return h
+}
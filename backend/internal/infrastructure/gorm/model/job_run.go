@@ -0,0 +1,78 @@
+package model
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type JobRun struct {
+	Id      int    `gorm:"primaryKey;column:id;autoIncrement"`
+	JobName string `gorm:"column:job_name;not null;size:100;uniqueIndex:idx_job_runs_job_scheduled,priority:1;index:idx_job_runs_job_started,priority:1"`
+	Trigger string `gorm:"column:trigger_type;not null;size:20"` // trigger is a reserved word in MySQL
+	// NULL for manual runs, which the unique index then does not restrict
+	ScheduledAt *time.Time `gorm:"column:scheduled_at;uniqueIndex:idx_job_runs_job_scheduled,priority:2"`
+	TriggeredBy *int       `gorm:"column:triggered_by"`
+	Instance    string     `gorm:"column:instance;not null;size:255"`
+	Status      string     `gorm:"column:status;not null;size:20"`
+	Output      string     `gorm:"column:output;type:text"`
+	Error       string     `gorm:"column:error;type:text"`
+	StartedAt   time.Time  `gorm:"column:started_at;not null;index:idx_job_runs_job_started,priority:2"`
+	FinishedAt  *time.Time `gorm:"column:finished_at"`
+}
+
+func (JobRun) TableName() string {
+	return "job_runs"
+}
+
+// JobLock is a lease on a job held by one instance until LockedUntil
+type JobLock struct {
+	Name        string    `gorm:"primaryKey;column:name;size:100"`
+	Owner       string    `gorm:"column:owner;not null;size:255"`
+	LockedUntil time.Time `gorm:"column:locked_until;not null"`
+}
+
+func (JobLock) TableName() string {
+	return "job_locks"
+}
+
+func (r *JobRun) ToEntity() *entity.JobRun {
+	return &entity.JobRun{
+		Id:          r.Id,
+		JobName:     r.JobName,
+		Trigger:     entity.JobTrigger(r.Trigger),
+		ScheduledAt: r.ScheduledAt,
+		TriggeredBy: r.TriggeredBy,
+		Instance:    r.Instance,
+		Status:      entity.JobRunStatus(r.Status),
+		Output:      r.Output,
+		Error:       r.Error,
+		StartedAt:   r.StartedAt,
+		FinishedAt:  r.FinishedAt,
+	}
+}
+
+func FromJobRunEntity(run *entity.JobRun) *JobRun {
+	return &JobRun{
+		Id:          run.Id,
+		JobName:     run.JobName,
+		Trigger:     string(run.Trigger),
+		ScheduledAt: run.ScheduledAt,
+		TriggeredBy: run.TriggeredBy,
+		Instance:    run.Instance,
+		Status:      string(run.Status),
+		Output:      run.Output,
+		Error:       run.Error,
+		StartedAt:   run.StartedAt,
+		FinishedAt:  run.FinishedAt,
+	}
+}
+
+// Helper functions for conversion
+func ToJobRunEntities(runs []JobRun) []*entity.JobRun {
+	entities := make([]*entity.JobRun, len(runs))
+	for i, r := range runs {
+		entities[i] = r.ToEntity()
+	}
+	return entities
+}
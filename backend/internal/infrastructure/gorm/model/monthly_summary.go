@@ -0,0 +1,102 @@
+package model
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type MonthlySummary struct {
+	Id             int       `gorm:"primaryKey;column:id;autoIncrement"`
+	UserId         int       `gorm:"column:user_id;not null;uniqueIndex:idx_monthly_summaries_user_month,priority:1"`
+	Month          string    `gorm:"column:month;not null;size:7;uniqueIndex:idx_monthly_summaries_user_month,priority:2;index"`
+	TotalHours     float64   `gorm:"column:total_hours;not null;default:0"`
+	RegularHours   float64   `gorm:"column:regular_hours;not null;default:0"`
+	OvertimeHours  float64   `gorm:"column:overtime_hours;not null;default:0"`
+	HolidayHours   float64   `gorm:"column:holiday_hours;not null;default:0"`
+	LateNightHours float64   `gorm:"column:late_night_hours;not null;default:0"`
+	WorkedDays     int       `gorm:"column:worked_days;not null;default:0"`
+	EstimatedPay   int       `gorm:"column:estimated_pay;not null;default:0"`
+	CalculatedAt   time.Time `gorm:"column:calculated_at;not null"`
+	Stale          bool      `gorm:"column:stale;not null;default:false"`
+
+	// Relations
+	User User `gorm:"foreignKey:UserId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (MonthlySummary) TableName() string {
+	return "monthly_summaries"
+}
+
+func (s *MonthlySummary) ToEntity() *entity.MonthlySummary {
+	return &entity.MonthlySummary{
+		Id:             s.Id,
+		UserId:         s.UserId,
+		Month:          s.Month,
+		TotalHours:     s.TotalHours,
+		RegularHours:   s.RegularHours,
+		OvertimeHours:  s.OvertimeHours,
+		HolidayHours:   s.HolidayHours,
+		LateNightHours: s.LateNightHours,
+		WorkedDays:     s.WorkedDays,
+		EstimatedPay:   s.EstimatedPay,
+		CalculatedAt:   s.CalculatedAt,
+		Stale:          s.Stale,
+	}
+}
+
+func (s *MonthlySummary) FromEntity(summary *entity.MonthlySummary) {
+	s.Id = summary.Id
+	s.UserId = summary.UserId
+	s.Month = summary.Month
+	s.TotalHours = summary.TotalHours
+	s.RegularHours = summary.RegularHours
+	s.OvertimeHours = summary.OvertimeHours
+	s.HolidayHours = summary.HolidayHours
+	s.LateNightHours = summary.LateNightHours
+	s.WorkedDays = summary.WorkedDays
+	s.EstimatedPay = summary.EstimatedPay
+	s.CalculatedAt = summary.CalculatedAt
+	s.Stale = summary.Stale
+}
+
+// Helper functions for conversion
+func ToMonthlySummaryEntities(summaries []MonthlySummary) []*entity.MonthlySummary {
+	entities := make([]*entity.MonthlySummary, len(summaries))
+	for i, s := range summaries {
+		entities[i] = s.ToEntity()
+	}
+	return entities
+}
+
+func FromMonthlySummaryEntity(summary *entity.MonthlySummary) *MonthlySummary {
+	s := &MonthlySummary{}
+	s.FromEntity(summary)
+	return s
+}
+
+type MonthlySummaryBuild struct {
+	Month        string    `gorm:"primaryKey;column:month;size:7"`
+	CalculatedAt time.Time `gorm:"column:calculated_at;not null"`
+	Stale        bool      `gorm:"column:stale;not null;default:false"`
+}
+
+func (MonthlySummaryBuild) TableName() string {
+	return "monthly_summary_builds"
+}
+
+func (b *MonthlySummaryBuild) ToEntity() *entity.MonthlySummaryBuild {
+	return &entity.MonthlySummaryBuild{
+		Month:        b.Month,
+		CalculatedAt: b.CalculatedAt,
+		Stale:        b.Stale,
+	}
+}
+
+func FromMonthlySummaryBuildEntity(build *entity.MonthlySummaryBuild) *MonthlySummaryBuild {
+	return &MonthlySummaryBuild{
+		Month:        build.Month,
+		CalculatedAt: build.CalculatedAt,
+		Stale:        build.Stale,
+	}
+}
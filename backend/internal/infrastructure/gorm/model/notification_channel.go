@@ -0,0 +1,80 @@
+package model
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type NotificationChannel struct {
+	Id        int       `gorm:"primaryKey;column:id;autoIncrement"`
+	Name      string    `gorm:"column:name;not null;size:100"`
+	Type      string    `gorm:"column:type;not null;size:20"`
+	Target    string    `gorm:"column:target;not null;size:1000"`
+	Enabled   bool      `gorm:"column:enabled;not null"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (NotificationChannel) TableName() string {
+	return "notification_channels"
+}
+
+type NotificationRoute struct {
+	Id         int    `gorm:"primaryKey;column:id;autoIncrement"`
+	Event      string `gorm:"column:event;not null;size:50;uniqueIndex:idx_notification_routes_event_channel,priority:1"`
+	ChannelId  int    `gorm:"column:channel_id;not null;uniqueIndex:idx_notification_routes_event_channel,priority:2;index"`
+	Department string `gorm:"column:department;not null;default:'';size:100;uniqueIndex:idx_notification_routes_event_channel,priority:3"`
+}
+
+func (NotificationRoute) TableName() string {
+	return "notification_routes"
+}
+
+func (c *NotificationChannel) ToEntity() *entity.NotificationChannel {
+	return &entity.NotificationChannel{
+		Id:        c.Id,
+		Name:      c.Name,
+		Type:      entity.NotificationChannelType(c.Type),
+		Target:    c.Target,
+		Enabled:   c.Enabled,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+func FromNotificationChannelEntity(channel *entity.NotificationChannel) *NotificationChannel {
+	return &NotificationChannel{
+		Id:      channel.Id,
+		Name:    channel.Name,
+		Type:    string(channel.Type),
+		Target:  channel.Target,
+		Enabled: channel.Enabled,
+	}
+}
+
+func (r *NotificationRoute) ToEntity() *entity.NotificationRoute {
+	return &entity.NotificationRoute{
+		Id:         r.Id,
+		Event:      entity.NotificationEvent(r.Event),
+		ChannelId:  r.ChannelId,
+		Department: r.Department,
+	}
+}
+
+// Helper functions for conversion
+func ToNotificationChannelEntities(channels []NotificationChannel) []*entity.NotificationChannel {
+	entities := make([]*entity.NotificationChannel, len(channels))
+	for i, c := range channels {
+		entities[i] = c.ToEntity()
+	}
+	return entities
+}
+
+func ToNotificationRouteEntities(routes []NotificationRoute) []*entity.NotificationRoute {
+	entities := make([]*entity.NotificationRoute, len(routes))
+	for i, r := range routes {
+		entities[i] = r.ToEntity()
+	}
+	return entities
+}
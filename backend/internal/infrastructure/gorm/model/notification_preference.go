@@ -0,0 +1,57 @@
+package model
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type NotificationPreference struct {
+	Id         int       `gorm:"primaryKey;column:id;autoIncrement"`
+	UserId     int       `gorm:"column:user_id;not null;uniqueIndex:idx_notification_preferences_user_event,priority:1"`
+	Event      string    `gorm:"column:event;not null;size:50;uniqueIndex:idx_notification_preferences_user_event,priority:2"`
+	Channel    string    `gorm:"column:channel;not null;size:20"`
+	OptOut     bool      `gorm:"column:opt_out;not null"`
+	QuietStart string    `gorm:"column:quiet_start;size:5"`
+	QuietEnd   string    `gorm:"column:quiet_end;size:5"`
+	UpdatedAt  time.Time `gorm:"column:updated_at;autoUpdateTime"`
+	User       User      `gorm:"foreignKey:UserId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (NotificationPreference) TableName() string {
+	return "notification_preferences"
+}
+
+func (p *NotificationPreference) ToEntity() *entity.NotificationPreference {
+	return &entity.NotificationPreference{
+		Id:         p.Id,
+		UserId:     p.UserId,
+		Event:      entity.NotificationEvent(p.Event),
+		Channel:    entity.PreferredChannel(p.Channel),
+		OptOut:     p.OptOut,
+		QuietStart: p.QuietStart,
+		QuietEnd:   p.QuietEnd,
+		UpdatedAt:  p.UpdatedAt,
+	}
+}
+
+func FromNotificationPreferenceEntity(preference *entity.NotificationPreference) *NotificationPreference {
+	return &NotificationPreference{
+		Id:         preference.Id,
+		UserId:     preference.UserId,
+		Event:      string(preference.Event),
+		Channel:    string(preference.Channel),
+		OptOut:     preference.OptOut,
+		QuietStart: preference.QuietStart,
+		QuietEnd:   preference.QuietEnd,
+	}
+}
+
+// Helper functions for conversion
+func ToNotificationPreferenceEntities(preferences []NotificationPreference) []*entity.NotificationPreference {
+	entities := make([]*entity.NotificationPreference, len(preferences))
+	for i, p := range preferences {
+		entities[i] = p.ToEntity()
+	}
+	return entities
+}
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type OutboxMessage struct {
+	Id             int       `gorm:"primaryKey;column:id;autoIncrement"`
+	IdempotencyKey string    `gorm:"column:idempotency_key;not null;size:191;uniqueIndex"`
+	Kind           string    `gorm:"column:kind;not null;size:100"`
+	Payload        string    `gorm:"column:payload;type:text"`
+	Status         string    `gorm:"column:status;not null;size:20;index:idx_outbox_messages_due,priority:1"`
+	Attempts       int       `gorm:"column:attempts;not null;default:0"`
+	NextAttemptAt  time.Time `gorm:"column:next_attempt_at;not null;index:idx_outbox_messages_due,priority:2"`
+	LastError      string    `gorm:"column:last_error;type:text"`
+	// Claim of the dispatcher instance delivering the message
+	ClaimedBy    string     `gorm:"column:claimed_by;size:255;index"`
+	ClaimedUntil *time.Time `gorm:"column:claimed_until"`
+	CreatedAt    time.Time  `gorm:"column:created_at;autoCreateTime"`
+	SentAt       *time.Time `gorm:"column:sent_at"`
+}
+
+func (OutboxMessage) TableName() string {
+	return "outbox_messages"
+}
+
+func (m *OutboxMessage) ToEntity() *entity.OutboxMessage {
+	return &entity.OutboxMessage{
+		Id:             m.Id,
+		IdempotencyKey: m.IdempotencyKey,
+		Kind:           m.Kind,
+		Payload:        m.Payload,
+		Status:         entity.OutboxStatus(m.Status),
+		Attempts:       m.Attempts,
+		NextAttemptAt:  m.NextAttemptAt,
+		LastError:      m.LastError,
+		CreatedAt:      m.CreatedAt,
+		SentAt:         m.SentAt,
+	}
+}
+
+func FromOutboxMessageEntity(message *entity.OutboxMessage) *OutboxMessage {
+	return &OutboxMessage{
+		Id:             message.Id,
+		IdempotencyKey: message.IdempotencyKey,
+		Kind:           message.Kind,
+		Payload:        message.Payload,
+		Status:         string(message.Status),
+		Attempts:       message.Attempts,
+		NextAttemptAt:  message.NextAttemptAt,
+		LastError:      message.LastError,
+		CreatedAt:      message.CreatedAt,
+		SentAt:         message.SentAt,
+	}
+}
+
+// Helper functions for conversion
+func ToOutboxMessageEntities(messages []OutboxMessage) []*entity.OutboxMessage {
+	entities := make([]*entity.OutboxMessage, len(messages))
+	for i, m := range messages {
+		entities[i] = m.ToEntity()
+	}
+	return entities
+}
@@ -0,0 +1,247 @@
+package model
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type PayrollRun struct {
+	Id           int        `gorm:"primaryKey;column:id;autoIncrement"`
+	Month        string     `gorm:"column:month;not null;size:7;index"`
+	Status       string     `gorm:"column:status;not null;size:20;default:'DRAFT'"`
+	TotalPayroll int        `gorm:"column:total_payroll;not null;default:0"`
+	CreatedBy    int        `gorm:"column:created_by;not null"`
+	FinalizedBy  *int       `gorm:"column:finalized_by"`
+	FinalizedAt  *time.Time `gorm:"column:finalized_at"`
+	ReopenedBy   *int       `gorm:"column:reopened_by"`
+	ReopenedAt   *time.Time `gorm:"column:reopened_at"`
+	ReopenReason string     `gorm:"column:reopen_reason;size:500"`
+	// OpenMonth is the month while the run is a draft or finalized and NULL once it is reopened;
+	// its unique index keeps concurrent requests from opening two runs for a month
+	OpenMonth *string   `gorm:"column:open_month;size:7;uniqueIndex"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
+
+	// Relations
+	Lines  []PayrollLine     `gorm:"foreignKey:RunId"`
+	Audits []PayrollRunAudit `gorm:"foreignKey:RunId"`
+}
+
+func (PayrollRun) TableName() string {
+	return "payroll_runs"
+}
+
+func (r *PayrollRun) ToEntity() *entity.PayrollRun {
+	return &entity.PayrollRun{
+		Id:           r.Id,
+		Month:        r.Month,
+		Status:       entity.PayrollRunStatus(r.Status),
+		TotalPayroll: r.TotalPayroll,
+		CreatedBy:    r.CreatedBy,
+		FinalizedBy:  r.FinalizedBy,
+		FinalizedAt:  r.FinalizedAt,
+		ReopenedBy:   r.ReopenedBy,
+		ReopenedAt:   r.ReopenedAt,
+		ReopenReason: r.ReopenReason,
+		CreatedAt:    r.CreatedAt,
+		UpdatedAt:    r.UpdatedAt,
+	}
+}
+
+func (r *PayrollRun) FromEntity(run *entity.PayrollRun) {
+	r.Id = run.Id
+	r.Month = run.Month
+	r.Status = string(run.Status)
+	r.TotalPayroll = run.TotalPayroll
+	r.CreatedBy = run.CreatedBy
+	r.FinalizedBy = run.FinalizedBy
+	r.FinalizedAt = run.FinalizedAt
+	r.ReopenedBy = run.ReopenedBy
+	r.ReopenedAt = run.ReopenedAt
+	r.ReopenReason = run.ReopenReason
+	r.OpenMonth = nil
+	if run.Status != entity.PayrollRunStatusReopened {
+		month := run.Month
+		r.OpenMonth = &month
+	}
+}
+
+type PayrollLine struct {
+	Id               int       `gorm:"primaryKey;column:id;autoIncrement"`
+	RunId            int       `gorm:"column:run_id;not null;index"`
+	UserId           int       `gorm:"column:user_id;not null"`
+	UserName         string    `gorm:"column:user_name;not null;size:255"`
+	PayType          string    `gorm:"column:pay_type;not null;size:50"`
+	PayRate          int       `gorm:"column:pay_rate;not null"`
+	TotalHours       float64   `gorm:"column:total_hours;not null;default:0"`
+	OvertimeHours    float64   `gorm:"column:overtime_hours;not null;default:0"`
+	WorkedDays       int       `gorm:"column:worked_days;not null;default:0"`
+	WorkingDays      int       `gorm:"column:working_days;not null;default:0"`
+	AbsenceDays      int       `gorm:"column:absence_days;not null;default:0"`
+	LateEarlyMinutes int       `gorm:"column:late_early_minutes;not null;default:0"`
+	TotalSalary      int       `gorm:"column:total_salary;not null;default:0"`
+	CreatedAt        time.Time `gorm:"column:created_at;autoCreateTime"`
+
+	// Relations
+	Items []PayrollLineItem `gorm:"foreignKey:LineId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (PayrollLine) TableName() string {
+	return "payroll_lines"
+}
+
+func (l *PayrollLine) ToEntity() *entity.PayrollLine {
+	line := &entity.PayrollLine{
+		Id:               l.Id,
+		RunId:            l.RunId,
+		UserId:           l.UserId,
+		UserName:         l.UserName,
+		PayType:          entity.PayType(l.PayType),
+		PayRate:          l.PayRate,
+		TotalHours:       l.TotalHours,
+		OvertimeHours:    l.OvertimeHours,
+		WorkedDays:       l.WorkedDays,
+		WorkingDays:      l.WorkingDays,
+		AbsenceDays:      l.AbsenceDays,
+		LateEarlyMinutes: l.LateEarlyMinutes,
+		TotalSalary:      l.TotalSalary,
+		Items:            make([]*entity.PayrollItem, len(l.Items)),
+		CreatedAt:        l.CreatedAt,
+	}
+	for i, item := range l.Items {
+		line.Items[i] = item.ToEntity()
+	}
+	return line
+}
+
+func (l *PayrollLine) FromEntity(line *entity.PayrollLine) {
+	l.Id = line.Id
+	l.RunId = line.RunId
+	l.UserId = line.UserId
+	l.UserName = line.UserName
+	l.PayType = string(line.PayType)
+	l.PayRate = line.PayRate
+	l.TotalHours = line.TotalHours
+	l.OvertimeHours = line.OvertimeHours
+	l.WorkedDays = line.WorkedDays
+	l.WorkingDays = line.WorkingDays
+	l.AbsenceDays = line.AbsenceDays
+	l.LateEarlyMinutes = line.LateEarlyMinutes
+	l.TotalSalary = line.TotalSalary
+	l.Items = make([]PayrollLineItem, len(line.Items))
+	for i, item := range line.Items {
+		l.Items[i].FromEntity(item)
+	}
+}
+
+type PayrollLineItem struct {
+	Id      int    `gorm:"primaryKey;column:id;autoIncrement"`
+	LineId  int    `gorm:"column:line_id;not null;index"`
+	Code    string `gorm:"column:code;not null;size:50"`
+	Name    string `gorm:"column:name;not null;size:255"`
+	Kind    string `gorm:"column:kind;not null;size:20"`
+	Amount  int    `gorm:"column:amount;not null;default:0"`
+	Taxable bool   `gorm:"column:taxable;not null"`
+}
+
+func (PayrollLineItem) TableName() string {
+	return "payroll_line_items"
+}
+
+func (i *PayrollLineItem) ToEntity() *entity.PayrollItem {
+	return &entity.PayrollItem{
+		Id:      i.Id,
+		LineId:  i.LineId,
+		Code:    i.Code,
+		Name:    i.Name,
+		Kind:    entity.PayrollItemKind(i.Kind),
+		Amount:  i.Amount,
+		Taxable: i.Taxable,
+	}
+}
+
+func (i *PayrollLineItem) FromEntity(item *entity.PayrollItem) {
+	i.Id = item.Id
+	i.LineId = item.LineId
+	i.Code = item.Code
+	i.Name = item.Name
+	i.Kind = string(item.Kind)
+	i.Amount = item.Amount
+	i.Taxable = item.Taxable
+}
+
+type PayrollRunAudit struct {
+	Id        int       `gorm:"primaryKey;column:id;autoIncrement"`
+	RunId     int       `gorm:"column:run_id;not null;index"`
+	Action    string    `gorm:"column:action;not null;size:20"`
+	ActorId   int       `gorm:"column:actor_id;not null"`
+	Reason    string    `gorm:"column:reason;size:500"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (PayrollRunAudit) TableName() string {
+	return "payroll_run_audits"
+}
+
+func (a *PayrollRunAudit) ToEntity() *entity.PayrollRunAudit {
+	return &entity.PayrollRunAudit{
+		Id:        a.Id,
+		RunId:     a.RunId,
+		Action:    entity.PayrollRunAction(a.Action),
+		ActorId:   a.ActorId,
+		Reason:    a.Reason,
+		CreatedAt: a.CreatedAt,
+	}
+}
+
+func (a *PayrollRunAudit) FromEntity(audit *entity.PayrollRunAudit) {
+	a.Id = audit.Id
+	a.RunId = audit.RunId
+	a.Action = string(audit.Action)
+	a.ActorId = audit.ActorId
+	a.Reason = audit.Reason
+}
+
+// Helper functions for conversion
+func ToPayrollRunEntities(runs []PayrollRun) []*entity.PayrollRun {
+	entities := make([]*entity.PayrollRun, len(runs))
+	for i, r := range runs {
+		entities[i] = r.ToEntity()
+	}
+	return entities
+}
+
+func FromPayrollRunEntity(run *entity.PayrollRun) *PayrollRun {
+	r := &PayrollRun{}
+	r.FromEntity(run)
+	return r
+}
+
+func ToPayrollLineEntities(lines []PayrollLine) []*entity.PayrollLine {
+	entities := make([]*entity.PayrollLine, len(lines))
+	for i, l := range lines {
+		entities[i] = l.ToEntity()
+	}
+	return entities
+}
+
+func FromPayrollLineEntity(line *entity.PayrollLine) *PayrollLine {
+	l := &PayrollLine{}
+	l.FromEntity(line)
+	return l
+}
+
+func ToPayrollRunAuditEntities(audits []PayrollRunAudit) []*entity.PayrollRunAudit {
+	entities := make([]*entity.PayrollRunAudit, len(audits))
+	for i, a := range audits {
+		entities[i] = a.ToEntity()
+	}
+	return entities
+}
+
+func FromPayrollRunAuditEntity(audit *entity.PayrollRunAudit) *PayrollRunAudit {
+	a := &PayrollRunAudit{}
+	a.FromEntity(audit)
+	return a
+}
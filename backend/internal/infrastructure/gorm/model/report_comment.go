@@ -0,0 +1,115 @@
+package model
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type ReportComment struct {
+	Id        int       `gorm:"primaryKey;column:id;autoIncrement"`
+	ReportId  int       `gorm:"column:report_id;not null;index"`
+	UserId    int       `gorm:"column:user_id;not null;index"`
+	ParentId  *int      `gorm:"column:parent_id;index"`
+	Body      string    `gorm:"column:body;type:text;not null"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
+
+	// Author name, read from users when the query joins them
+	UserName string `gorm:"->;-:migration;column:user_name"`
+
+	// Relations
+	Report DailyReport    `gorm:"foreignKey:ReportId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	User   User           `gorm:"foreignKey:UserId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Parent *ReportComment `gorm:"foreignKey:ParentId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (ReportComment) TableName() string {
+	return "report_comments"
+}
+
+func (c *ReportComment) ToEntity() *entity.ReportComment {
+	return &entity.ReportComment{
+		Id:        c.Id,
+		ReportId:  c.ReportId,
+		UserId:    c.UserId,
+		ParentId:  c.ParentId,
+		Body:      c.Body,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+		UserName:  c.UserName,
+	}
+}
+
+func (c *ReportComment) FromEntity(comment *entity.ReportComment) {
+	c.Id = comment.Id
+	c.ReportId = comment.ReportId
+	c.UserId = comment.UserId
+	c.ParentId = comment.ParentId
+	c.Body = comment.Body
+}
+
+type ReportReaction struct {
+	Id        int       `gorm:"primaryKey;column:id;autoIncrement"`
+	ReportId  int       `gorm:"column:report_id;not null;uniqueIndex:idx_report_reactions_report_user_emoji,priority:1"`
+	UserId    int       `gorm:"column:user_id;not null;uniqueIndex:idx_report_reactions_report_user_emoji,priority:2"`
+	Emoji     string    `gorm:"column:emoji;not null;size:64;uniqueIndex:idx_report_reactions_report_user_emoji,priority:3"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+
+	UserName string `gorm:"->;-:migration;column:user_name"`
+
+	// Relations
+	Report DailyReport `gorm:"foreignKey:ReportId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	User   User        `gorm:"foreignKey:UserId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (ReportReaction) TableName() string {
+	return "report_reactions"
+}
+
+func (r *ReportReaction) ToEntity() *entity.ReportReaction {
+	return &entity.ReportReaction{
+		Id:        r.Id,
+		ReportId:  r.ReportId,
+		UserId:    r.UserId,
+		Emoji:     r.Emoji,
+		CreatedAt: r.CreatedAt,
+		UserName:  r.UserName,
+	}
+}
+
+func (r *ReportReaction) FromEntity(reaction *entity.ReportReaction) {
+	r.Id = reaction.Id
+	r.ReportId = reaction.ReportId
+	r.UserId = reaction.UserId
+	r.Emoji = reaction.Emoji
+}
+
+// Helper functions for conversion
+func ToReportCommentEntities(comments []ReportComment) []*entity.ReportComment {
+	entities := make([]*entity.ReportComment, len(comments))
+	for i, c := range comments {
+		entities[i] = c.ToEntity()
+	}
+	return entities
+}
+
+func FromReportCommentEntity(comment *entity.ReportComment) *ReportComment {
+	c := &ReportComment{}
+	c.FromEntity(comment)
+	return c
+}
+
+func ToReportReactionEntities(reactions []ReportReaction) []*entity.ReportReaction {
+	entities := make([]*entity.ReportReaction, len(reactions))
+	for i, r := range reactions {
+		entities[i] = r.ToEntity()
+	}
+	return entities
+}
+
+func FromReportReactionEntity(reaction *entity.ReportReaction) *ReportReaction {
+	r := &ReportReaction{}
+	r.FromEntity(reaction)
+	return r
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type ReportRead struct {
+	Id       int       `gorm:"primaryKey;column:id;autoIncrement"`
+	ReportId int       `gorm:"column:report_id;not null;uniqueIndex:idx_report_reads_report_user,priority:1"`
+	UserId   int       `gorm:"column:user_id;not null;uniqueIndex:idx_report_reads_report_user,priority:2;index"`
+	ReadAt   time.Time `gorm:"column:read_at;not null"`
+
+	// Reader details, read from users when the query joins them
+	UserName   string `gorm:"->;-:migration;column:user_name"`
+	Department string `gorm:"->;-:migration;column:department"`
+
+	// Relations
+	Report DailyReport `gorm:"foreignKey:ReportId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	User   User        `gorm:"foreignKey:UserId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (ReportRead) TableName() string {
+	return "report_reads"
+}
+
+func (r *ReportRead) ToEntity() *entity.ReportRead {
+	return &entity.ReportRead{
+		Id:         r.Id,
+		ReportId:   r.ReportId,
+		UserId:     r.UserId,
+		ReadAt:     r.ReadAt,
+		UserName:   r.UserName,
+		Department: r.Department,
+	}
+}
+
+// Helper functions for conversion
+func ToReportReadEntities(reads []ReportRead) []*entity.ReportRead {
+	entities := make([]*entity.ReportRead, len(reads))
+	for i, r := range reads {
+		entities[i] = r.ToEntity()
+	}
+	return entities
+}
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"time"
+)
+
+// ReportTag is a hashtag used in a daily report
+type ReportTag struct {
+	Id       int    `gorm:"primaryKey;column:id;autoIncrement"`
+	ReportId int    `gorm:"column:report_id;not null;uniqueIndex:idx_report_tags_report_tag,priority:1"`
+	Tag      string `gorm:"column:tag;not null;size:50;uniqueIndex:idx_report_tags_report_tag,priority:2;index"`
+
+	// Relations
+	Report DailyReport `gorm:"foreignKey:ReportId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (ReportTag) TableName() string {
+	return "report_tags"
+}
+
+// ReportMention is a user @mentioned in a daily report
+type ReportMention struct {
+	Id       int `gorm:"primaryKey;column:id;autoIncrement"`
+	ReportId int `gorm:"column:report_id;not null;uniqueIndex:idx_report_mentions_report_user,priority:1"`
+	UserId   int `gorm:"column:user_id;not null;uniqueIndex:idx_report_mentions_report_user,priority:2;index"`
+	// NotifiedAt is set once the user has been told about the mention
+	NotifiedAt *time.Time `gorm:"column:notified_at"`
+
+	// Relations
+	Report DailyReport `gorm:"foreignKey:ReportId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	User   User        `gorm:"foreignKey:UserId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (ReportMention) TableName() string {
+	return "report_mentions"
+}
@@ -0,0 +1,70 @@
+package model
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+// TemplateSection is stored as JSON in the sections column
+type TemplateSection struct {
+	Heading  string `json:"heading"`
+	Hint     string `json:"hint,omitempty"`
+	Required bool   `json:"required"`
+}
+
+type ReportTemplate struct {
+	Id          int               `gorm:"primaryKey;column:id;autoIncrement"`
+	Name        string            `gorm:"column:name;not null;size:100"`
+	Description string            `gorm:"column:description;size:500"`
+	Sections    []TemplateSection `gorm:"column:sections;type:json;serializer:json"`
+	Active      bool              `gorm:"column:active;not null"`
+	CreatedAt   time.Time         `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt   time.Time         `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (ReportTemplate) TableName() string {
+	return "report_templates"
+}
+
+func (t *ReportTemplate) ToEntity() *entity.ReportTemplate {
+	sections := make([]entity.TemplateSection, len(t.Sections))
+	for i, s := range t.Sections {
+		sections[i] = entity.TemplateSection{Heading: s.Heading, Hint: s.Hint, Required: s.Required}
+	}
+	return &entity.ReportTemplate{
+		Id:          t.Id,
+		Name:        t.Name,
+		Description: t.Description,
+		Sections:    sections,
+		Active:      t.Active,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+	}
+}
+
+func (t *ReportTemplate) FromEntity(template *entity.ReportTemplate) {
+	t.Id = template.Id
+	t.Name = template.Name
+	t.Description = template.Description
+	t.Sections = make([]TemplateSection, len(template.Sections))
+	for i, s := range template.Sections {
+		t.Sections[i] = TemplateSection{Heading: s.Heading, Hint: s.Hint, Required: s.Required}
+	}
+	t.Active = template.Active
+}
+
+// Helper functions for conversion
+func ToReportTemplateEntities(templates []ReportTemplate) []*entity.ReportTemplate {
+	entities := make([]*entity.ReportTemplate, len(templates))
+	for i, t := range templates {
+		entities[i] = t.ToEntity()
+	}
+	return entities
+}
+
+func FromReportTemplateEntity(template *entity.ReportTemplate) *ReportTemplate {
+	t := &ReportTemplate{}
+	t.FromEntity(template)
+	return t
+}
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type SentReminder struct {
+	Id        int       `gorm:"primaryKey;column:id;autoIncrement"`
+	UserId    int       `gorm:"column:user_id;not null;uniqueIndex:idx_sent_reminders_user_date,priority:1"`
+	Date      time.Time `gorm:"column:date;type:date;not null;uniqueIndex:idx_sent_reminders_user_date,priority:2"`
+	CreatedAt time.Time `gorm:"column:created_at;not null"`
+
+	// Relations
+	User User `gorm:"foreignKey:UserId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (SentReminder) TableName() string {
+	return "sent_reminders"
+}
+
+func (r *SentReminder) ToEntity() *entity.SentReminder {
+	return &entity.SentReminder{
+		Id:        r.Id,
+		UserId:    r.UserId,
+		Date:      r.Date,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+func FromSentReminderEntity(reminder *entity.SentReminder) *SentReminder {
+	return &SentReminder{
+		Id:        reminder.Id,
+		UserId:    reminder.UserId,
+		Date:      reminder.Date,
+		CreatedAt: reminder.CreatedAt,
+	}
+}
@@ -0,0 +1,69 @@
+package model
+
+import (
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type SlackAccount struct {
+	Id          int       `gorm:"primaryKey;column:id;autoIncrement"`
+	UserId      int       `gorm:"column:user_id;not null;uniqueIndex"`
+	TeamId      string    `gorm:"column:team_id;not null;size:50;uniqueIndex:idx_slack_accounts_team_user,priority:1"`
+	SlackUserId string    `gorm:"column:slack_user_id;not null;size:50;uniqueIndex:idx_slack_accounts_team_user,priority:2"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`
+	User        User      `gorm:"foreignKey:UserId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (SlackAccount) TableName() string {
+	return "slack_accounts"
+}
+
+func (a *SlackAccount) ToEntity() *entity.SlackAccount {
+	return &entity.SlackAccount{
+		Id:          a.Id,
+		UserId:      a.UserId,
+		TeamId:      a.TeamId,
+		SlackUserId: a.SlackUserId,
+		CreatedAt:   a.CreatedAt,
+	}
+}
+
+func FromSlackAccountEntity(account *entity.SlackAccount) *SlackAccount {
+	return &SlackAccount{
+		Id:          account.Id,
+		UserId:      account.UserId,
+		TeamId:      account.TeamId,
+		SlackUserId: account.SlackUserId,
+		CreatedAt:   account.CreatedAt,
+	}
+}
+
+type SlackLinkCode struct {
+	Code        string    `gorm:"primaryKey;column:code;size:20"`
+	TeamId      string    `gorm:"column:team_id;not null;size:50"`
+	SlackUserId string    `gorm:"column:slack_user_id;not null;size:50"`
+	ExpiresAt   time.Time `gorm:"column:expires_at;not null;index"`
+}
+
+func (SlackLinkCode) TableName() string {
+	return "slack_link_codes"
+}
+
+func (c *SlackLinkCode) ToEntity() *entity.SlackLinkCode {
+	return &entity.SlackLinkCode{
+		Code:        c.Code,
+		TeamId:      c.TeamId,
+		SlackUserId: c.SlackUserId,
+		ExpiresAt:   c.ExpiresAt,
+	}
+}
+
+func FromSlackLinkCodeEntity(code *entity.SlackLinkCode) *SlackLinkCode {
+	return &SlackLinkCode{
+		Code:        code.Code,
+		TeamId:      code.TeamId,
+		SlackUserId: code.SlackUserId,
+		ExpiresAt:   code.ExpiresAt,
+	}
+}
@@ -7,17 +7,25 @@ import (
 )
 
 type User struct {
-	Id        int          `gorm:"primaryKey;column:id;autoIncrement"`
-	Name      string       `gorm:"column:name;not null;size:255"`
-	Email     string       `gorm:"column:email;not null;size:255"`
-	Password  string       `gorm:"column:password;not null;size:255"`
-	Role      string       `gorm:"column:role;not null;size:50;default:'USER'"`
-	PayType   string       `gorm:"column:pay_type;not null;size:50;default:'HOURLY'"`
-	PayRate   int          `gorm:"column:pay_rate;not null"`
-	Goal      int          `gorm:"column:goal;default:0"`
-	CreatedAt time.Time    `gorm:"column:created_at;autoCreateTime"`
-	UpdatedAt time.Time    `gorm:"column:updated_at;autoUpdateTime"`
-	
+	Id                    int        `gorm:"primaryKey;column:id;autoIncrement"`
+	Name                  string     `gorm:"column:name;not null;size:255"`
+	Email                 string     `gorm:"column:email;not null;size:255"`
+	Password              string     `gorm:"column:password;not null;size:255"`
+	Role                  string     `gorm:"column:role;not null;size:50;default:'USER'"`
+	PayType               string     `gorm:"column:pay_type;not null;size:50;default:'HOURLY'"`
+	PayRate               int        `gorm:"column:pay_rate;not null"`
+	Goal                  int        `gorm:"column:goal;default:0"`
+	Department            string     `gorm:"column:department;size:100;index"`
+	FixedOvertimeHours    int        `gorm:"column:fixed_overtime_hours;not null;default:0"`
+	HireDate              *time.Time `gorm:"column:hire_date;type:date"`
+	TerminationDate       *time.Time `gorm:"column:termination_date;type:date"`
+	ProrationPolicy       string     `gorm:"column:proration_policy;not null;size:50;default:'CALENDAR_DAYS'"`
+	ScheduledStartTime    string     `gorm:"column:scheduled_start_time;size:5"`
+	ScheduledEndTime      string     `gorm:"column:scheduled_end_time;size:5"`
+	ScheduledBreakMinutes int        `gorm:"column:scheduled_break_minutes;not null;default:0"`
+	CreatedAt             time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt             time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+
 	// Relations
 	Attendances []Attendance `gorm:"foreignKey:UserId"`
 }
@@ -28,16 +36,24 @@ func (User) TableName() string {
 
 func (u *User) ToEntity() *entity.User {
 	return &entity.User{
-		Id:        u.Id,
-		Name:      u.Name,
-		Email:     u.Email,
-		Password:  u.Password,
-		Role:      entity.UserRole(u.Role),
-		PayType:   entity.PayType(u.PayType),
-		PayRate:   u.PayRate,
-		Goal:      u.Goal,
-		CreatedAt: u.CreatedAt,
-		UpdatedAt: u.UpdatedAt,
+		Id:                    u.Id,
+		Name:                  u.Name,
+		Email:                 u.Email,
+		Password:              u.Password,
+		Role:                  entity.UserRole(u.Role),
+		PayType:               entity.PayType(u.PayType),
+		PayRate:               u.PayRate,
+		Goal:                  u.Goal,
+		Department:            u.Department,
+		FixedOvertimeHours:    u.FixedOvertimeHours,
+		HireDate:              u.HireDate,
+		TerminationDate:       u.TerminationDate,
+		ProrationPolicy:       entity.ProrationPolicy(u.ProrationPolicy),
+		ScheduledStartTime:    u.ScheduledStartTime,
+		ScheduledEndTime:      u.ScheduledEndTime,
+		ScheduledBreakMinutes: u.ScheduledBreakMinutes,
+		CreatedAt:             u.CreatedAt,
+		UpdatedAt:             u.UpdatedAt,
 	}
 }
 
@@ -50,6 +66,14 @@ func (u *User) FromEntity(user *entity.User) {
 	u.PayType = string(user.PayType)
 	u.PayRate = user.PayRate
 	u.Goal = user.Goal
+	u.Department = user.Department
+	u.FixedOvertimeHours = user.FixedOvertimeHours
+	u.HireDate = user.HireDate
+	u.TerminationDate = user.TerminationDate
+	u.ProrationPolicy = string(user.ProrationPolicy)
+	u.ScheduledStartTime = user.ScheduledStartTime
+	u.ScheduledEndTime = user.ScheduledEndTime
+	u.ScheduledBreakMinutes = user.ScheduledBreakMinutes
 }
 
 // Helper functions for conversion
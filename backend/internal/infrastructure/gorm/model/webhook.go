@@ -0,0 +1,118 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+type WebhookSubscription struct {
+	Id     int    `gorm:"primaryKey;column:id;autoIncrement"`
+	Name   string `gorm:"column:name;not null;size:100"`
+	URL    string `gorm:"column:url;not null;size:1000"`
+	Secret string `gorm:"column:secret;not null;size:255"`
+	// Events is a comma-separated list
+	Events    string    `gorm:"column:events;not null;size:1000"`
+	Enabled   bool      `gorm:"column:enabled;not null"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+type WebhookDelivery struct {
+	Id             int                 `gorm:"primaryKey;column:id;autoIncrement"`
+	SubscriptionId int                 `gorm:"column:subscription_id;not null;index:idx_webhook_deliveries_subscription_created,priority:1"`
+	Subscription   WebhookSubscription `gorm:"foreignKey:SubscriptionId;references:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	EventId        string              `gorm:"column:event_id;not null;size:64;index"`
+	Event          string              `gorm:"column:event;not null;size:50"`
+	StatusCode     int                 `gorm:"column:status_code;not null"`
+	Response       string              `gorm:"column:response;type:text"`
+	Error          string              `gorm:"column:error;type:text"`
+	DurationMs     int                 `gorm:"column:duration_ms;not null"`
+	CreatedAt      time.Time           `gorm:"column:created_at;autoCreateTime;index:idx_webhook_deliveries_subscription_created,priority:2"`
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+func (s *WebhookSubscription) ToEntity() *entity.WebhookSubscription {
+	subscription := &entity.WebhookSubscription{
+		Id:        s.Id,
+		Name:      s.Name,
+		URL:       s.URL,
+		Secret:    s.Secret,
+		Enabled:   s.Enabled,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+	}
+	for _, event := range strings.Split(s.Events, ",") {
+		if event != "" {
+			subscription.Events = append(subscription.Events, entity.WebhookEvent(event))
+		}
+	}
+	return subscription
+}
+
+func FromWebhookSubscriptionEntity(subscription *entity.WebhookSubscription) *WebhookSubscription {
+	events := make([]string, len(subscription.Events))
+	for i, event := range subscription.Events {
+		events[i] = string(event)
+	}
+	return &WebhookSubscription{
+		Id:      subscription.Id,
+		Name:    subscription.Name,
+		URL:     subscription.URL,
+		Secret:  subscription.Secret,
+		Events:  strings.Join(events, ","),
+		Enabled: subscription.Enabled,
+	}
+}
+
+func (d *WebhookDelivery) ToEntity() *entity.WebhookDelivery {
+	return &entity.WebhookDelivery{
+		Id:             d.Id,
+		SubscriptionId: d.SubscriptionId,
+		EventId:        d.EventId,
+		Event:          entity.WebhookEvent(d.Event),
+		StatusCode:     d.StatusCode,
+		Response:       d.Response,
+		Error:          d.Error,
+		DurationMs:     d.DurationMs,
+		CreatedAt:      d.CreatedAt,
+	}
+}
+
+func FromWebhookDeliveryEntity(delivery *entity.WebhookDelivery) *WebhookDelivery {
+	return &WebhookDelivery{
+		Id:             delivery.Id,
+		SubscriptionId: delivery.SubscriptionId,
+		EventId:        delivery.EventId,
+		Event:          string(delivery.Event),
+		StatusCode:     delivery.StatusCode,
+		Response:       delivery.Response,
+		Error:          delivery.Error,
+		DurationMs:     delivery.DurationMs,
+	}
+}
+
+// Helper functions for conversion
+func ToWebhookSubscriptionEntities(subscriptions []WebhookSubscription) []*entity.WebhookSubscription {
+	entities := make([]*entity.WebhookSubscription, len(subscriptions))
+	for i, s := range subscriptions {
+		entities[i] = s.ToEntity()
+	}
+	return entities
+}
+
+func ToWebhookDeliveryEntities(deliveries []WebhookDelivery) []*entity.WebhookDelivery {
+	entities := make([]*entity.WebhookDelivery, len(deliveries))
+	for i, d := range deliveries {
+		entities[i] = d.ToEntity()
+	}
+	return entities
+}
@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/model"
+)
+
+type allowanceRepository struct {
+	db *gorm.DB
+}
+
+func NewAllowanceRepository(db *gorm.DB) repository.AllowanceRepository {
+	return &allowanceRepository{db: db}
+}
+
+func (r *allowanceRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value("tx").(*gorm.DB); ok {
+		return tx
+	}
+	return r.db
+}
+
+func (r *allowanceRepository) FindAll(ctx context.Context) ([]*entity.Allowance, error) {
+	var allowances []model.Allowance
+	if err := r.getDB(ctx).Order("id").Find(&allowances).Error; err != nil {
+		return nil, err
+	}
+	return model.ToAllowanceEntities(allowances), nil
+}
+
+func (r *allowanceRepository) FindById(ctx context.Context, id int) (*entity.Allowance, error) {
+	var allowance model.Allowance
+	if err := r.getDB(ctx).First(&allowance, id).Error; err != nil {
+		return nil, err
+	}
+	return allowance.ToEntity(), nil
+}
+
+func (r *allowanceRepository) Create(ctx context.Context, allowance *entity.Allowance) (*entity.Allowance, error) {
+	allowanceModel := model.FromAllowanceEntity(allowance)
+	if err := r.getDB(ctx).Create(allowanceModel).Error; err != nil {
+		return nil, err
+	}
+	return allowanceModel.ToEntity(), nil
+}
+
+func (r *allowanceRepository) Update(ctx context.Context, allowance *entity.Allowance) (*entity.Allowance, error) {
+	allowanceModel := model.FromAllowanceEntity(allowance)
+	// Use Updates instead of Save to avoid updating created_at
+	if err := r.getDB(ctx).Model(&model.Allowance{}).Where("id = ?", allowanceModel.Id).Updates(map[string]interface{}{
+		"name":           allowanceModel.Name,
+		"taxable":        allowanceModel.Taxable,
+		"default_amount": allowanceModel.DefaultAmount,
+	}).Error; err != nil {
+		return nil, err
+	}
+
+	// Fetch the updated allowance to return
+	return r.FindById(ctx, allowanceModel.Id)
+}
+
+func (r *allowanceRepository) Delete(ctx context.Context, id int) error {
+	return r.getDB(ctx).Delete(&model.Allowance{}, id).Error
+}
+
+func (r *allowanceRepository) FindUserAllowancesByUserId(ctx context.Context, userId int) ([]*entity.UserAllowance, error) {
+	var userAllowances []model.UserAllowance
+	if err := r.getDB(ctx).Preload("Allowance").
+		Where("user_id = ?", userId).
+		Order("effective_from DESC").
+		Find(&userAllowances).Error; err != nil {
+		return nil, err
+	}
+	return model.ToUserAllowanceEntities(userAllowances), nil
+}
+
+func (r *allowanceRepository) FindUserAllowancesByPeriod(ctx context.Context, startDate, endDate time.Time) ([]*entity.UserAllowance, error) {
+	var userAllowances []model.UserAllowance
+	if err := r.getDB(ctx).Preload("Allowance").
+		Where("effective_from <= ? AND (effective_to IS NULL OR effective_to >= ?)", endDate, startDate).
+		Order("user_id, allowance_id").
+		Find(&userAllowances).Error; err != nil {
+		return nil, err
+	}
+	return model.ToUserAllowanceEntities(userAllowances), nil
+}
+
+func (r *allowanceRepository) FindUserAllowanceById(ctx context.Context, id int) (*entity.UserAllowance, error) {
+	var userAllowance model.UserAllowance
+	if err := r.getDB(ctx).Preload("Allowance").First(&userAllowance, id).Error; err != nil {
+		return nil, err
+	}
+	return userAllowance.ToEntity(), nil
+}
+
+func (r *allowanceRepository) CreateUserAllowance(ctx context.Context, userAllowance *entity.UserAllowance) (*entity.UserAllowance, error) {
+	userAllowanceModel := model.FromUserAllowanceEntity(userAllowance)
+	if err := r.getDB(ctx).Omit("User", "Allowance").Create(userAllowanceModel).Error; err != nil {
+		return nil, err
+	}
+	return r.FindUserAllowanceById(ctx, userAllowanceModel.Id)
+}
+
+func (r *allowanceRepository) UpdateUserAllowance(ctx context.Context, userAllowance *entity.UserAllowance) (*entity.UserAllowance, error) {
+	userAllowanceModel := model.FromUserAllowanceEntity(userAllowance)
+	// Use Updates instead of Save to avoid updating created_at
+	if err := r.getDB(ctx).Model(&model.UserAllowance{}).Where("id = ?", userAllowanceModel.Id).Updates(map[string]interface{}{
+		"amount":         userAllowanceModel.Amount,
+		"effective_from": userAllowanceModel.EffectiveFrom,
+		"effective_to":   userAllowanceModel.EffectiveTo,
+	}).Error; err != nil {
+		return nil, err
+	}
+
+	// Fetch the updated assignment to return
+	return r.FindUserAllowanceById(ctx, userAllowanceModel.Id)
+}
+
+func (r *allowanceRepository) DeleteUserAllowance(ctx context.Context, id int) error {
+	return r.getDB(ctx).Delete(&model.UserAllowance{}, id).Error
+}
@@ -52,6 +52,47 @@ func (r *attendanceRepository) FindByDatePeriod(ctx context.Context, userId int,
 	return model.ToAttendanceEntities(attendances), nil
 }
 
+func (r *attendanceRepository) FindAllByDatePeriod(ctx context.Context, startDate, endDate time.Time) ([]*entity.Attendance, error) {
+	var attendances []model.Attendance
+	if err := r.getDB(ctx).
+		Where("date >= ? AND date <= ?", startDate, endDate).
+		Find(&attendances).Error; err != nil {
+		return nil, err
+	}
+	return model.ToAttendanceEntities(attendances), nil
+}
+
+// workingSecondsSQL mirrors usecase.CalculateWorkingHours: duration minus breaks, floored at zero per record
+const workingSecondsSQL = "GREATEST(TIMESTAMPDIFF(SECOND, start_time, end_time) - break_minutes * 60, 0)"
+
+func (r *attendanceRepository) SummarizeByUser(ctx context.Context, startDate, endDate time.Time) ([]*entity.AttendanceSummary, error) {
+	var summaries []model.AttendanceSummary
+	if err := r.getDB(ctx).
+		Model(&model.Attendance{}).
+		Select("user_id, SUM("+workingSecondsSQL+") / 3600 AS total_hours, COUNT(DISTINCT DATE(date)) AS worked_days").
+		Where("date >= ? AND date <= ?", startDate, endDate).
+		Group("user_id").
+		Scan(&summaries).Error; err != nil {
+		return nil, err
+	}
+	return model.ToAttendanceSummaryEntities(summaries), nil
+}
+
+func (r *attendanceRepository) SummarizeByUserAndWeek(ctx context.Context, startDate, endDate time.Time) ([]*entity.AttendanceSummary, error) {
+	var summaries []model.AttendanceSummary
+	// WEEKDAY() is 0 for Monday
+	if err := r.getDB(ctx).
+		Model(&model.Attendance{}).
+		Select("user_id, DATE_SUB(DATE(date), INTERVAL WEEKDAY(date) DAY) AS period_start, SUM("+workingSecondsSQL+") / 3600 AS total_hours, COUNT(DISTINCT DATE(date)) AS worked_days").
+		Where("date >= ? AND date <= ?", startDate, endDate).
+		Group("user_id, period_start").
+		Order("period_start, user_id").
+		Scan(&summaries).Error; err != nil {
+		return nil, err
+	}
+	return model.ToAttendanceSummaryEntities(summaries), nil
+}
+
 func (r *attendanceRepository) FindById(ctx context.Context, id int) (*entity.Attendance, error) {
 	var attendance model.Attendance
 	if err := r.getDB(ctx).First(&attendance, id).Error; err != nil {
@@ -77,7 +118,6 @@ func (r *attendanceRepository) Update(ctx context.Context, attendance *entity.At
 		"start_time":    attendanceModel.StartTime,
 		"end_time":      attendanceModel.EndTime,
 		"break_minutes": attendanceModel.BreakMinutes,
-		"report":        attendanceModel.Report,
 	}).Error; err != nil {
 		return nil, err
 	}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/model"
+)
+
+type bonusRepository struct {
+	db *gorm.DB
+}
+
+func NewBonusRepository(db *gorm.DB) repository.BonusRepository {
+	return &bonusRepository{db: db}
+}
+
+func (r *bonusRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value("tx").(*gorm.DB); ok {
+		return tx
+	}
+	return r.db
+}
+
+func (r *bonusRepository) FindAll(ctx context.Context) ([]*entity.Bonus, error) {
+	var bonuses []model.Bonus
+	if err := r.getDB(ctx).Order("payment_date DESC, id DESC").Find(&bonuses).Error; err != nil {
+		return nil, err
+	}
+	return model.ToBonusEntities(bonuses), nil
+}
+
+func (r *bonusRepository) FindByPeriod(ctx context.Context, startDate, endDate time.Time) ([]*entity.Bonus, error) {
+	var bonuses []model.Bonus
+	if err := r.getDB(ctx).
+		Where("payment_date >= ? AND payment_date <= ?", startDate, endDate).
+		Order("payment_date, id").
+		Find(&bonuses).Error; err != nil {
+		return nil, err
+	}
+	return model.ToBonusEntities(bonuses), nil
+}
+
+func (r *bonusRepository) FindByUserId(ctx context.Context, userId int) ([]*entity.Bonus, error) {
+	var bonuses []model.Bonus
+	if err := r.getDB(ctx).Where("user_id = ?", userId).Order("payment_date DESC").Find(&bonuses).Error; err != nil {
+		return nil, err
+	}
+	return model.ToBonusEntities(bonuses), nil
+}
+
+func (r *bonusRepository) FindById(ctx context.Context, id int) (*entity.Bonus, error) {
+	var bonus model.Bonus
+	if err := r.getDB(ctx).First(&bonus, id).Error; err != nil {
+		return nil, err
+	}
+	return bonus.ToEntity(), nil
+}
+
+func (r *bonusRepository) Create(ctx context.Context, bonus *entity.Bonus) (*entity.Bonus, error) {
+	bonusModel := model.FromBonusEntity(bonus)
+	if err := r.getDB(ctx).Omit("User").Create(bonusModel).Error; err != nil {
+		return nil, err
+	}
+	return bonusModel.ToEntity(), nil
+}
+
+func (r *bonusRepository) Delete(ctx context.Context, id int) error {
+	return r.getDB(ctx).Delete(&model.Bonus{}, id).Error
+}
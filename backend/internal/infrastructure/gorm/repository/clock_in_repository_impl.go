@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/model"
+)
+
+type clockInRepository struct {
+	db *gorm.DB
+}
+
+func NewClockInRepository(db *gorm.DB) repository.ClockInRepository {
+	return &clockInRepository{db: db}
+}
+
+func (r *clockInRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value("tx").(*gorm.DB); ok {
+		return tx
+	}
+	return r.db
+}
+
+func (r *clockInRepository) FindByUser(ctx context.Context, userId int) (*entity.ClockIn, error) {
+	var clockIns []model.ClockIn
+	if err := r.getDB(ctx).Where("user_id = ?", userId).Limit(1).Find(&clockIns).Error; err != nil {
+		return nil, err
+	}
+	if len(clockIns) == 0 {
+		return nil, nil
+	}
+	return clockIns[0].ToEntity(), nil
+}
+
+func (r *clockInRepository) Create(ctx context.Context, clockIn *entity.ClockIn) (*entity.ClockIn, error) {
+	clockInModel := model.FromClockInEntity(clockIn)
+	if err := r.getDB(ctx).Omit("User").Create(clockInModel).Error; err != nil {
+		return nil, err
+	}
+	return clockInModel.ToEntity(), nil
+}
+
+func (r *clockInRepository) Delete(ctx context.Context, id int) error {
+	return r.getDB(ctx).Delete(&model.ClockIn{}, id).Error
+}
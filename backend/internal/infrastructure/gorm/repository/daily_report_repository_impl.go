@@ -0,0 +1,288 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/model"
+)
+
+type dailyReportRepository struct {
+	db *gorm.DB
+}
+
+func NewDailyReportRepository(db *gorm.DB) repository.DailyReportRepository {
+	return &dailyReportRepository{db: db}
+}
+
+func (r *dailyReportRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value("tx").(*gorm.DB); ok {
+		return tx
+	}
+	return r.db
+}
+
+// reportListColumns selects reports together with their author's name and department and
+// the number of comments and reactions; queries using it must join users
+const reportListColumns = "daily_reports.*, users.name AS user_name, users.department, " +
+	"(SELECT COUNT(*) FROM report_comments WHERE report_comments.report_id = daily_reports.id) AS comment_count, " +
+	"(SELECT COUNT(*) FROM report_reactions WHERE report_reactions.report_id = daily_reports.id) AS reaction_count"
+
+// withAuthor selects reports together with their author details and feedback counts
+func (r *dailyReportRepository) withAuthor(ctx context.Context) *gorm.DB {
+	return r.getDB(ctx).
+		Model(&model.DailyReport{}).
+		Select(reportListColumns).
+		Joins("JOIN users ON users.id = daily_reports.user_id")
+}
+
+func (r *dailyReportRepository) FindByFilter(ctx context.Context, filter repository.DailyReportFilter) ([]*entity.DailyReport, int64, error) {
+	var total int64
+	if err := r.filteredQuery(ctx, filter).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		return []*entity.DailyReport{}, 0, nil
+	}
+
+	query := r.filteredQuery(ctx, filter)
+	if filter.ReaderId != 0 {
+		// The reader's own reports count as read
+		query = query.Select(reportListColumns+", (daily_reports.user_id = ? OR EXISTS (SELECT 1 FROM report_reads "+
+			"WHERE report_reads.report_id = daily_reports.id AND report_reads.user_id = ?)) AS is_read",
+			filter.ReaderId, filter.ReaderId)
+	} else {
+		query = query.Select(reportListColumns)
+	}
+
+	var reports []model.DailyReport
+	if err := query.
+		Order("daily_reports.date DESC, daily_reports.id DESC").
+		Offset(filter.Offset).
+		Limit(filter.Limit).
+		Find(&reports).Error; err != nil {
+		return nil, 0, err
+	}
+	return model.ToDailyReportEntities(reports), total, nil
+}
+
+func (r *dailyReportRepository) CountUnread(ctx context.Context, filter repository.DailyReportFilter) (int64, error) {
+	filter.Unread = true
+	var count int64
+	if err := r.filteredQuery(ctx, filter).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// filteredQuery builds a new query for the filter; it is called separately for the count and the page
+func (r *dailyReportRepository) filteredQuery(ctx context.Context, filter repository.DailyReportFilter) *gorm.DB {
+	query := r.getDB(ctx).
+		Model(&model.DailyReport{}).
+		Joins("JOIN users ON users.id = daily_reports.user_id")
+
+	if filter.UserId != 0 {
+		query = query.Where("daily_reports.user_id = ?", filter.UserId)
+	}
+	if filter.Department != "" {
+		query = query.Where("users.department = ?", filter.Department)
+	}
+	if filter.StartDate != nil {
+		query = query.Where("daily_reports.date >= ?", *filter.StartDate)
+	}
+	if filter.EndDate != nil {
+		query = query.Where("daily_reports.date <= ?", *filter.EndDate)
+	}
+	if filter.Keyword != "" {
+		query = query.Where("daily_reports.content LIKE ?", "%"+escapeLike(filter.Keyword)+"%")
+	}
+	if filter.Status != "" {
+		query = query.Where("daily_reports.status = ?", string(filter.Status))
+	}
+	if filter.Viewer != nil {
+		query = visibleTo(query, filter.Viewer)
+	}
+	if filter.Tag != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM report_tags "+
+			"WHERE report_tags.report_id = daily_reports.id AND report_tags.tag = ?)", filter.Tag)
+	}
+	if filter.Unread && filter.ReaderId != 0 {
+		query = query.Where("daily_reports.user_id <> ? AND NOT EXISTS (SELECT 1 FROM report_reads "+
+			"WHERE report_reads.report_id = daily_reports.id AND report_reads.user_id = ?)",
+			filter.ReaderId, filter.ReaderId)
+	}
+	return query
+}
+
+// visibleTo restricts a query over daily_reports joined with their authors as users to the reports
+// the viewer may read; it mirrors entity.DailyReport.VisibleTo
+func visibleTo(query *gorm.DB, viewer *entity.User) *gorm.DB {
+	published := string(entity.ReportStatusPublished)
+	if viewer.IsAdmin() {
+		return query.Where("daily_reports.user_id = ? OR (daily_reports.status = ? AND daily_reports.visibility <> ?)",
+			viewer.Id, published, string(entity.ReportVisibilityPrivate))
+	}
+	return query.Where("daily_reports.user_id = ? OR (daily_reports.status = ? AND (daily_reports.visibility = ? "+
+		"OR (daily_reports.visibility = ? AND users.department <> '' AND users.department = ?)))",
+		viewer.Id, published, string(entity.ReportVisibilityCompany), string(entity.ReportVisibilityDepartment), viewer.Department)
+}
+
+// escapeLike escapes the LIKE wildcards in s so that it matches literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func (r *dailyReportRepository) FindById(ctx context.Context, id int) (*entity.DailyReport, error) {
+	var report model.DailyReport
+	if err := r.withAuthor(ctx).Where("daily_reports.id = ?", id).First(&report).Error; err != nil {
+		return nil, err
+	}
+	return report.ToEntity(), nil
+}
+
+func (r *dailyReportRepository) FindByUserAndDate(ctx context.Context, userId int, date time.Time) (*entity.DailyReport, error) {
+	var reports []model.DailyReport
+	if err := r.getDB(ctx).
+		Where("user_id = ? AND date = ?", userId, date.Format("2006-01-02")).
+		Limit(1).
+		Find(&reports).Error; err != nil {
+		return nil, err
+	}
+	if len(reports) == 0 {
+		return nil, nil
+	}
+	return reports[0].ToEntity(), nil
+}
+
+func (r *dailyReportRepository) FindByAttendanceIds(ctx context.Context, attendanceIds []int) ([]*entity.DailyReport, error) {
+	if len(attendanceIds) == 0 {
+		return []*entity.DailyReport{}, nil
+	}
+	var reports []model.DailyReport
+	// The author's department is needed to check department visibility
+	if err := r.withAuthor(ctx).Where("daily_reports.attendance_id IN ?", attendanceIds).Find(&reports).Error; err != nil {
+		return nil, err
+	}
+	return model.ToDailyReportEntities(reports), nil
+}
+
+func (r *dailyReportRepository) FindByIds(ctx context.Context, ids []int) ([]*entity.DailyReport, error) {
+	if len(ids) == 0 {
+		return []*entity.DailyReport{}, nil
+	}
+	var reports []model.DailyReport
+	if err := r.withAuthor(ctx).Where("daily_reports.id IN ?", ids).Find(&reports).Error; err != nil {
+		return nil, err
+	}
+	return model.ToDailyReportEntities(reports), nil
+}
+
+func (r *dailyReportRepository) FindVisibleIds(ctx context.Context, ids []int, viewer *entity.User) ([]int, error) {
+	if len(ids) == 0 {
+		return []int{}, nil
+	}
+	var visibleIds []int
+	if err := visibleTo(r.getDB(ctx).
+		Model(&model.DailyReport{}).
+		Joins("JOIN users ON users.id = daily_reports.user_id").
+		Where("daily_reports.id IN ?", ids), viewer).
+		Pluck("daily_reports.id", &visibleIds).Error; err != nil {
+		return nil, err
+	}
+
+	visible := make(map[int]bool, len(visibleIds))
+	for _, id := range visibleIds {
+		visible[id] = true
+	}
+	result := make([]int, 0, len(visibleIds))
+	for _, id := range ids {
+		if visible[id] {
+			result = append(result, id)
+		}
+	}
+	return result, nil
+}
+
+func (r *dailyReportRepository) FindAllPublished(ctx context.Context) ([]*entity.DailyReport, error) {
+	var reports []model.DailyReport
+	if err := r.getDB(ctx).Where("status = ?", string(entity.ReportStatusPublished)).Find(&reports).Error; err != nil {
+		return nil, err
+	}
+	return model.ToDailyReportEntities(reports), nil
+}
+
+func (r *dailyReportRepository) FindUpdatedSince(ctx context.Context, since time.Time) ([]*entity.DailyReport, error) {
+	var reports []model.DailyReport
+	if err := r.getDB(ctx).Where("updated_at >= ?", since).Find(&reports).Error; err != nil {
+		return nil, err
+	}
+	return model.ToDailyReportEntities(reports), nil
+}
+
+func (r *dailyReportRepository) FindPublishedIds(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := r.getDB(ctx).
+		Model(&model.DailyReport{}).
+		Where("status = ?", string(entity.ReportStatusPublished)).
+		Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *dailyReportRepository) Create(ctx context.Context, report *entity.DailyReport) (*entity.DailyReport, error) {
+	reportModel := model.FromDailyReportEntity(report)
+	if err := r.getDB(ctx).Omit("User", "Attendance", "Template").Create(reportModel).Error; err != nil {
+		return nil, err
+	}
+	return reportModel.ToEntity(), nil
+}
+
+func (r *dailyReportRepository) Update(ctx context.Context, report *entity.DailyReport) (*entity.DailyReport, error) {
+	reportModel := model.FromDailyReportEntity(report)
+	// Update from the struct rather than a map so that sections go through the JSON serializer;
+	// Select makes the zero values (e.g. a cleared body) count
+	if err := r.getDB(ctx).Model(&model.DailyReport{Id: reportModel.Id}).
+		Select("attendance_id", "template_id", "sections", "body", "content", "status", "visibility", "version", "published_at").
+		Updates(reportModel).Error; err != nil {
+		return nil, err
+	}
+
+	return r.FindById(ctx, reportModel.Id)
+}
+
+func (r *dailyReportRepository) Delete(ctx context.Context, id int) error {
+	return r.getDB(ctx).Delete(&model.DailyReport{}, id).Error
+}
+
+func (r *dailyReportRepository) LinkAttendance(ctx context.Context, userId int, date time.Time, attendanceId int) error {
+	if err := r.getDB(ctx).Model(&model.DailyReport{}).
+		Where("attendance_id = ? AND date <> ?", attendanceId, date.Format("2006-01-02")).
+		Update("attendance_id", nil).Error; err != nil {
+		return err
+	}
+	return r.getDB(ctx).Model(&model.DailyReport{}).
+		Where("user_id = ? AND date = ? AND attendance_id IS NULL", userId, date.Format("2006-01-02")).
+		Update("attendance_id", attendanceId).Error
+}
+
+func (r *dailyReportRepository) CreateRevision(ctx context.Context, revision *entity.DailyReportRevision) (*entity.DailyReportRevision, error) {
+	revisionModel := model.FromDailyReportRevisionEntity(revision)
+	if err := r.getDB(ctx).Omit("Report").Create(revisionModel).Error; err != nil {
+		return nil, err
+	}
+	return revisionModel.ToEntity(), nil
+}
+
+func (r *dailyReportRepository) FindRevisions(ctx context.Context, reportId int) ([]*entity.DailyReportRevision, error) {
+	var revisions []model.DailyReportRevision
+	if err := r.getDB(ctx).Where("report_id = ?", reportId).Order("version DESC").Find(&revisions).Error; err != nil {
+		return nil, err
+	}
+	return model.ToDailyReportRevisionEntities(revisions), nil
+}
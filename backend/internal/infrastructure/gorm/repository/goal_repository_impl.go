@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/model"
+)
+
+type goalRepository struct {
+	db *gorm.DB
+}
+
+func NewGoalRepository(db *gorm.DB) repository.GoalRepository {
+	return &goalRepository{db: db}
+}
+
+func (r *goalRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value("tx").(*gorm.DB); ok {
+		return tx
+	}
+	return r.db
+}
+
+func (r *goalRepository) FindByUserId(ctx context.Context, userId int) ([]*entity.Goal, error) {
+	var goals []model.Goal
+	if err := r.getDB(ctx).Where("user_id = ?", userId).Order("active_from DESC, id DESC").Find(&goals).Error; err != nil {
+		return nil, err
+	}
+	return model.ToGoalEntities(goals), nil
+}
+
+func (r *goalRepository) FindActiveByUserId(ctx context.Context, userId int) ([]*entity.Goal, error) {
+	var goals []model.Goal
+	if err := r.getDB(ctx).Where("user_id = ? AND active_to IS NULL", userId).Order("type, period").Find(&goals).Error; err != nil {
+		return nil, err
+	}
+	return model.ToGoalEntities(goals), nil
+}
+
+func (r *goalRepository) Create(ctx context.Context, goal *entity.Goal) (*entity.Goal, error) {
+	goalModel := model.FromGoalEntity(goal)
+	if err := r.getDB(ctx).Omit("User").Create(goalModel).Error; err != nil {
+		return nil, err
+	}
+	return goalModel.ToEntity(), nil
+}
+
+func (r *goalRepository) Update(ctx context.Context, goal *entity.Goal) (*entity.Goal, error) {
+	goalModel := model.FromGoalEntity(goal)
+	// Only the end of the active range ever changes; targets are immutable history
+	if err := r.getDB(ctx).Model(&model.Goal{}).Where("id = ?", goalModel.Id).Updates(map[string]interface{}{
+		"active_to": goalModel.ActiveTo,
+	}).Error; err != nil {
+		return nil, err
+	}
+
+	var updatedGoal model.Goal
+	if err := r.getDB(ctx).First(&updatedGoal, goalModel.Id).Error; err != nil {
+		return nil, err
+	}
+	return updatedGoal.ToEntity(), nil
+}
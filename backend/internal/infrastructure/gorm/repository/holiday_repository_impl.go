@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/model"
+)
+
+type holidayRepository struct {
+	db *gorm.DB
+}
+
+func NewHolidayRepository(db *gorm.DB) repository.HolidayRepository {
+	return &holidayRepository{db: db}
+}
+
+func (r *holidayRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value("tx").(*gorm.DB); ok {
+		return tx
+	}
+	return r.db
+}
+
+func (r *holidayRepository) FindByPeriod(ctx context.Context, startDate, endDate time.Time) ([]*entity.Holiday, error) {
+	var holidays []model.Holiday
+	if err := r.getDB(ctx).
+		Where("date >= ? AND date <= ?", startDate, endDate).
+		Order("date").
+		Find(&holidays).Error; err != nil {
+		return nil, err
+	}
+	return model.ToHolidayEntities(holidays), nil
+}
+
+func (r *holidayRepository) FindById(ctx context.Context, id int) (*entity.Holiday, error) {
+	var holiday model.Holiday
+	if err := r.getDB(ctx).First(&holiday, id).Error; err != nil {
+		return nil, err
+	}
+	return holiday.ToEntity(), nil
+}
+
+func (r *holidayRepository) Create(ctx context.Context, holiday *entity.Holiday) (*entity.Holiday, error) {
+	holidayModel := model.FromHolidayEntity(holiday)
+	if err := r.getDB(ctx).Create(holidayModel).Error; err != nil {
+		return nil, err
+	}
+	return holidayModel.ToEntity(), nil
+}
+
+func (r *holidayRepository) Delete(ctx context.Context, id int) error {
+	return r.getDB(ctx).Delete(&model.Holiday{}, id).Error
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/model"
+)
+
+type jobLockRepository struct {
+	db *gorm.DB
+}
+
+func NewJobLockRepository(db *gorm.DB) repository.JobLockRepository {
+	return &jobLockRepository{db: db}
+}
+
+func (r *jobLockRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value("tx").(*gorm.DB); ok {
+		return tx
+	}
+	return r.db
+}
+
+func (r *jobLockRepository) Acquire(ctx context.Context, jobName string, owner string, until time.Time) (bool, error) {
+	db := r.getDB(ctx)
+	now := time.Now()
+
+	// Make sure the lock row exists, expired, so that the conditional update below can take it
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&model.JobLock{Name: jobName, LockedUntil: now.Add(-time.Second)}).Error; err != nil {
+		return false, err
+	}
+
+	result := db.Model(&model.JobLock{}).
+		Where("name = ? AND locked_until < ?", jobName, now).
+		Updates(map[string]interface{}{"owner": owner, "locked_until": until})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *jobLockRepository) Release(ctx context.Context, jobName string, owner string) error {
+	return r.getDB(ctx).Model(&model.JobLock{}).
+		Where("name = ? AND owner = ?", jobName, owner).
+		Update("locked_until", time.Now().Add(-time.Second)).Error
+}
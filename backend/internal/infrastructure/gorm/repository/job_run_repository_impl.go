@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/attendance_report_app/backend/internal/domain"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/model"
+)
+
+type jobRunRepository struct {
+	db *gorm.DB
+}
+
+func NewJobRunRepository(db *gorm.DB) repository.JobRunRepository {
+	return &jobRunRepository{db: db}
+}
+
+func (r *jobRunRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value("tx").(*gorm.DB); ok {
+		return tx
+	}
+	return r.db
+}
+
+func (r *jobRunRepository) Create(ctx context.Context, run *entity.JobRun) (*entity.JobRun, error) {
+	runModel := model.FromJobRunEntity(run)
+	// The unique index on (job_name, scheduled_at) decides which instance runs a scheduled minute
+	result := r.getDB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(runModel)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, domain.ErrJobAlreadyRan
+	}
+	return runModel.ToEntity(), nil
+}
+
+func (r *jobRunRepository) Update(ctx context.Context, run *entity.JobRun) (*entity.JobRun, error) {
+	runModel := model.FromJobRunEntity(run)
+	if err := r.getDB(ctx).Model(&model.JobRun{Id: runModel.Id}).
+		Select("status", "output", "error", "finished_at").
+		Updates(runModel).Error; err != nil {
+		return nil, err
+	}
+	return runModel.ToEntity(), nil
+}
+
+func (r *jobRunRepository) FindByJob(ctx context.Context, jobName string, limit int) ([]*entity.JobRun, error) {
+	var runs []model.JobRun
+	if err := r.getDB(ctx).
+		Where("job_name = ?", jobName).
+		Order("started_at DESC, id DESC").
+		Limit(limit).
+		Find(&runs).Error; err != nil {
+		return nil, err
+	}
+	return model.ToJobRunEntities(runs), nil
+}
+
+func (r *jobRunRepository) FindLatest(ctx context.Context) ([]*entity.JobRun, error) {
+	var runs []model.JobRun
+	if err := r.getDB(ctx).
+		Where("id IN (?)", r.getDB(ctx).Model(&model.JobRun{}).Select("MAX(id)").Group("job_name")).
+		Find(&runs).Error; err != nil {
+		return nil, err
+	}
+	return model.ToJobRunEntities(runs), nil
+}
+
+func (r *jobRunRepository) FailRunning(ctx context.Context, jobName string, exceptId int, reason string, now time.Time) error {
+	return r.getDB(ctx).Model(&model.JobRun{}).
+		Where("job_name = ? AND status = ? AND id <> ?", jobName, string(entity.JobRunStatusRunning), exceptId).
+		Updates(map[string]interface{}{
+			"status":      string(entity.JobRunStatusFailed),
+			"error":       reason,
+			"finished_at": now,
+		}).Error
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/model"
+)
+
+type monthlySummaryRepository struct {
+	db *gorm.DB
+}
+
+func NewMonthlySummaryRepository(db *gorm.DB) repository.MonthlySummaryRepository {
+	return &monthlySummaryRepository{db: db}
+}
+
+func (r *monthlySummaryRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value("tx").(*gorm.DB); ok {
+		return tx
+	}
+	return r.db
+}
+
+func (r *monthlySummaryRepository) FindByMonth(ctx context.Context, month string) ([]*entity.MonthlySummary, error) {
+	var summaries []model.MonthlySummary
+	if err := r.getDB(ctx).Where("month = ?", month).Order("user_id").Find(&summaries).Error; err != nil {
+		return nil, err
+	}
+	return model.ToMonthlySummaryEntities(summaries), nil
+}
+
+func (r *monthlySummaryRepository) FindByUserId(ctx context.Context, userId int, from, to string) ([]*entity.MonthlySummary, error) {
+	var summaries []model.MonthlySummary
+	if err := r.getDB(ctx).
+		Where("user_id = ? AND month >= ? AND month <= ?", userId, from, to).
+		Order("month").
+		Find(&summaries).Error; err != nil {
+		return nil, err
+	}
+	return model.ToMonthlySummaryEntities(summaries), nil
+}
+
+func (r *monthlySummaryRepository) Upsert(ctx context.Context, summary *entity.MonthlySummary) error {
+	summaryModel := model.FromMonthlySummaryEntity(summary)
+	return r.getDB(ctx).Omit("User").Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}, {Name: "month"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"total_hours", "regular_hours", "overtime_hours", "holiday_hours",
+			"late_night_hours", "worked_days", "estimated_pay", "calculated_at", "stale",
+		}),
+	}).Create(summaryModel).Error
+}
+
+func (r *monthlySummaryRepository) DeleteByUserAndMonth(ctx context.Context, userId int, month string) error {
+	return r.getDB(ctx).Where("user_id = ? AND month = ?", userId, month).Delete(&model.MonthlySummary{}).Error
+}
+
+func (r *monthlySummaryRepository) FindBuild(ctx context.Context, month string) (*entity.MonthlySummaryBuild, error) {
+	var builds []model.MonthlySummaryBuild
+	if err := r.getDB(ctx).Where("month = ?", month).Limit(1).Find(&builds).Error; err != nil {
+		return nil, err
+	}
+	if len(builds) == 0 {
+		return nil, nil
+	}
+	return builds[0].ToEntity(), nil
+}
+
+func (r *monthlySummaryRepository) UpsertBuild(ctx context.Context, build *entity.MonthlySummaryBuild) error {
+	return r.getDB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "month"}},
+		DoUpdates: clause.AssignmentColumns([]string{"calculated_at", "stale"}),
+	}).Create(model.FromMonthlySummaryBuildEntity(build)).Error
+}
+
+func (r *monthlySummaryRepository) MarkStale(ctx context.Context, userId int, from, to string) error {
+	months := func(query *gorm.DB) *gorm.DB {
+		if from != "" {
+			query = query.Where("month >= ?", from)
+		}
+		if to != "" {
+			query = query.Where("month <= ?", to)
+		}
+		return query
+	}
+
+	summaries := months(r.getDB(ctx).Model(&model.MonthlySummary{}))
+	if userId > 0 {
+		summaries = summaries.Where("user_id = ?", userId)
+	} else {
+		// A bare UPDATE without conditions is refused by gorm
+		summaries = summaries.Where("1 = 1")
+	}
+	if err := summaries.Update("stale", true).Error; err != nil {
+		return err
+	}
+
+	return months(r.getDB(ctx).Model(&model.MonthlySummaryBuild{}).Where("1 = 1")).Update("stale", true).Error
+}
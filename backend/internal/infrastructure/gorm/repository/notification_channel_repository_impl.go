@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/model"
+)
+
+type notificationChannelRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationChannelRepository(db *gorm.DB) repository.NotificationChannelRepository {
+	return &notificationChannelRepository{db: db}
+}
+
+func (r *notificationChannelRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value("tx").(*gorm.DB); ok {
+		return tx
+	}
+	return r.db
+}
+
+func (r *notificationChannelRepository) FindAll(ctx context.Context) ([]*entity.NotificationChannel, error) {
+	var channels []model.NotificationChannel
+	if err := r.getDB(ctx).Order("id").Find(&channels).Error; err != nil {
+		return nil, err
+	}
+	return model.ToNotificationChannelEntities(channels), nil
+}
+
+func (r *notificationChannelRepository) FindById(ctx context.Context, id int) (*entity.NotificationChannel, error) {
+	var channel model.NotificationChannel
+	if err := r.getDB(ctx).First(&channel, id).Error; err != nil {
+		return nil, err
+	}
+	return channel.ToEntity(), nil
+}
+
+func (r *notificationChannelRepository) Create(ctx context.Context, channel *entity.NotificationChannel) (*entity.NotificationChannel, error) {
+	channelModel := model.FromNotificationChannelEntity(channel)
+	if err := r.getDB(ctx).Create(channelModel).Error; err != nil {
+		return nil, err
+	}
+	return channelModel.ToEntity(), nil
+}
+
+func (r *notificationChannelRepository) Update(ctx context.Context, channel *entity.NotificationChannel) (*entity.NotificationChannel, error) {
+	channelModel := model.FromNotificationChannelEntity(channel)
+	if err := r.getDB(ctx).Model(&model.NotificationChannel{Id: channelModel.Id}).
+		Select("name", "type", "target", "enabled").
+		Updates(channelModel).Error; err != nil {
+		return nil, err
+	}
+	return r.FindById(ctx, channelModel.Id)
+}
+
+func (r *notificationChannelRepository) Delete(ctx context.Context, id int) error {
+	db := r.getDB(ctx)
+	if err := db.Where("channel_id = ?", id).Delete(&model.NotificationRoute{}).Error; err != nil {
+		return err
+	}
+	return db.Delete(&model.NotificationChannel{}, id).Error
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/model"
+)
+
+type notificationPreferenceRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationPreferenceRepository(db *gorm.DB) repository.NotificationPreferenceRepository {
+	return &notificationPreferenceRepository{db: db}
+}
+
+func (r *notificationPreferenceRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value("tx").(*gorm.DB); ok {
+		return tx
+	}
+	return r.db
+}
+
+func (r *notificationPreferenceRepository) FindByUser(ctx context.Context, userId int) ([]*entity.NotificationPreference, error) {
+	var preferences []model.NotificationPreference
+	if err := r.getDB(ctx).Where("user_id = ?", userId).Order("event").Find(&preferences).Error; err != nil {
+		return nil, err
+	}
+	return model.ToNotificationPreferenceEntities(preferences), nil
+}
+
+func (r *notificationPreferenceRepository) Find(ctx context.Context, userId int, event entity.NotificationEvent) (*entity.NotificationPreference, error) {
+	var preferences []model.NotificationPreference
+	if err := r.getDB(ctx).
+		Where("user_id = ? AND event = ?", userId, string(event)).
+		Limit(1).
+		Find(&preferences).Error; err != nil {
+		return nil, err
+	}
+	if len(preferences) == 0 {
+		return nil, nil
+	}
+	return preferences[0].ToEntity(), nil
+}
+
+func (r *notificationPreferenceRepository) Upsert(ctx context.Context, preference *entity.NotificationPreference) error {
+	preferenceModel := model.FromNotificationPreferenceEntity(preference)
+	return r.getDB(ctx).Omit("User").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "event"}},
+		DoUpdates: clause.AssignmentColumns([]string{"channel", "opt_out", "quiet_start", "quiet_end", "updated_at"}),
+	}).Create(preferenceModel).Error
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/model"
+)
+
+type notificationRouteRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRouteRepository(db *gorm.DB) repository.NotificationRouteRepository {
+	return &notificationRouteRepository{db: db}
+}
+
+func (r *notificationRouteRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value("tx").(*gorm.DB); ok {
+		return tx
+	}
+	return r.db
+}
+
+func (r *notificationRouteRepository) FindAll(ctx context.Context) ([]*entity.NotificationRoute, error) {
+	var routes []model.NotificationRoute
+	if err := r.getDB(ctx).Order("event, department, channel_id").Find(&routes).Error; err != nil {
+		return nil, err
+	}
+	return model.ToNotificationRouteEntities(routes), nil
+}
+
+func (r *notificationRouteRepository) FindByEvent(ctx context.Context, event entity.NotificationEvent) ([]*entity.NotificationRoute, error) {
+	var routes []model.NotificationRoute
+	if err := r.getDB(ctx).Where("event = ?", string(event)).Order("department, channel_id").Find(&routes).Error; err != nil {
+		return nil, err
+	}
+	return model.ToNotificationRouteEntities(routes), nil
+}
+
+func (r *notificationRouteRepository) ReplaceForEvent(ctx context.Context, event entity.NotificationEvent, routes []*entity.NotificationRoute) error {
+	db := r.getDB(ctx)
+	if err := db.Where("event = ?", string(event)).Delete(&model.NotificationRoute{}).Error; err != nil {
+		return err
+	}
+	if len(routes) == 0 {
+		return nil
+	}
+
+	routeModels := make([]model.NotificationRoute, len(routes))
+	for i, route := range routes {
+		routeModels[i] = model.NotificationRoute{Event: string(event), ChannelId: route.ChannelId, Department: route.Department}
+	}
+	return db.Create(&routeModels).Error
+}
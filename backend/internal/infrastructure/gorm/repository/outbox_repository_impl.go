@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/model"
+)
+
+type outboxRepository struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) repository.OutboxRepository {
+	return &outboxRepository{db: db}
+}
+
+func (r *outboxRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value("tx").(*gorm.DB); ok {
+		return tx
+	}
+	return r.db
+}
+
+func (r *outboxRepository) Enqueue(ctx context.Context, message *entity.OutboxMessage) error {
+	messageModel := model.FromOutboxMessageEntity(message)
+	if err := r.getDB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "idempotency_key"}},
+		DoNothing: true,
+	}).Create(messageModel).Error; err != nil {
+		return err
+	}
+	message.Id = messageModel.Id
+	return nil
+}
+
+func (r *outboxRepository) ClaimDue(ctx context.Context, claimant string, now time.Time, until time.Time, limit int) ([]*entity.OutboxMessage, error) {
+	db := r.getDB(ctx)
+
+	// A single UPDATE claims the messages atomically; gorm cannot put ORDER BY and LIMIT on updates
+	if err := db.Exec("UPDATE outbox_messages SET claimed_by = ?, claimed_until = ? "+
+		"WHERE status = ? AND next_attempt_at <= ? AND (claimed_until IS NULL OR claimed_until < ?) "+
+		"ORDER BY id LIMIT ?",
+		claimant, until, string(entity.OutboxStatusPending), now, now, limit).Error; err != nil {
+		return nil, err
+	}
+
+	var messages []model.OutboxMessage
+	if err := db.
+		Where("claimed_by = ? AND status = ?", claimant, string(entity.OutboxStatusPending)).
+		Order("id").
+		Find(&messages).Error; err != nil {
+		return nil, err
+	}
+	return model.ToOutboxMessageEntities(messages), nil
+}
+
+func (r *outboxRepository) Update(ctx context.Context, message *entity.OutboxMessage) (*entity.OutboxMessage, error) {
+	messageModel := model.FromOutboxMessageEntity(message)
+	if err := r.getDB(ctx).Model(&model.OutboxMessage{Id: messageModel.Id}).
+		Select("status", "attempts", "next_attempt_at", "last_error", "sent_at", "claimed_by", "claimed_until").
+		Updates(messageModel).Error; err != nil {
+		return nil, err
+	}
+	return r.FindById(ctx, messageModel.Id)
+}
+
+func (r *outboxRepository) FindById(ctx context.Context, id int) (*entity.OutboxMessage, error) {
+	var message model.OutboxMessage
+	if err := r.getDB(ctx).First(&message, id).Error; err != nil {
+		return nil, err
+	}
+	return message.ToEntity(), nil
+}
+
+func (r *outboxRepository) FindByStatus(ctx context.Context, status entity.OutboxStatus, offset, limit int) ([]*entity.OutboxMessage, int64, error) {
+	var total int64
+	if err := r.getDB(ctx).Model(&model.OutboxMessage{}).Where("status = ?", string(status)).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var messages []model.OutboxMessage
+	if err := r.getDB(ctx).
+		Where("status = ?", string(status)).
+		Order("id DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&messages).Error; err != nil {
+		return nil, 0, err
+	}
+	return model.ToOutboxMessageEntities(messages), total, nil
+}
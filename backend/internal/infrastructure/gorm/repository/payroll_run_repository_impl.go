@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/attendance_report_app/backend/internal/domain"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/model"
+)
+
+type payrollRunRepository struct {
+	db *gorm.DB
+}
+
+func NewPayrollRunRepository(db *gorm.DB) repository.PayrollRunRepository {
+	return &payrollRunRepository{db: db}
+}
+
+func (r *payrollRunRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value("tx").(*gorm.DB); ok {
+		return tx
+	}
+	return r.db
+}
+
+func (r *payrollRunRepository) FindAll(ctx context.Context) ([]*entity.PayrollRun, error) {
+	var runs []model.PayrollRun
+	if err := r.getDB(ctx).Order("month DESC, id DESC").Find(&runs).Error; err != nil {
+		return nil, err
+	}
+	return model.ToPayrollRunEntities(runs), nil
+}
+
+func (r *payrollRunRepository) FindById(ctx context.Context, id int) (*entity.PayrollRun, error) {
+	var run model.PayrollRun
+	if err := r.getDB(ctx).First(&run, id).Error; err != nil {
+		return nil, err
+	}
+	return run.ToEntity(), nil
+}
+
+func (r *payrollRunRepository) FindByIdForUpdate(ctx context.Context, id int) (*entity.PayrollRun, error) {
+	var run model.PayrollRun
+	if err := r.getDB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).First(&run, id).Error; err != nil {
+		return nil, err
+	}
+	return run.ToEntity(), nil
+}
+
+func (r *payrollRunRepository) FindByMonth(ctx context.Context, month string) ([]*entity.PayrollRun, error) {
+	var runs []model.PayrollRun
+	if err := r.getDB(ctx).Where("month = ?", month).Order("id DESC").Find(&runs).Error; err != nil {
+		return nil, err
+	}
+	return model.ToPayrollRunEntities(runs), nil
+}
+
+func (r *payrollRunRepository) FindByMonthForShare(ctx context.Context, month string) ([]*entity.PayrollRun, error) {
+	var runs []model.PayrollRun
+	if err := r.getDB(ctx).Clauses(clause.Locking{Strength: "SHARE"}).Where("month = ?", month).Order("id DESC").Find(&runs).Error; err != nil {
+		return nil, err
+	}
+	return model.ToPayrollRunEntities(runs), nil
+}
+
+func (r *payrollRunRepository) Create(ctx context.Context, run *entity.PayrollRun) (*entity.PayrollRun, error) {
+	runModel := model.FromPayrollRunEntity(run)
+	// The unique index on open_month rejects a second open run for the month
+	result := r.getDB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(runModel)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, domain.ErrPayrollRunExists
+	}
+	return runModel.ToEntity(), nil
+}
+
+func (r *payrollRunRepository) Update(ctx context.Context, run *entity.PayrollRun) (*entity.PayrollRun, error) {
+	runModel := model.FromPayrollRunEntity(run)
+	// Use Updates instead of Save to avoid updating created_at
+	if err := r.getDB(ctx).Model(&model.PayrollRun{}).Where("id = ?", runModel.Id).Updates(map[string]interface{}{
+		"status":        runModel.Status,
+		"total_payroll": runModel.TotalPayroll,
+		"finalized_by":  runModel.FinalizedBy,
+		"finalized_at":  runModel.FinalizedAt,
+		"reopened_by":   runModel.ReopenedBy,
+		"reopened_at":   runModel.ReopenedAt,
+		"reopen_reason": runModel.ReopenReason,
+		"open_month":    runModel.OpenMonth,
+	}).Error; err != nil {
+		return nil, err
+	}
+
+	// Fetch the updated run to return
+	var updatedRun model.PayrollRun
+	if err := r.getDB(ctx).First(&updatedRun, runModel.Id).Error; err != nil {
+		return nil, err
+	}
+	return updatedRun.ToEntity(), nil
+}
+
+func (r *payrollRunRepository) CreateLines(ctx context.Context, lines []*entity.PayrollLine) error {
+	if len(lines) == 0 {
+		return nil
+	}
+
+	lineModels := make([]*model.PayrollLine, len(lines))
+	for i, line := range lines {
+		lineModels[i] = model.FromPayrollLineEntity(line)
+	}
+	// Line items are inserted together with their lines
+	return r.getDB(ctx).Create(&lineModels).Error
+}
+
+func (r *payrollRunRepository) FindLinesByRunId(ctx context.Context, runId int) ([]*entity.PayrollLine, error) {
+	var lines []model.PayrollLine
+	if err := r.getDB(ctx).Preload("Items").Where("run_id = ?", runId).Order("id").Find(&lines).Error; err != nil {
+		return nil, err
+	}
+	return model.ToPayrollLineEntities(lines), nil
+}
+
+func (r *payrollRunRepository) CreateAudit(ctx context.Context, audit *entity.PayrollRunAudit) error {
+	return r.getDB(ctx).Create(model.FromPayrollRunAuditEntity(audit)).Error
+}
+
+func (r *payrollRunRepository) FindAuditsByRunId(ctx context.Context, runId int) ([]*entity.PayrollRunAudit, error) {
+	var audits []model.PayrollRunAudit
+	if err := r.getDB(ctx).Where("run_id = ?", runId).Order("id").Find(&audits).Error; err != nil {
+		return nil, err
+	}
+	return model.ToPayrollRunAuditEntities(audits), nil
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/model"
+)
+
+type reportCommentRepository struct {
+	db *gorm.DB
+}
+
+func NewReportCommentRepository(db *gorm.DB) repository.ReportCommentRepository {
+	return &reportCommentRepository{db: db}
+}
+
+func (r *reportCommentRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value("tx").(*gorm.DB); ok {
+		return tx
+	}
+	return r.db
+}
+
+// withAuthor selects comments together with their author's name
+func (r *reportCommentRepository) withAuthor(ctx context.Context) *gorm.DB {
+	return r.getDB(ctx).
+		Model(&model.ReportComment{}).
+		Select("report_comments.*, users.name AS user_name").
+		Joins("JOIN users ON users.id = report_comments.user_id")
+}
+
+func (r *reportCommentRepository) FindByReportId(ctx context.Context, reportId int) ([]*entity.ReportComment, error) {
+	var comments []model.ReportComment
+	if err := r.withAuthor(ctx).
+		Where("report_comments.report_id = ?", reportId).
+		Order("report_comments.created_at, report_comments.id").
+		Find(&comments).Error; err != nil {
+		return nil, err
+	}
+	return model.ToReportCommentEntities(comments), nil
+}
+
+func (r *reportCommentRepository) FindById(ctx context.Context, id int) (*entity.ReportComment, error) {
+	var comment model.ReportComment
+	if err := r.withAuthor(ctx).Where("report_comments.id = ?", id).First(&comment).Error; err != nil {
+		return nil, err
+	}
+	return comment.ToEntity(), nil
+}
+
+func (r *reportCommentRepository) Create(ctx context.Context, comment *entity.ReportComment) (*entity.ReportComment, error) {
+	commentModel := model.FromReportCommentEntity(comment)
+	if err := r.getDB(ctx).Omit("Report", "User", "Parent").Create(commentModel).Error; err != nil {
+		return nil, err
+	}
+	return r.FindById(ctx, commentModel.Id)
+}
+
+func (r *reportCommentRepository) Update(ctx context.Context, comment *entity.ReportComment) (*entity.ReportComment, error) {
+	updates := map[string]interface{}{
+		"body": comment.Body,
+	}
+	if err := r.getDB(ctx).Model(&model.ReportComment{}).Where("id = ?", comment.Id).Updates(updates).Error; err != nil {
+		return nil, err
+	}
+	return r.FindById(ctx, comment.Id)
+}
+
+func (r *reportCommentRepository) Delete(ctx context.Context, id int) error {
+	return r.getDB(ctx).Delete(&model.ReportComment{}, id).Error
+}
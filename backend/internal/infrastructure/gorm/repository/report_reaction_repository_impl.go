@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/model"
+)
+
+type reportReactionRepository struct {
+	db *gorm.DB
+}
+
+func NewReportReactionRepository(db *gorm.DB) repository.ReportReactionRepository {
+	return &reportReactionRepository{db: db}
+}
+
+func (r *reportReactionRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value("tx").(*gorm.DB); ok {
+		return tx
+	}
+	return r.db
+}
+
+func (r *reportReactionRepository) FindByReportId(ctx context.Context, reportId int) ([]*entity.ReportReaction, error) {
+	var reactions []model.ReportReaction
+	if err := r.getDB(ctx).
+		Model(&model.ReportReaction{}).
+		Select("report_reactions.*, users.name AS user_name").
+		Joins("JOIN users ON users.id = report_reactions.user_id").
+		Where("report_reactions.report_id = ?", reportId).
+		Order("report_reactions.created_at, report_reactions.id").
+		Find(&reactions).Error; err != nil {
+		return nil, err
+	}
+	return model.ToReportReactionEntities(reactions), nil
+}
+
+func (r *reportReactionRepository) Find(ctx context.Context, reportId, userId int, emoji string) (*entity.ReportReaction, error) {
+	var reactions []model.ReportReaction
+	if err := r.getDB(ctx).
+		Where("report_id = ? AND user_id = ? AND emoji = ?", reportId, userId, emoji).
+		Limit(1).
+		Find(&reactions).Error; err != nil {
+		return nil, err
+	}
+	if len(reactions) == 0 {
+		return nil, nil
+	}
+	return reactions[0].ToEntity(), nil
+}
+
+func (r *reportReactionRepository) Create(ctx context.Context, reaction *entity.ReportReaction) (*entity.ReportReaction, error) {
+	reactionModel := model.FromReportReactionEntity(reaction)
+	if err := r.getDB(ctx).Omit("Report", "User").Create(reactionModel).Error; err != nil {
+		return nil, err
+	}
+	return reactionModel.ToEntity(), nil
+}
+
+func (r *reportReactionRepository) Delete(ctx context.Context, id int) error {
+	return r.getDB(ctx).Delete(&model.ReportReaction{}, id).Error
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/model"
+)
+
+type reportReadRepository struct {
+	db *gorm.DB
+}
+
+func NewReportReadRepository(db *gorm.DB) repository.ReportReadRepository {
+	return &reportReadRepository{db: db}
+}
+
+func (r *reportReadRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value("tx").(*gorm.DB); ok {
+		return tx
+	}
+	return r.db
+}
+
+func (r *reportReadRepository) MarkRead(ctx context.Context, reportId, userId int, readAt time.Time) error {
+	return r.getDB(ctx).Omit("Report", "User").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "report_id"}, {Name: "user_id"}},
+		DoNothing: true,
+	}).Create(&model.ReportRead{ReportId: reportId, UserId: userId, ReadAt: readAt}).Error
+}
+
+func (r *reportReadRepository) MarkAllRead(ctx context.Context, viewer *entity.User, readAt time.Time) (int64, error) {
+	db := r.getDB(ctx)
+	unread := visibleTo(db.
+		Model(&model.DailyReport{}).
+		Select("daily_reports.id, ?, ?", viewer.Id, readAt).
+		Joins("JOIN users ON users.id = daily_reports.user_id").
+		Where("daily_reports.status = ? AND daily_reports.user_id <> ?", string(entity.ReportStatusPublished), viewer.Id), viewer)
+
+	result := db.Exec("INSERT IGNORE INTO report_reads (report_id, user_id, read_at) ?", unread)
+	return result.RowsAffected, result.Error
+}
+
+func (r *reportReadRepository) FindByReportId(ctx context.Context, reportId int) ([]*entity.ReportRead, error) {
+	var reads []model.ReportRead
+	if err := r.getDB(ctx).
+		Model(&model.ReportRead{}).
+		Select("report_reads.*, users.name AS user_name, users.department").
+		Joins("JOIN users ON users.id = report_reads.user_id").
+		Where("report_reads.report_id = ?", reportId).
+		Order("report_reads.read_at, report_reads.id").
+		Find(&reads).Error; err != nil {
+		return nil, err
+	}
+	return model.ToReportReadEntities(reads), nil
+}
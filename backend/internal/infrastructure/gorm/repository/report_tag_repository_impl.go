@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/model"
+)
+
+type reportTagRepository struct {
+	db *gorm.DB
+}
+
+func NewReportTagRepository(db *gorm.DB) repository.ReportTagRepository {
+	return &reportTagRepository{db: db}
+}
+
+func (r *reportTagRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value("tx").(*gorm.DB); ok {
+		return tx
+	}
+	return r.db
+}
+
+func (r *reportTagRepository) ReplaceTags(ctx context.Context, reportId int, tags []string) error {
+	db := r.getDB(ctx)
+	if err := db.Where("report_id = ?", reportId).Delete(&model.ReportTag{}).Error; err != nil {
+		return err
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+
+	rows := make([]model.ReportTag, len(tags))
+	for i, tag := range tags {
+		rows[i] = model.ReportTag{ReportId: reportId, Tag: tag}
+	}
+	return db.Omit("Report").Create(&rows).Error
+}
+
+func (r *reportTagRepository) ReplaceMentions(ctx context.Context, reportId int, userIds []int) error {
+	db := r.getDB(ctx)
+
+	remove := db.Where("report_id = ?", reportId)
+	if len(userIds) > 0 {
+		remove = remove.Where("user_id NOT IN ?", userIds)
+	}
+	if err := remove.Delete(&model.ReportMention{}).Error; err != nil {
+		return err
+	}
+	if len(userIds) == 0 {
+		return nil
+	}
+
+	var existing []int
+	if err := db.Model(&model.ReportMention{}).
+		Where("report_id = ?", reportId).
+		Pluck("user_id", &existing).Error; err != nil {
+		return err
+	}
+	known := make(map[int]bool, len(existing))
+	for _, id := range existing {
+		known[id] = true
+	}
+
+	var rows []model.ReportMention
+	for _, id := range userIds {
+		if !known[id] {
+			rows = append(rows, model.ReportMention{ReportId: reportId, UserId: id})
+		}
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	return db.Omit("Report", "User").Create(&rows).Error
+}
+
+func (r *reportTagRepository) FindUnnotifiedMentions(ctx context.Context, reportId int) ([]int, error) {
+	var userIds []int
+	if err := r.getDB(ctx).Model(&model.ReportMention{}).
+		Where("report_id = ? AND notified_at IS NULL", reportId).
+		Order("id").
+		Pluck("user_id", &userIds).Error; err != nil {
+		return nil, err
+	}
+	return userIds, nil
+}
+
+func (r *reportTagRepository) MarkMentionsNotified(ctx context.Context, reportId int, userIds []int, notifiedAt time.Time) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+	return r.getDB(ctx).Model(&model.ReportMention{}).
+		Where("report_id = ? AND user_id IN ?", reportId, userIds).
+		Update("notified_at", notifiedAt).Error
+}
+
+func (r *reportTagRepository) CountTags(ctx context.Context, filter repository.TagCountFilter) ([]*entity.TagCount, error) {
+	query := r.getDB(ctx).
+		Model(&model.ReportTag{}).
+		Select("report_tags.tag, COUNT(*) AS count").
+		Joins("JOIN daily_reports ON daily_reports.id = report_tags.report_id").
+		Joins("JOIN users ON users.id = daily_reports.user_id").
+		Where("daily_reports.status = ?", string(entity.ReportStatusPublished))
+
+	if filter.StartDate != nil {
+		query = query.Where("daily_reports.date >= ?", *filter.StartDate)
+	}
+	if filter.EndDate != nil {
+		query = query.Where("daily_reports.date <= ?", *filter.EndDate)
+	}
+	if filter.Department != "" {
+		query = query.Where("users.department = ?", filter.Department)
+	}
+	if filter.Viewer != nil {
+		query = visibleTo(query, filter.Viewer)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	var rows []struct {
+		Tag   string
+		Count int
+	}
+	if err := query.Group("report_tags.tag").Order("count DESC, report_tags.tag").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	counts := make([]*entity.TagCount, len(rows))
+	for i, row := range rows {
+		counts[i] = &entity.TagCount{Tag: row.Tag, Count: row.Count}
+	}
+	return counts, nil
+}
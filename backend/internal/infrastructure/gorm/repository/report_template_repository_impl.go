@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/model"
+)
+
+type reportTemplateRepository struct {
+	db *gorm.DB
+}
+
+func NewReportTemplateRepository(db *gorm.DB) repository.ReportTemplateRepository {
+	return &reportTemplateRepository{db: db}
+}
+
+func (r *reportTemplateRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value("tx").(*gorm.DB); ok {
+		return tx
+	}
+	return r.db
+}
+
+func (r *reportTemplateRepository) FindAll(ctx context.Context) ([]*entity.ReportTemplate, error) {
+	var templates []model.ReportTemplate
+	if err := r.getDB(ctx).Order("id").Find(&templates).Error; err != nil {
+		return nil, err
+	}
+	return model.ToReportTemplateEntities(templates), nil
+}
+
+func (r *reportTemplateRepository) FindById(ctx context.Context, id int) (*entity.ReportTemplate, error) {
+	var template model.ReportTemplate
+	if err := r.getDB(ctx).First(&template, id).Error; err != nil {
+		return nil, err
+	}
+	return template.ToEntity(), nil
+}
+
+func (r *reportTemplateRepository) Create(ctx context.Context, template *entity.ReportTemplate) (*entity.ReportTemplate, error) {
+	templateModel := model.FromReportTemplateEntity(template)
+	if err := r.getDB(ctx).Create(templateModel).Error; err != nil {
+		return nil, err
+	}
+	return templateModel.ToEntity(), nil
+}
+
+func (r *reportTemplateRepository) Update(ctx context.Context, template *entity.ReportTemplate) (*entity.ReportTemplate, error) {
+	templateModel := model.FromReportTemplateEntity(template)
+	// Update from the struct so that sections go through the JSON serializer
+	if err := r.getDB(ctx).Model(&model.ReportTemplate{Id: templateModel.Id}).
+		Select("name", "description", "sections", "active").
+		Updates(templateModel).Error; err != nil {
+		return nil, err
+	}
+
+	return r.FindById(ctx, templateModel.Id)
+}
+
+func (r *reportTemplateRepository) Delete(ctx context.Context, id int) error {
+	return r.getDB(ctx).Delete(&model.ReportTemplate{}, id).Error
+}
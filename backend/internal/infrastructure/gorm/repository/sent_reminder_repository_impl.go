@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/attendance_report_app/backend/internal/domain"
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/model"
+)
+
+type sentReminderRepository struct {
+	db *gorm.DB
+}
+
+func NewSentReminderRepository(db *gorm.DB) repository.SentReminderRepository {
+	return &sentReminderRepository{db: db}
+}
+
+func (r *sentReminderRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value("tx").(*gorm.DB); ok {
+		return tx
+	}
+	return r.db
+}
+
+func (r *sentReminderRepository) Create(ctx context.Context, reminder *entity.SentReminder) error {
+	// The unique index on (user_id, date) decides which run reminds the user
+	result := r.getDB(ctx).Omit("User").Clauses(clause.OnConflict{DoNothing: true}).Create(model.FromSentReminderEntity(reminder))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrReminderAlreadySent
+	}
+	return nil
+}
+
+func (r *sentReminderRepository) Delete(ctx context.Context, userId int, date time.Time) error {
+	return r.getDB(ctx).Where("user_id = ? AND date = ?", userId, date.Format("2006-01-02")).Delete(&model.SentReminder{}).Error
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/model"
+)
+
+type slackAccountRepository struct {
+	db *gorm.DB
+}
+
+func NewSlackAccountRepository(db *gorm.DB) repository.SlackAccountRepository {
+	return &slackAccountRepository{db: db}
+}
+
+func (r *slackAccountRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value("tx").(*gorm.DB); ok {
+		return tx
+	}
+	return r.db
+}
+
+func (r *slackAccountRepository) FindBySlackUser(ctx context.Context, teamId, slackUserId string) (*entity.SlackAccount, error) {
+	return r.findOne(r.getDB(ctx).Where("team_id = ? AND slack_user_id = ?", teamId, slackUserId))
+}
+
+func (r *slackAccountRepository) FindByUser(ctx context.Context, userId int) (*entity.SlackAccount, error) {
+	return r.findOne(r.getDB(ctx).Where("user_id = ?", userId))
+}
+
+func (r *slackAccountRepository) findOne(query *gorm.DB) (*entity.SlackAccount, error) {
+	var accounts []model.SlackAccount
+	if err := query.Limit(1).Find(&accounts).Error; err != nil {
+		return nil, err
+	}
+	if len(accounts) == 0 {
+		return nil, nil
+	}
+	return accounts[0].ToEntity(), nil
+}
+
+func (r *slackAccountRepository) Link(ctx context.Context, account *entity.SlackAccount) (*entity.SlackAccount, error) {
+	db := r.getDB(ctx)
+	// A user has one Slack account and a Slack user acts as one user, so older links on either side go
+	if err := db.Where("user_id = ? OR (team_id = ? AND slack_user_id = ?)", account.UserId, account.TeamId, account.SlackUserId).
+		Delete(&model.SlackAccount{}).Error; err != nil {
+		return nil, err
+	}
+
+	accountModel := model.FromSlackAccountEntity(account)
+	if err := db.Omit("User").Create(accountModel).Error; err != nil {
+		return nil, err
+	}
+	return accountModel.ToEntity(), nil
+}
+
+func (r *slackAccountRepository) DeleteByUser(ctx context.Context, userId int) error {
+	return r.getDB(ctx).Where("user_id = ?", userId).Delete(&model.SlackAccount{}).Error
+}
+
+func (r *slackAccountRepository) CreateLinkCode(ctx context.Context, code *entity.SlackLinkCode) error {
+	db := r.getDB(ctx)
+	// Codes are single-use and short-lived; expired ones are cleaned up whenever a new one is issued
+	if err := db.Where("expires_at < ? OR (team_id = ? AND slack_user_id = ?)", code.ExpiresAt.Add(-entity.SlackLinkCodeTTL), code.TeamId, code.SlackUserId).
+		Delete(&model.SlackLinkCode{}).Error; err != nil {
+		return err
+	}
+	return db.Create(model.FromSlackLinkCodeEntity(code)).Error
+}
+
+func (r *slackAccountRepository) TakeLinkCode(ctx context.Context, code string) (*entity.SlackLinkCode, error) {
+	db := r.getDB(ctx)
+	var codes []model.SlackLinkCode
+	if err := db.Where("code = ?", code).Limit(1).Find(&codes).Error; err != nil {
+		return nil, err
+	}
+	if len(codes) == 0 {
+		return nil, nil
+	}
+	// Only the request that deletes the code may use it
+	result := db.Where("code = ?", code).Delete(&model.SlackLinkCode{})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+	return codes[0].ToEntity(), nil
+}
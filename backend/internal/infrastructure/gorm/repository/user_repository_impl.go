@@ -61,17 +61,25 @@ func (r *userRepository) Update(ctx context.Context, user *entity.User) (*entity
 	userModel := model.FromUserEntity(user)
 	// Use Updates instead of Save to avoid updating created_at
 	if err := r.getDB(ctx).Model(&model.User{}).Where("id = ?", userModel.Id).Updates(map[string]interface{}{
-		"name":      userModel.Name,
-		"email":     userModel.Email,
-		"password":  userModel.Password,
-		"role":      userModel.Role,
-		"pay_type":  userModel.PayType,
-		"pay_rate":  userModel.PayRate,
-		"goal":      userModel.Goal,
+		"name":                    userModel.Name,
+		"email":                   userModel.Email,
+		"password":                userModel.Password,
+		"role":                    userModel.Role,
+		"pay_type":                userModel.PayType,
+		"pay_rate":                userModel.PayRate,
+		"goal":                    userModel.Goal,
+		"department":              userModel.Department,
+		"fixed_overtime_hours":    userModel.FixedOvertimeHours,
+		"hire_date":               userModel.HireDate,
+		"termination_date":        userModel.TerminationDate,
+		"proration_policy":        userModel.ProrationPolicy,
+		"scheduled_start_time":    userModel.ScheduledStartTime,
+		"scheduled_end_time":      userModel.ScheduledEndTime,
+		"scheduled_break_minutes": userModel.ScheduledBreakMinutes,
 	}).Error; err != nil {
 		return nil, err
 	}
-	
+
 	// Fetch the updated user to return
 	var updatedUser model.User
 	if err := r.getDB(ctx).First(&updatedUser, userModel.Id).Error; err != nil {
@@ -82,4 +90,4 @@ func (r *userRepository) Update(ctx context.Context, user *entity.User) (*entity
 
 func (r *userRepository) Delete(ctx context.Context, id int) error {
 	return r.getDB(ctx).Delete(&model.User{}, id).Error
-}
\ No newline at end of file
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/model"
+)
+
+type webhookDeliveryRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookDeliveryRepository(db *gorm.DB) repository.WebhookDeliveryRepository {
+	return &webhookDeliveryRepository{db: db}
+}
+
+func (r *webhookDeliveryRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value("tx").(*gorm.DB); ok {
+		return tx
+	}
+	return r.db
+}
+
+func (r *webhookDeliveryRepository) Create(ctx context.Context, delivery *entity.WebhookDelivery) (*entity.WebhookDelivery, error) {
+	deliveryModel := model.FromWebhookDeliveryEntity(delivery)
+	if err := r.getDB(ctx).Omit("Subscription").Create(deliveryModel).Error; err != nil {
+		return nil, err
+	}
+	return deliveryModel.ToEntity(), nil
+}
+
+func (r *webhookDeliveryRepository) FindBySubscription(ctx context.Context, subscriptionId int, offset, limit int) ([]*entity.WebhookDelivery, int64, error) {
+	var total int64
+	if err := r.getDB(ctx).Model(&model.WebhookDelivery{}).Where("subscription_id = ?", subscriptionId).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var deliveries []model.WebhookDelivery
+	if err := r.getDB(ctx).
+		Where("subscription_id = ?", subscriptionId).
+		Order("id DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&deliveries).Error; err != nil {
+		return nil, 0, err
+	}
+	return model.ToWebhookDeliveryEntities(deliveries), total, nil
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+	"github.com/attendance_report_app/backend/internal/domain/repository"
+	"github.com/attendance_report_app/backend/internal/infrastructure/gorm/model"
+)
+
+type webhookSubscriptionRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookSubscriptionRepository(db *gorm.DB) repository.WebhookSubscriptionRepository {
+	return &webhookSubscriptionRepository{db: db}
+}
+
+func (r *webhookSubscriptionRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value("tx").(*gorm.DB); ok {
+		return tx
+	}
+	return r.db
+}
+
+func (r *webhookSubscriptionRepository) FindAll(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	var subscriptions []model.WebhookSubscription
+	if err := r.getDB(ctx).Order("id").Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+	return model.ToWebhookSubscriptionEntities(subscriptions), nil
+}
+
+func (r *webhookSubscriptionRepository) FindById(ctx context.Context, id int) (*entity.WebhookSubscription, error) {
+	var subscription model.WebhookSubscription
+	if err := r.getDB(ctx).First(&subscription, id).Error; err != nil {
+		return nil, err
+	}
+	return subscription.ToEntity(), nil
+}
+
+func (r *webhookSubscriptionRepository) Create(ctx context.Context, subscription *entity.WebhookSubscription) (*entity.WebhookSubscription, error) {
+	subscriptionModel := model.FromWebhookSubscriptionEntity(subscription)
+	if err := r.getDB(ctx).Create(subscriptionModel).Error; err != nil {
+		return nil, err
+	}
+	return subscriptionModel.ToEntity(), nil
+}
+
+func (r *webhookSubscriptionRepository) Update(ctx context.Context, subscription *entity.WebhookSubscription) (*entity.WebhookSubscription, error) {
+	subscriptionModel := model.FromWebhookSubscriptionEntity(subscription)
+	if err := r.getDB(ctx).Model(&model.WebhookSubscription{Id: subscriptionModel.Id}).
+		Select("name", "url", "secret", "events", "enabled").
+		Updates(subscriptionModel).Error; err != nil {
+		return nil, err
+	}
+	return r.FindById(ctx, subscriptionModel.Id)
+}
+
+func (r *webhookSubscriptionRepository) Delete(ctx context.Context, id int) error {
+	db := r.getDB(ctx)
+	if err := db.Where("subscription_id = ?", id).Delete(&model.WebhookDelivery{}).Error; err != nil {
+		return err
+	}
+	return db.Delete(&model.WebhookSubscription{}, id).Error
+}
@@ -0,0 +1,70 @@
+package notifier
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+// Discord rejects embeds whose texts exceed these lengths
+const (
+	discordMaxTitle      = 256
+	discordMaxFieldName  = 256
+	discordMaxFieldValue = 1024
+)
+
+type discordMessage struct {
+	Content string         `json:"content,omitempty"`
+	Embeds  []discordEmbed `json:"embeds,omitempty"`
+}
+
+type discordEmbed struct {
+	Title  string         `json:"title"`
+	Color  int            `json:"color,omitempty"`
+	Fields []discordField `json:"fields,omitempty"`
+}
+
+type discordField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline"`
+}
+
+// discordNotifier posts to Discord webhooks
+type discordNotifier struct {
+	httpClient *http.Client
+}
+
+func (n *discordNotifier) Send(ctx context.Context, target string, notification *entity.Notification) error {
+	if len(notification.Fields) == 0 {
+		return postJSON(ctx, n.httpClient, target, discordMessage{Content: notification.Title})
+	}
+
+	embed := discordEmbed{
+		Title: truncate(notification.Title, discordMaxTitle),
+	}
+	if color, err := strconv.ParseInt(strings.TrimPrefix(notification.Color, "#"), 16, 32); err == nil {
+		embed.Color = int(color)
+	}
+	for _, field := range notification.Fields {
+		embed.Fields = append(embed.Fields, discordField{
+			Name:   truncate(field.Title, discordMaxFieldName),
+			Value:  truncate(field.Value, discordMaxFieldValue),
+			Inline: field.Short,
+		})
+	}
+
+	return postJSON(ctx, n.httpClient, target, discordMessage{Embeds: []discordEmbed{embed}})
+}
+
+// truncate cuts s to at most max characters, marking the cut with an ellipsis
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max-1]) + "…"
+}
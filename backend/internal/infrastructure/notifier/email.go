@@ -0,0 +1,172 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+// SMTPConfig is the mail server email channels send through
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	Username string // no authentication if empty
+	Password string
+	From     string
+}
+
+// NewSMTPConfigFromEnv reads SMTP_HOST, SMTP_PORT (587 by default), SMTP_USERNAME,
+// SMTP_PASSWORD and SMTP_FROM; email channels are unusable without a host and sender
+func NewSMTPConfigFromEnv() *SMTPConfig {
+	port := os.Getenv("SMTP_PORT")
+	if port == "" {
+		port = "587"
+	}
+	return &SMTPConfig{
+		Host:     os.Getenv("SMTP_HOST"),
+		Port:     port,
+		Username: os.Getenv("SMTP_USERNAME"),
+		Password: os.Getenv("SMTP_PASSWORD"),
+		From:     os.Getenv("SMTP_FROM"),
+	}
+}
+
+// smtpTimeout bounds connecting to the SMTP server and the whole exchange with it, so that an
+// unresponsive server cannot hold up the outbox dispatcher
+const smtpTimeout = 30 * time.Second
+
+// emailNotifier sends plain-text mail through the SMTP server
+type emailNotifier struct {
+	config  *SMTPConfig
+	timeout time.Duration
+}
+
+func (n *emailNotifier) Send(ctx context.Context, target string, notification *entity.Notification) error {
+	if n.config == nil || n.config.Host == "" || n.config.From == "" {
+		return ErrNotConfigured
+	}
+	from, err := mail.ParseAddress(n.config.From)
+	if err != nil {
+		return fmt.Errorf("invalid sender address: %w", err)
+	}
+	recipients, err := mail.ParseAddressList(target)
+	if err != nil {
+		return fmt.Errorf("invalid recipient addresses: %w", err)
+	}
+
+	to := make([]string, len(recipients))
+	headerTo := make([]string, len(recipients))
+	for i, recipient := range recipients {
+		to[i] = recipient.Address
+		headerTo[i] = recipient.String()
+	}
+
+	var body strings.Builder
+	for _, field := range notification.Fields {
+		if field.Short {
+			fmt.Fprintf(&body, "%s: %s\n", field.Title, field.Value)
+		} else {
+			fmt.Fprintf(&body, "\n%s:\n%s\n", field.Title, field.Value)
+		}
+	}
+	if body.Len() == 0 {
+		body.WriteString(notification.Title + "\n")
+	}
+
+	var message bytes.Buffer
+	fmt.Fprintf(&message, "From: %s\r\n", from.String())
+	fmt.Fprintf(&message, "To: %s\r\n", strings.Join(headerTo, ", "))
+	fmt.Fprintf(&message, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", notification.Title))
+	fmt.Fprintf(&message, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	message.WriteString("MIME-Version: 1.0\r\n")
+	message.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	message.WriteString("Content-Transfer-Encoding: base64\r\n\r\n")
+	// Base64 lines must not exceed 76 characters
+	encoded := base64.StdEncoding.EncodeToString([]byte(body.String()))
+	for len(encoded) > 76 {
+		message.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	message.WriteString(encoded + "\r\n")
+
+	var auth smtp.Auth
+	if n.config.Username != "" {
+		auth = smtp.PlainAuth("", n.config.Username, n.config.Password, n.config.Host)
+	}
+	if err := n.sendMail(ctx, auth, from.Address, to, message.Bytes()); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+	return nil
+}
+
+// sendMail is smtp.SendMail bounded by ctx and the notifier's timeout: it upgrades to TLS when
+// the server offers STARTTLS and authenticates if auth is set
+func (n *emailNotifier) sendMail(ctx context.Context, auth smtp.Auth, from string, to []string, message []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, n.timeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(n.config.Host, n.config.Port))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+	// A cancelled ctx aborts the exchange before the deadline
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	client, err := smtp.NewClient(conn, n.config.Host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: n.config.Host}); err != nil {
+			return err
+		}
+	}
+	if auth != nil {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return errors.New("smtp: server doesn't support AUTH")
+		}
+		if err := client.Auth(auth); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(from); err != nil {
+		return err
+	}
+	for _, recipient := range to {
+		if err := client.Rcpt(recipient); err != nil {
+			return err
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(message); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
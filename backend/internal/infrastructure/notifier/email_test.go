@@ -0,0 +1,135 @@
+package notifier
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+// listen starts a server on a random local port that hands every connection to serve
+func listen(t *testing.T, serve func(conn net.Conn)) *SMTPConfig {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				serve(conn)
+			}()
+		}
+	}()
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	return &SMTPConfig{Host: host, Port: port, From: "勤怠管理 <noreply@example.com>"}
+}
+
+// serveSMTP speaks just enough SMTP to accept one message and sends its data to received
+func serveSMTP(received chan<- string) func(conn net.Conn) {
+	return func(conn net.Conn) {
+		r := bufio.NewReader(conn)
+		fmt.Fprint(conn, "220 localhost ESMTP\r\n")
+		var data strings.Builder
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			switch command := strings.ToUpper(strings.TrimSpace(line)); {
+			case strings.HasPrefix(command, "EHLO"):
+				fmt.Fprint(conn, "250 localhost\r\n")
+			case command == "DATA":
+				fmt.Fprint(conn, "354 go ahead\r\n")
+				for {
+					line, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if line == ".\r\n" {
+						break
+					}
+					data.WriteString(line)
+				}
+				received <- data.String()
+				fmt.Fprint(conn, "250 queued\r\n")
+			case command == "QUIT":
+				fmt.Fprint(conn, "221 bye\r\n")
+				return
+			default:
+				fmt.Fprint(conn, "250 ok\r\n")
+			}
+		}
+	}
+}
+
+func TestEmailNotifierSend(t *testing.T) {
+	received := make(chan string, 1)
+	config := listen(t, serveSMTP(received))
+	n := &emailNotifier{config: config, timeout: 5 * time.Second}
+
+	notification := &entity.Notification{Title: "打刻漏れのお知らせ"}
+	if err := n.Send(context.Background(), "taro@example.com", notification); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	select {
+	case data := <-received:
+		if !strings.Contains(data, "To: <taro@example.com>\r\n") {
+			t.Errorf("message does not address the recipient:\n%s", data)
+		}
+	default:
+		t.Error("server received no message")
+	}
+}
+
+func TestEmailNotifierSendUnresponsiveServer(t *testing.T) {
+	// The server accepts connections but never greets
+	config := listen(t, func(conn net.Conn) { time.Sleep(5 * time.Second) })
+	notification := &entity.Notification{Title: "打刻漏れのお知らせ"}
+
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		ctx     func() (context.Context, context.CancelFunc)
+	}{
+		{
+			name:    "notifier timeout",
+			timeout: 100 * time.Millisecond,
+			ctx:     func() (context.Context, context.CancelFunc) { return context.WithCancel(context.Background()) },
+		},
+		{
+			name:    "context deadline",
+			timeout: time.Minute,
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 100*time.Millisecond)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := &emailNotifier{config: config, timeout: tt.timeout}
+			ctx, cancel := tt.ctx()
+			defer cancel()
+
+			started := time.Now()
+			if err := n.Send(ctx, "taro@example.com", notification); err == nil {
+				t.Error("Send succeeded, want an error")
+			}
+			if elapsed := time.Since(started); elapsed > 2*time.Second {
+				t.Errorf("Send returned after %v, want it to give up after about 100ms", elapsed)
+			}
+		})
+	}
+}
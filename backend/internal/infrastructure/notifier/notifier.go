@@ -0,0 +1,66 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+// ErrNotConfigured is returned when a channel cannot be used because its adapter lacks settings
+var ErrNotConfigured = errors.New("notification channel is not configured")
+
+// Notifier delivers notifications through one kind of channel
+type Notifier interface {
+	// Send delivers the notification to target, a webhook URL, a Slack channel ID or a list of email addresses
+	Send(ctx context.Context, target string, notification *entity.Notification) error
+}
+
+// NewNotifiers returns an adapter for every channel type
+func NewNotifiers(smtpConfig *SMTPConfig, slackConfig *SlackConfig) map[entity.NotificationChannelType]Notifier {
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+	}
+	return map[entity.NotificationChannelType]Notifier{
+		entity.NotificationChannelSlack:   &slackNotifier{httpClient: client, config: slackConfig},
+		entity.NotificationChannelTeams:   &teamsNotifier{httpClient: client},
+		entity.NotificationChannelDiscord: &discordNotifier{httpClient: client},
+		entity.NotificationChannelEmail:   &emailNotifier{config: smtpConfig, timeout: smtpTimeout},
+	}
+}
+
+// postJSON posts message to a webhook and fails on any non-2xx response
+func postJSON(ctx context.Context, client *http.Client, url string, message interface{}) error {
+	if url == "" {
+		return ErrNotConfigured
+	}
+
+	jsonData, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("notification failed with status: %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+const slackPostMessageURL = "https://slack.com/api/chat.postMessage"
+
+// Slack rejects blocks whose texts exceed these lengths
+const (
+	slackMaxHeader      = 150
+	slackMaxSectionText = 3000
+	slackMaxFieldText   = 2000
+	slackMaxFields      = 10
+)
+
+// SlackConfig holds the bot token Slack channels given as channel IDs are posted to with
+type SlackConfig struct {
+	// BotToken (xoxb-…) needs the chat:write scope; channels with webhook URLs work without it
+	BotToken string
+}
+
+// NewSlackConfigFromEnv reads SLACK_BOT_TOKEN
+func NewSlackConfigFromEnv() *SlackConfig {
+	return &SlackConfig{
+		BotToken: os.Getenv("SLACK_BOT_TOKEN"),
+	}
+}
+
+type slackMessage struct {
+	// Channel is set for messages posted with the bot token
+	Channel string `json:"channel,omitempty"`
+	// Text is shown in push notifications and by clients that cannot render blocks
+	Text   string       `json:"text"`
+	Blocks []slackBlock `json:"blocks"`
+}
+
+type slackBlock struct {
+	Type   string      `json:"type"`
+	Text   *slackText  `json:"text,omitempty"`
+	Fields []slackText `json:"fields,omitempty"`
+}
+
+type slackText struct {
+	Type  string `json:"type"` // plain_text or mrkdwn
+	Text  string `json:"text"`
+	Emoji bool   `json:"emoji,omitempty"`
+}
+
+// slackAPIResponse is the envelope of every Web API response; failures come back with 200
+type slackAPIResponse struct {
+	Ok    bool   `json:"ok"`
+	Error string `json:"error"`
+}
+
+// slackNotifier posts Block Kit messages to Slack incoming webhooks, or with the bot token to the
+// channel or member ID given as the target
+type slackNotifier struct {
+	httpClient *http.Client
+	config     *SlackConfig
+}
+
+func (n *slackNotifier) Send(ctx context.Context, target string, notification *entity.Notification) error {
+	message := newSlackMessage(notification)
+	if !entity.IsSlackConversationId(target) {
+		return postJSON(ctx, n.httpClient, target, message)
+	}
+
+	if n.config == nil || n.config.BotToken == "" {
+		return fmt.Errorf("%w: SLACK_BOT_TOKEN is required to post to %s", ErrNotConfigured, target)
+	}
+	message.Channel = target
+	return n.postMessage(ctx, message)
+}
+
+// postMessage posts with the Web API, which reports errors in the body rather than the status
+func (n *slackNotifier) postMessage(ctx context.Context, message *slackMessage) error {
+	jsonData, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", slackPostMessageURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Authorization", "Bearer "+n.config.BotToken)
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("notification failed with status: %d", resp.StatusCode)
+	}
+	var result slackAPIResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("failed to read slack response: %w", err)
+	}
+	if !result.Ok {
+		return fmt.Errorf("slack rejected the notification: %s", result.Error)
+	}
+	return nil
+}
+
+// newSlackMessage lays the notification out as a header, the mentions, a section with the
+// short fields side by side, and a section for each long field
+func newSlackMessage(notification *entity.Notification) *slackMessage {
+	message := &slackMessage{
+		Text: notification.Title,
+		Blocks: []slackBlock{{
+			Type: "header",
+			Text: &slackText{Type: "plain_text", Text: truncate(notification.Title, slackMaxHeader), Emoji: true},
+		}},
+	}
+
+	if len(notification.SlackMentions) > 0 {
+		mentions := make([]string, len(notification.SlackMentions))
+		for i, id := range notification.SlackMentions {
+			mentions[i] = "<@" + id + ">"
+		}
+		message.Text = strings.Join(mentions, " ") + " " + notification.Title
+		message.Blocks = append(message.Blocks, slackBlock{
+			Type: "section",
+			Text: &slackText{Type: "mrkdwn", Text: strings.Join(mentions, " ")},
+		})
+	}
+
+	var short []slackText
+	flushShort := func() {
+		if len(short) > 0 {
+			message.Blocks = append(message.Blocks, slackBlock{Type: "section", Fields: short})
+			short = nil
+		}
+	}
+	for _, field := range notification.Fields {
+		if field.Short {
+			short = append(short, slackText{Type: "mrkdwn", Text: truncate(slackFieldText(field), slackMaxFieldText)})
+			if len(short) == slackMaxFields {
+				flushShort()
+			}
+			continue
+		}
+		flushShort()
+		message.Blocks = append(message.Blocks, slackBlock{
+			Type: "section",
+			Text: &slackText{Type: "mrkdwn", Text: truncate(slackFieldText(field), slackMaxSectionText)},
+		})
+	}
+	flushShort()
+
+	return message
+}
+
+func slackFieldText(field entity.NotificationField) string {
+	return "*" + slackEscape(field.Title) + "*\n" + slackEscape(field.Value)
+}
+
+// slackEscape keeps user-written text from being read as mentions or links
+func slackEscape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
@@ -0,0 +1,64 @@
+package notifier
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/attendance_report_app/backend/internal/domain/entity"
+)
+
+// teamsMessage is a legacy actionable message card, which Teams incoming webhooks accept
+type teamsMessage struct {
+	Type       string         `json:"@type"`
+	Context    string         `json:"@context"`
+	ThemeColor string         `json:"themeColor,omitempty"`
+	Summary    string         `json:"summary"`
+	Title      string         `json:"title"`
+	Sections   []teamsSection `json:"sections,omitempty"`
+}
+
+type teamsSection struct {
+	Facts []teamsFact `json:"facts,omitempty"`
+	Text  string      `json:"text,omitempty"`
+}
+
+type teamsFact struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// teamsNotifier posts to Microsoft Teams incoming webhooks
+type teamsNotifier struct {
+	httpClient *http.Client
+}
+
+func (n *teamsNotifier) Send(ctx context.Context, target string, notification *entity.Notification) error {
+	message := teamsMessage{
+		Type:       "MessageCard",
+		Context:    "https://schema.org/extensions",
+		ThemeColor: strings.TrimPrefix(notification.Color, "#"),
+		Summary:    notification.Title,
+		Title:      notification.Title,
+	}
+
+	// Short fields become facts; long ones such as report text get their own section
+	var facts []teamsFact
+	for _, field := range notification.Fields {
+		if field.Short {
+			facts = append(facts, teamsFact{Name: field.Title, Value: field.Value})
+		}
+	}
+	if len(facts) > 0 {
+		message.Sections = append(message.Sections, teamsSection{Facts: facts})
+	}
+	for _, field := range notification.Fields {
+		if !field.Short {
+			// Teams renders the text as Markdown, where single newlines are ignored
+			text := "**" + field.Title + "**\n\n" + strings.ReplaceAll(field.Value, "\n", "\n\n")
+			message.Sections = append(message.Sections, teamsSection{Text: text})
+		}
+	}
+
+	return postJSON(ctx, n.httpClient, target, message)
+}
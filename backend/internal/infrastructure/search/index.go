@@ -0,0 +1,338 @@
+package search
+
+import (
+	"encoding/gob"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// BM25 parameters
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// Document is a text to be indexed
+type Document struct {
+	Id   int
+	Date time.Time // breaks ties between equally relevant documents, newest first
+	Text string
+}
+
+// Hit is a document matching a search
+type Hit struct {
+	Id    int
+	Score float64
+}
+
+type token struct {
+	term     string
+	position int
+}
+
+type docInfo struct {
+	Date   time.Time
+	Length int // number of word characters
+	Terms  []string
+}
+
+// Index is an in-memory inverted index over character unigrams and bigrams.
+// Matching is phrase-based, so a query term matches only where all of its
+// characters appear consecutively in the normalized text.
+type Index struct {
+	mu sync.RWMutex
+	// postings[term][docId] holds the positions of term in the document
+	postings    map[string]map[int][]int
+	docs        map[int]*docInfo
+	totalLength int
+	builtAt     time.Time
+}
+
+func NewIndex() *Index {
+	return &Index{
+		postings: make(map[string]map[int][]int),
+		docs:     make(map[int]*docInfo),
+	}
+}
+
+// Add indexes the document, replacing an earlier version with the same ID.
+// A document without text is removed.
+func (idx *Index) Add(doc Document) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.remove(doc.Id)
+
+	tokens := tokenize(normalize(doc.Text).runes, true)
+	if len(tokens) == 0 {
+		return
+	}
+
+	info := &docInfo{Date: doc.Date}
+	for _, t := range tokens {
+		if len([]rune(t.term)) == 1 {
+			info.Length++
+		}
+		docs, ok := idx.postings[t.term]
+		if !ok {
+			docs = make(map[int][]int)
+			idx.postings[t.term] = docs
+		}
+		if _, seen := docs[doc.Id]; !seen {
+			info.Terms = append(info.Terms, t.term)
+		}
+		docs[doc.Id] = append(docs[doc.Id], t.position)
+	}
+	idx.docs[doc.Id] = info
+	idx.totalLength += info.Length
+}
+
+// Remove drops the document from the index
+func (idx *Index) Remove(id int) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(id)
+}
+
+func (idx *Index) remove(id int) {
+	info, ok := idx.docs[id]
+	if !ok {
+		return
+	}
+	for _, term := range info.Terms {
+		delete(idx.postings[term], id)
+		if len(idx.postings[term]) == 0 {
+			delete(idx.postings, term)
+		}
+	}
+	idx.totalLength -= info.Length
+	delete(idx.docs, id)
+}
+
+// Len returns the number of indexed documents
+func (idx *Index) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.docs)
+}
+
+// Ids returns the IDs of all indexed documents
+func (idx *Index) Ids() []int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	ids := make([]int, 0, len(idx.docs))
+	for id := range idx.docs {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// BuiltAt returns the time set by SetBuiltAt, i.e. how recent the indexed data is
+func (idx *Index) BuiltAt() time.Time {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return idx.builtAt
+}
+
+func (idx *Index) SetBuiltAt(t time.Time) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.builtAt = t
+}
+
+// Search returns the documents containing every whitespace-separated term of the query,
+// ordered by BM25 relevance, skipping offset hits and returning at most limit.
+// The second return value is the total number of matching documents.
+func (idx *Index) Search(query string, offset, limit int) ([]Hit, int) {
+	terms := queryTerms(query)
+	if len(terms) == 0 {
+		return []Hit{}, 0
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	// Frequencies per document of every term; documents must match all terms
+	var matches map[int][]int
+	dfs := make([]int, len(terms))
+	for i, term := range terms {
+		tfs := idx.phraseFrequencies(term)
+		dfs[i] = len(tfs)
+
+		next := make(map[int][]int)
+		for id, tf := range tfs {
+			if i == 0 {
+				next[id] = []int{tf}
+			} else if prev, ok := matches[id]; ok {
+				next[id] = append(prev, tf)
+			}
+		}
+		matches = next
+		if len(matches) == 0 {
+			return []Hit{}, 0
+		}
+	}
+
+	n := float64(len(idx.docs))
+	avgLength := float64(idx.totalLength) / n
+	hits := make([]Hit, 0, len(matches))
+	for id, tfs := range matches {
+		length := float64(idx.docs[id].Length)
+		score := 0.0
+		for i, tf := range tfs {
+			idf := math.Log(1 + (n-float64(dfs[i])+0.5)/(float64(dfs[i])+0.5))
+			f := float64(tf)
+			score += idf * f * (bm25K1 + 1) / (f + bm25K1*(1-bm25B+bm25B*length/avgLength))
+		}
+		hits = append(hits, Hit{Id: id, Score: score})
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		di, dj := idx.docs[hits[i].Id].Date, idx.docs[hits[j].Id].Date
+		if !di.Equal(dj) {
+			return di.After(dj)
+		}
+		return hits[i].Id > hits[j].Id
+	})
+
+	total := len(hits)
+	if offset >= total {
+		return []Hit{}, total
+	}
+	end := offset + limit
+	if limit <= 0 || end > total {
+		end = total
+	}
+	return hits[offset:end], total
+}
+
+// phraseFrequencies counts, per document, the occurrences of the tokens at their relative positions
+func (idx *Index) phraseFrequencies(phrase []token) map[int]int {
+	// Drive the match from the rarest token
+	rarest := 0
+	for i, t := range phrase {
+		if len(idx.postings[t.term]) < len(idx.postings[phrase[rarest].term]) {
+			rarest = i
+		}
+	}
+
+	frequencies := make(map[int]int)
+	for id, positions := range idx.postings[phrase[rarest].term] {
+		count := 0
+		for _, position := range positions {
+			start := position - phrase[rarest].position
+			if idx.matchesAt(id, phrase, start) {
+				count++
+			}
+		}
+		if count > 0 {
+			frequencies[id] = count
+		}
+	}
+	return frequencies
+}
+
+func (idx *Index) matchesAt(id int, phrase []token, start int) bool {
+	for _, t := range phrase {
+		positions := idx.postings[t.term][id]
+		want := start + t.position
+		i := sort.SearchInts(positions, want)
+		if i == len(positions) || positions[i] != want {
+			return false
+		}
+	}
+	return true
+}
+
+// queryTerms splits the query on whitespace and tokenizes each term with positions relative to its start
+func queryTerms(query string) [][]token {
+	var terms [][]token
+	for _, field := range strings.Fields(string(normalize(query).runes)) {
+		tokens := tokenize([]rune(field), false)
+		if len(tokens) > 0 {
+			terms = append(terms, tokens)
+		}
+	}
+	return terms
+}
+
+// tokenize splits runes into runs of word characters and emits the character bigrams of each run.
+// Single-character runs emit a unigram. With all set, every character is emitted as a unigram
+// too, which is what documents need so that one-character queries can match.
+// Positions are rune offsets, so they increase monotonically.
+func tokenize(runes []rune, all bool) []token {
+	var tokens []token
+	for start := 0; start < len(runes); {
+		if !isWordRune(runes[start]) {
+			start++
+			continue
+		}
+		end := start
+		for end < len(runes) && isWordRune(runes[end]) {
+			end++
+		}
+
+		for i := start; i < end; i++ {
+			if all || end-start == 1 {
+				tokens = append(tokens, token{term: string(runes[i]), position: i})
+			}
+			if i+1 < end {
+				tokens = append(tokens, token{term: string(runes[i : i+2]), position: i})
+			}
+		}
+		start = end
+	}
+	return tokens
+}
+
+// snapshot is the serialized form of an Index
+type snapshot struct {
+	Postings map[string]map[int][]int
+	Docs     map[int]*docInfo
+	BuiltAt  time.Time
+}
+
+// Save writes the index to w
+func (idx *Index) Save(w io.Writer) error {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return gob.NewEncoder(w).Encode(snapshot{
+		Postings: idx.postings,
+		Docs:     idx.docs,
+		BuiltAt:  idx.builtAt,
+	})
+}
+
+// Load replaces the contents of the index with a snapshot written by Save
+func (idx *Index) Load(r io.Reader) error {
+	var s snapshot
+	if err := gob.NewDecoder(r).Decode(&s); err != nil {
+		return err
+	}
+
+	totalLength := 0
+	for _, info := range s.Docs {
+		totalLength += info.Length
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.postings = s.Postings
+	idx.docs = s.Docs
+	idx.totalLength = totalLength
+	idx.builtAt = s.BuiltAt
+	if idx.postings == nil {
+		idx.postings = make(map[string]map[int][]int)
+	}
+	if idx.docs == nil {
+		idx.docs = make(map[int]*docInfo)
+	}
+	return nil
+}
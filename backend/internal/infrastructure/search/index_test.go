@@ -0,0 +1,198 @@
+package search
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testDay = time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+// newTestIndex indexes texts[i] as document i+1, dated i days after testDay
+func newTestIndex(texts ...string) *Index {
+	idx := NewIndex()
+	for i, text := range texts {
+		idx.Add(Document{Id: i + 1, Date: testDay.AddDate(0, 0, i), Text: text})
+	}
+	return idx
+}
+
+func hitIds(hits []Hit) []int {
+	ids := make([]int, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.Id
+	}
+	return ids
+}
+
+func TestIndexSearch(t *testing.T) {
+	idx := newTestIndex(
+		"東京都で会議の準備をした",              // 1
+		"京都に出張して会議に出た",              // 2
+		"資料作成と会議 会議 会議",             // 3
+		"東の京都へ移動",                   // 4
+		"Ｇｏでミーティング用のツールを作った",        // 5
+		"ﾃﾞｰﾀを整理した",                 // 6
+		"<b>データベース</b>のバックアップ & 復元", // 7
+	)
+
+	tests := []struct {
+		name  string
+		query string
+		want  []int
+	}{
+		{"most occurrences first", "会議", []int{3, 2, 1}},
+		{"every term must match", "会議 資料", []int{3}},
+		{"phrase", "東京都", []int{1}},
+		{"shorter phrase", "京都", []int{4, 2, 1}},
+		{"characters must be consecutive", "東京都へ", []int{}},
+		{"not in any document", "休暇", []int{}},
+		{"one term missing", "会議 休暇", []int{}},
+		{"single character", "東", []int{4, 1}},
+		{"full-width document, half-width query", "go", []int{5}},
+		{"half-width query, katakana document", "ﾐｰﾃｨﾝｸﾞ", []int{5}},
+		{"hiragana query, half-width document", "でーた", []int{6, 7}},
+		{"katakana query, half-width document", "データ", []int{6, 7}},
+		{"symbols are not terms", "&", []int{}},
+		{"punctuation only", "、。", []int{}},
+		{"empty", "  ", []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hits, total := idx.Search(tt.query, 0, 0)
+			if got := hitIds(hits); !slices.Equal(got, tt.want) {
+				t.Errorf("Search(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+			if total != len(tt.want) {
+				t.Errorf("Search(%q) total = %d, want %d", tt.query, total, len(tt.want))
+			}
+		})
+	}
+}
+
+func TestIndexSearchRanking(t *testing.T) {
+	t.Run("shorter documents rank higher", func(t *testing.T) {
+		idx := newTestIndex("午前中はお客様との打ち合わせの後で長い議事録を書いて会議の内容を共有した", "会議")
+		hits, _ := idx.Search("会議", 0, 0)
+		if got := hitIds(hits); !slices.Equal(got, []int{2, 1}) {
+			t.Errorf("Search() = %v, want [2 1]", got)
+		}
+		if hits[0].Score <= hits[1].Score {
+			t.Errorf("scores = %v, want descending", hits)
+		}
+	})
+
+	t.Run("rarer terms weigh more", func(t *testing.T) {
+		idx := newTestIndex("会議 会議", "会議 出張", "会議", "会議")
+		hits, _ := idx.Search("会議 出張", 0, 0)
+		if got := hitIds(hits); !slices.Equal(got, []int{2}) {
+			t.Fatalf("Search() = %v, want [2]", got)
+		}
+		meeting, _ := idx.Search("会議", 0, 0)
+		trip, _ := idx.Search("出張", 0, 0)
+		if trip[0].Score <= meeting[len(meeting)-1].Score {
+			t.Errorf("出張 scored %v, want more than 会議 in the same document", trip[0].Score)
+		}
+	})
+
+	t.Run("ties are broken by date, newest first", func(t *testing.T) {
+		idx := NewIndex()
+		idx.Add(Document{Id: 1, Date: testDay, Text: "週次の会議"})
+		idx.Add(Document{Id: 2, Date: testDay.AddDate(0, 0, 7), Text: "週次の会議"})
+		idx.Add(Document{Id: 3, Date: testDay, Text: "週次の会議"})
+		hits, _ := idx.Search("会議", 0, 0)
+		if got := hitIds(hits); !slices.Equal(got, []int{2, 3, 1}) {
+			t.Errorf("Search() = %v, want [2 3 1]", got)
+		}
+	})
+}
+
+func TestIndexSearchPaging(t *testing.T) {
+	idx := newTestIndex("会議", "会議", "会議", "会議", "会議")
+
+	tests := []struct {
+		offset, limit int
+		want          []int
+	}{
+		{0, 2, []int{5, 4}},
+		{2, 2, []int{3, 2}},
+		{4, 2, []int{1}},
+		{5, 2, []int{}},
+		{1, 0, []int{4, 3, 2, 1}},
+	}
+	for _, tt := range tests {
+		hits, total := idx.Search("会議", tt.offset, tt.limit)
+		if got := hitIds(hits); !slices.Equal(got, tt.want) {
+			t.Errorf("Search(offset %d, limit %d) = %v, want %v", tt.offset, tt.limit, got, tt.want)
+		}
+		if total != 5 {
+			t.Errorf("Search(offset %d, limit %d) total = %d, want 5", tt.offset, tt.limit, total)
+		}
+	}
+}
+
+func TestIndexAddReplacesAndRemoves(t *testing.T) {
+	idx := newTestIndex("会議の準備", "資料作成")
+
+	idx.Add(Document{Id: 1, Date: testDay, Text: "出張報告"})
+	if hits, _ := idx.Search("会議", 0, 0); len(hits) != 0 {
+		t.Errorf("replaced text still matches: %v", hitIds(hits))
+	}
+	if hits, _ := idx.Search("出張", 0, 0); !slices.Equal(hitIds(hits), []int{1}) {
+		t.Errorf("new text does not match: %v", hitIds(hits))
+	}
+
+	// A document without text is removed
+	idx.Add(Document{Id: 2, Date: testDay})
+	idx.Remove(1)
+	if idx.Len() != 0 || len(idx.postings) != 0 || idx.totalLength != 0 {
+		t.Errorf("index not empty: %d documents, %d terms, length %d", idx.Len(), len(idx.postings), idx.totalLength)
+	}
+}
+
+func TestIndexSnapshot(t *testing.T) {
+	idx := newTestIndex("東京都で会議の準備をした", "京都に出張して会議に出た", "ﾃﾞｰﾀを整理した")
+	builtAt := time.Date(2024, 4, 2, 9, 30, 0, 0, time.UTC)
+	idx.SetBuiltAt(builtAt)
+
+	var buf bytes.Buffer
+	if err := idx.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	loaded := NewIndex()
+	if err := loaded.Load(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	if loaded.Len() != idx.Len() || loaded.totalLength != idx.totalLength || !loaded.BuiltAt().Equal(builtAt) {
+		t.Errorf("loaded %d documents, length %d, built at %v; want %d, %d, %v",
+			loaded.Len(), loaded.totalLength, loaded.BuiltAt(), idx.Len(), idx.totalLength, builtAt)
+	}
+	for _, query := range []string{"会議", "京都", "データ", "出張 会議"} {
+		want, _ := idx.Search(query, 0, 0)
+		got, _ := loaded.Search(query, 0, 0)
+		if !slices.Equal(got, want) {
+			t.Errorf("Search(%q) after loading = %v, want %v", query, got, want)
+		}
+	}
+
+	// The loaded index keeps working
+	loaded.Add(Document{Id: 4, Date: testDay, Text: "会議"})
+	if hits, _ := loaded.Search("会議", 0, 0); len(hits) != 3 {
+		t.Errorf("Search() after adding = %v, want 3 hits", hitIds(hits))
+	}
+}
+
+func TestIndexLoadInvalidSnapshot(t *testing.T) {
+	idx := newTestIndex("会議")
+	if err := idx.Load(strings.NewReader("not a snapshot")); err == nil {
+		t.Error("Load() = nil, want an error")
+	}
+	// A failed load leaves the index as it was
+	if hits, _ := idx.Search("会議", 0, 0); len(hits) != 1 {
+		t.Errorf("Search() after a failed load = %v, want 1 hit", hitIds(hits))
+	}
+}
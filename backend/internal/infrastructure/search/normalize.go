@@ -0,0 +1,94 @@
+package search
+
+import "unicode"
+
+// halfWidthKana maps half-width katakana and punctuation (U+FF61–U+FF9F) to their full-width forms
+var halfWidthKana = [...]rune{
+	'。', '「', '」', '、', '・', 'ヲ', 'ァ', 'ィ', 'ゥ', 'ェ', 'ォ', 'ャ', 'ュ', 'ョ', 'ッ', 'ー',
+	'ア', 'イ', 'ウ', 'エ', 'オ', 'カ', 'キ', 'ク', 'ケ', 'コ', 'サ', 'シ', 'ス', 'セ', 'ソ', 'タ',
+	'チ', 'ツ', 'テ', 'ト', 'ナ', 'ニ', 'ヌ', 'ネ', 'ノ', 'ハ', 'ヒ', 'フ', 'ヘ', 'ホ', 'マ', 'ミ',
+	'ム', 'メ', 'モ', 'ヤ', 'ユ', 'ヨ', 'ラ', 'リ', 'ル', 'レ', 'ロ', 'ワ', 'ン', '゛', '゜',
+}
+
+const (
+	halfWidthVoicedMark     = 'ﾞ'
+	halfWidthSemiVoicedMark = 'ﾟ'
+)
+
+// normalized is a normalized text with the position of each rune in the original text
+type normalized struct {
+	runes []rune
+	// offsets[i] is the index in the original runes where runes[i] starts;
+	// offsets[len(runes)] is the length of the original
+	offsets []int
+}
+
+// normalize folds the text so that equivalent spellings compare equal:
+// full-width ASCII becomes half-width, half-width katakana becomes full-width
+// (joining voiced sound marks), katakana becomes hiragana and letters are lower-cased.
+func normalize(text string) normalized {
+	original := []rune(text)
+	n := normalized{
+		runes:   make([]rune, 0, len(original)),
+		offsets: make([]int, 0, len(original)+1),
+	}
+
+	for i := 0; i < len(original); i++ {
+		r := original[i]
+		start := i
+
+		switch {
+		case r == '　':
+			r = ' '
+		case r >= '！' && r <= '～':
+			r -= 0xFEE0
+		case r >= '｡' && r <= 'ﾟ':
+			r = halfWidthKana[r-'｡']
+			if i+1 < len(original) {
+				if voiced, ok := withSoundMark(r, original[i+1]); ok {
+					r = voiced
+					i++
+				}
+			}
+		}
+
+		n.runes = append(n.runes, toHiragana(unicode.ToLower(r)))
+		n.offsets = append(n.offsets, start)
+	}
+	n.offsets = append(n.offsets, len(original))
+
+	return n
+}
+
+// withSoundMark combines a full-width katakana with a following half-width (semi-)voiced sound mark
+func withSoundMark(kana, mark rune) (rune, bool) {
+	switch mark {
+	case halfWidthVoicedMark:
+		switch {
+		case kana == 'ウ':
+			return 'ヴ', true
+		case kana >= 'カ' && kana <= 'チ' && (kana-'カ')%2 == 0:
+			return kana + 1, true
+		case kana == 'ツ', kana == 'テ', kana == 'ト':
+			return kana + 1, true
+		case kana >= 'ハ' && kana <= 'ホ' && (kana-'ハ')%3 == 0:
+			return kana + 1, true
+		}
+	case halfWidthSemiVoicedMark:
+		if kana >= 'ハ' && kana <= 'ホ' && (kana-'ハ')%3 == 0 {
+			return kana + 2, true
+		}
+	}
+	return kana, false
+}
+
+func toHiragana(r rune) rune {
+	if r >= 'ァ' && r <= 'ヶ' {
+		return r - 0x60
+	}
+	return r
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r)
+}
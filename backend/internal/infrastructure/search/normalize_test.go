@@ -0,0 +1,68 @@
+package search
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"full-width ASCII", "ＡＢＣ１２３！", "abc123!"},
+		{"full-width space", "会議　資料", "会議 資料"},
+		{"upper case", "Go言語", "go言語"},
+		{"katakana", "カタカナ", "かたかな"},
+		{"half-width katakana", "ｶﾀｶﾅ", "かたかな"},
+		{"half-width voiced marks", "ｶﾞｲﾄﾞ", "がいど"},
+		{"half-width semi-voiced marks", "ﾊﾟﾋﾟﾌﾟ", "ぱぴぷ"},
+		{"half-width vu", "ｳﾞ", "ゔ"},
+		{"half-width prolonged sound mark", "ﾃﾞｰﾀ", "でーた"},
+		{"half-width punctuation", "｢ﾒﾓ｣､", "「めも」、"},
+		{"voiced mark without a base", "ｱﾞ", "あ゛"},
+		{"hiragana and kanji are kept", "ひらがなと漢字", "ひらがなと漢字"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(normalize(tt.text).runes); got != tt.want {
+				t.Errorf("normalize(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeOffsets(t *testing.T) {
+	// ｶﾞ is joined into one rune; the offsets point back into the original
+	n := normalize("ｶﾞｲﾄﾞ")
+	if want := []int{0, 2, 3, 5}; !slices.Equal(n.offsets, want) {
+		t.Errorf("offsets = %v, want %v", n.offsets, want)
+	}
+}
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		all  bool
+		want []token
+	}{
+		{"bigrams", "abc", false, []token{{"ab", 0}, {"bc", 1}}},
+		{"bigrams and unigrams", "abc", true, []token{{"a", 0}, {"ab", 0}, {"b", 1}, {"bc", 1}, {"c", 2}}},
+		{"single character", "a", false, []token{{"a", 0}}},
+		{"split on punctuation", "東京、大阪", false, []token{{"東京", 0}, {"大阪", 3}}},
+		{"split on spaces", "会議 資料", false, []token{{"会議", 0}, {"資料", 3}}},
+		{"single characters between punctuation", "a・b", false, []token{{"a", 0}, {"b", 2}}},
+		{"no word characters", "、。 !?", true, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tokenize([]rune(tt.text), tt.all); !slices.Equal(got, tt.want) {
+				t.Errorf("tokenize(%q, %v) = %v, want %v", tt.text, tt.all, got, tt.want)
+			}
+		})
+	}
+}
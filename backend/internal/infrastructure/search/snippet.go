@@ -0,0 +1,80 @@
+package search
+
+import (
+	"html"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Snippet length in characters and how many of them precede the first match
+const (
+	snippetLength  = 100
+	snippetContext = 30
+)
+
+type span struct {
+	start, end int // rune offsets in the original text
+}
+
+// Highlight returns an excerpt of text around the first occurrence of a query term, with every
+// occurrence wrapped in <mark> tags. The text is HTML-escaped, so the result can be rendered as HTML.
+// Without a match the excerpt is the beginning of the text.
+func Highlight(text, query string) string {
+	original := []rune(text)
+	n := normalize(text)
+
+	var spans []span
+	for _, term := range strings.Fields(string(normalize(query).runes)) {
+		spans = append(spans, findAll(n, []rune(term))...)
+	}
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+
+	start := 0
+	if len(spans) > 0 && spans[0].start > snippetContext {
+		start = spans[0].start - snippetContext
+	}
+	end := start + snippetLength
+	if end > len(original) {
+		end = len(original)
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	position := start
+	for _, s := range spans {
+		// Skip overlapping matches and those outside the excerpt
+		if s.start < position || s.start >= end {
+			continue
+		}
+		if s.end > end {
+			s.end = end
+		}
+		b.WriteString(html.EscapeString(string(original[position:s.start])))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(string(original[s.start:s.end])))
+		b.WriteString("</mark>")
+		position = s.end
+	}
+	b.WriteString(html.EscapeString(string(original[position:end])))
+	if end < len(original) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
+
+// findAll returns the original spans of every occurrence of term in the normalized text
+func findAll(n normalized, term []rune) []span {
+	var spans []span
+	if len(term) == 0 {
+		return spans
+	}
+	for i := 0; i+len(term) <= len(n.runes); i++ {
+		if slices.Equal(n.runes[i:i+len(term)], term) {
+			spans = append(spans, span{start: n.offsets[i], end: n.offsets[i+len(term)]})
+		}
+	}
+	return spans
+}
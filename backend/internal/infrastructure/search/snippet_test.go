@@ -0,0 +1,67 @@
+package search
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		query string
+		want  string
+	}{
+		{
+			name: "no match", text: "資料を作成した", query: "会議",
+			want: "資料を作成した",
+		},
+		{
+			name: "every occurrence", text: "会議のあと会議", query: "会議",
+			want: "<mark>会議</mark>のあと<mark>会議</mark>",
+		},
+		{
+			name: "several terms", text: "会議の資料を作成", query: "資料 会議",
+			want: "<mark>会議</mark>の<mark>資料</mark>を作成",
+		},
+		{
+			name: "overlapping terms", text: "会議事録", query: "会議 議事",
+			want: "<mark>会議</mark>事録",
+		},
+		{
+			name: "original spelling is kept", text: "ﾃﾞｰﾀを整理", query: "データ",
+			want: "<mark>ﾃﾞｰﾀ</mark>を整理",
+		},
+		{
+			name: "full-width match", text: "ＧｏとGO", query: "go",
+			want: "<mark>Ｇｏ</mark>と<mark>GO</mark>",
+		},
+		{
+			name: "HTML is escaped", text: "<b>会議</b> & 資料", query: "会議",
+			want: "&lt;b&gt;<mark>会議</mark>&lt;/b&gt; &amp; 資料",
+		},
+		{
+			name: "excerpt starts before the first match",
+			text: strings.Repeat("あ", 50) + "会議" + strings.Repeat("い", 100), query: "会議",
+			want: "…" + strings.Repeat("あ", 30) + "<mark>会議</mark>" + strings.Repeat("い", 68) + "…",
+		},
+		{
+			name: "match cut at the end of the excerpt",
+			text: "会議" + strings.Repeat("あ", 97) + "会議いいい", query: "会議",
+			want: "<mark>会議</mark>" + strings.Repeat("あ", 97) + "<mark>会</mark>…",
+		},
+		{
+			name: "without a match the excerpt is the beginning",
+			text: strings.Repeat("日報", 60), query: "会議",
+			want: strings.Repeat("日報", 50) + "…",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Highlight(tt.text, tt.query); got != tt.want {
+				t.Errorf("Highlight() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
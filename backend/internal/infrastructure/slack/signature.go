@@ -0,0 +1,46 @@
+// Package slack verifies the requests Slack sends to the app, such as slash commands.
+package slack
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"time"
+)
+
+const (
+	SignatureHeader = "X-Slack-Signature"
+	TimestampHeader = "X-Slack-Request-Timestamp"
+
+	// MaxRequestAge is how old a signed request may be; older ones are rejected as replays
+	MaxRequestAge = 5 * time.Minute
+)
+
+var ErrInvalidSignature = errors.New("invalid slack signature")
+
+// Sign computes the signature Slack sends for body: "v0=" and the hex HMAC-SHA256 of
+// "v0:<timestamp>:<body>" keyed with the app's signing secret
+func Sign(signingSecret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(signingSecret))
+	mac.Write([]byte("v0:" + timestamp + ":"))
+	mac.Write(body)
+	return "v0=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature checks that body was signed with the signing secret within MaxRequestAge of now
+func VerifySignature(signingSecret, timestamp, signature string, body []byte, now time.Time) error {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	age := now.Sub(time.Unix(seconds, 0))
+	if age > MaxRequestAge || age < -MaxRequestAge {
+		return ErrInvalidSignature
+	}
+	if !hmac.Equal([]byte(signature), []byte(Sign(signingSecret, timestamp, body))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package slack
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// The example request from Slack's documentation on verifying requests
+const (
+	exampleSecret    = "8f742231b10e8888abcd99yyyzzz85a5"
+	exampleTimestamp = "1531420618"
+	exampleBody      = "token=xyzz0WbapA4vBCDEFasx0q6G&team_id=T1DC2JH3J&team_domain=testteamnow&channel_id=G8PSS9T3V&channel_name=foobar&user_id=U2CERLKJA&user_name=roadrunner&command=%2Fwebhook-collect&text=&response_url=https%3A%2F%2Fhooks.slack.com%2Fcommands%2FT1DC2JH3J%2F397700885554%2F96rGlfmibIGlgcZRskXaIFfN&trigger_id=398738663015.47445629121.803a0bc887a14d10d2c447fce8b6703c"
+	exampleSignature = "v0=a2114d57b48eac39b9ad189dd8316235a7b4a8d21a10bd27519666489c69b503"
+)
+
+var exampleTime = time.Unix(1531420618, 0)
+
+func TestSignMatchesSlackExample(t *testing.T) {
+	if got := Sign(exampleSecret, exampleTimestamp, []byte(exampleBody)); got != exampleSignature {
+		t.Errorf("Sign() = %s, want %s", got, exampleSignature)
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp string
+		signature string
+		body      string
+		now       time.Time
+		valid     bool
+	}{
+		{"good", exampleSecret, exampleTimestamp, exampleSignature, exampleBody, exampleTime.Add(30 * time.Second), true},
+		{"good within clock skew", exampleSecret, exampleTimestamp, exampleSignature, exampleBody, exampleTime.Add(-time.Minute), true},
+		{"wrong secret", "another-secret", exampleTimestamp, exampleSignature, exampleBody, exampleTime, false},
+		{"tampered body", exampleSecret, exampleTimestamp, exampleSignature, exampleBody + "&text=out", exampleTime, false},
+		{"tampered timestamp", exampleSecret, "1531420619", exampleSignature, exampleBody, exampleTime, false},
+		{"missing signature", exampleSecret, exampleTimestamp, "", exampleBody, exampleTime, false},
+		{"malformed timestamp", exampleSecret, "yesterday", exampleSignature, exampleBody, exampleTime, false},
+		{"expired", exampleSecret, exampleTimestamp, exampleSignature, exampleBody, exampleTime.Add(MaxRequestAge + time.Second), false},
+		{"from the future", exampleSecret, exampleTimestamp, exampleSignature, exampleBody, exampleTime.Add(-MaxRequestAge - time.Second), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifySignature(tt.secret, tt.timestamp, tt.signature, []byte(tt.body), tt.now)
+			if tt.valid && err != nil {
+				t.Errorf("VerifySignature() = %v, want nil", err)
+			}
+			if !tt.valid && !errors.Is(err, ErrInvalidSignature) {
+				t.Errorf("VerifySignature() = %v, want ErrInvalidSignature", err)
+			}
+		})
+	}
+}
+
+func TestVerifySignatureAcceptsFreshSignatures(t *testing.T) {
+	now := time.Now()
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	body := []byte("team_id=T0001&user_id=U2147483697&command=%2Fkintai&text=in")
+	if err := VerifySignature("secret", timestamp, Sign("secret", timestamp, body), body, now); err != nil {
+		t.Errorf("VerifySignature() = %v, want nil", err)
+	}
+}
@@ -0,0 +1,103 @@
+// Package webhook posts signed event payloads to the URLs of webhook subscriptions.
+//
+// Receivers verify a delivery by computing the HMAC-SHA256 of "<timestamp>.<body>" keyed with
+// the subscription's secret, where timestamp is the X-Webhook-Timestamp header, and comparing
+// "sha256=<hex digest>" with the X-Webhook-Signature header. Rejecting old timestamps keeps
+// recorded deliveries from being replayed.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	EventHeader     = "X-Webhook-Event"
+	IdHeader        = "X-Webhook-Id"
+	TimestampHeader = "X-Webhook-Timestamp"
+	SignatureHeader = "X-Webhook-Signature"
+
+	// maxResponseLength is how much of the receiver's response is kept for the delivery log
+	maxResponseLength = 1000
+)
+
+// Request is one event posted to one subscription
+type Request struct {
+	URL     string
+	Secret  string
+	EventId string
+	Event   string
+	Body    []byte // JSON
+}
+
+// Response is what the receiver answered; StatusCode is 0 if it did not answer
+type Response struct {
+	StatusCode int
+	Body       string
+	Duration   time.Duration
+}
+
+type Sender struct {
+	httpClient *http.Client
+}
+
+func NewSender() *Sender {
+	return &Sender{
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+// Send posts the request and returns the response. The error is set for anything but a 2xx
+// status; the response is returned either way so that the attempt can be logged.
+func (s *Sender) Send(ctx context.Context, request *Request) (*Response, error) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", request.URL, bytes.NewReader(request.Body))
+	if err != nil {
+		return &Response{}, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "attendance-report-webhooks")
+	req.Header.Set(EventHeader, request.Event)
+	req.Header.Set(IdHeader, request.EventId)
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, Sign(request.Secret, timestamp, request.Body))
+
+	start := time.Now()
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return &Response{Duration: time.Since(start)}, fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseLength))
+	response := &Response{
+		StatusCode: resp.StatusCode,
+		Body:       string(body),
+		Duration:   time.Since(start),
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return response, fmt.Errorf("webhook failed with status: %d", resp.StatusCode)
+	}
+	return response, nil
+}
+
+// Sign returns the signature of body sent at timestamp: "sha256=" and the hex HMAC-SHA256 of
+// "<timestamp>.<body>" keyed with secret
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -21,7 +21,11 @@ func NewAdminHandler(adminUseCase usecase.AdminUseCase, attendanceUseCase usecas
 }
 
 func (h *AdminHandler) GetDashboard(c *gin.Context) {
-	dashboard, err := h.adminUseCase.GetDashboardData(c.Request.Context())
+	month := c.Query("month")
+	from := c.Query("from")
+	to := c.Query("to")
+
+	dashboard, err := h.adminUseCase.GetDashboardData(c.Request.Context(), &month, &from, &to)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -60,7 +64,13 @@ func (h *AdminHandler) GetUserAttendances(c *gin.Context) {
 		monthPtr = &month
 	}
 
-	attendances, err := h.attendanceUseCase.GetMyAttendances(c.Request.Context(), userID, monthPtr)
+	viewerID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	attendances, err := h.attendanceUseCase.GetUserAttendances(c.Request.Context(), userID, viewerID.(int), monthPtr)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/attendance_report_app/backend/internal/application/dto/request"
 	"github.com/attendance_report_app/backend/internal/application/usecase"
 	"github.com/attendance_report_app/backend/internal/application/transaction"
+	"github.com/attendance_report_app/backend/internal/domain"
 )
 
 type AttendanceHandler struct {
@@ -71,6 +73,10 @@ func (h *AttendanceHandler) CreateAttendance(c *gin.Context) {
 		return err
 	})
 
+	if errors.Is(err, domain.ErrPayrollPeriodLocked) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -80,6 +86,12 @@ func (h *AttendanceHandler) CreateAttendance(c *gin.Context) {
 }
 
 func (h *AttendanceHandler) UpdateAttendance(c *gin.Context) {
+	editorID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
 	idStr := c.Param("id")
 	id, err := strconv.Atoi(idStr)
 	if err != nil {
@@ -101,10 +113,14 @@ func (h *AttendanceHandler) UpdateAttendance(c *gin.Context) {
 	var attendance *dto.AttendanceResponse
 	err = h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
 		var err error
-		attendance, err = h.attendanceUseCase.UpdateAttendance(ctx, id, &req)
+		attendance, err = h.attendanceUseCase.UpdateAttendance(ctx, id, &req, editorID.(int))
 		return err
 	})
 
+	if errors.Is(err, domain.ErrPayrollPeriodLocked) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/application/transaction"
+	"github.com/attendance_report_app/backend/internal/application/usecase"
+)
+
+type CalendarHandler struct {
+	calendarUseCase usecase.CalendarUseCase
+	txManager       transaction.Manager
+}
+
+func NewCalendarHandler(calendarUseCase usecase.CalendarUseCase, txManager transaction.Manager) *CalendarHandler {
+	return &CalendarHandler{
+		calendarUseCase: calendarUseCase,
+		txManager:       txManager,
+	}
+}
+
+func (h *CalendarHandler) GetHolidays(c *gin.Context) {
+	year := c.Query("year")
+	var yearPtr *string
+	if year != "" {
+		yearPtr = &year
+	}
+
+	holidays, err := h.calendarUseCase.GetHolidays(c.Request.Context(), yearPtr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, holidays.Holidays)
+}
+
+func (h *CalendarHandler) CreateHoliday(c *gin.Context) {
+	var req request.CreateHolidayRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var holiday *dto.HolidayResponse
+	err := h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		holiday, err = h.calendarUseCase.CreateHoliday(ctx, &req)
+		return err
+	})
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, holiday)
+}
+
+func (h *CalendarHandler) DeleteHoliday(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid holiday ID"})
+		return
+	}
+
+	err = h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		return h.calendarUseCase.DeleteHoliday(ctx, id)
+	})
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,293 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/application/transaction"
+	"github.com/attendance_report_app/backend/internal/application/usecase"
+)
+
+type CompensationHandler struct {
+	compensationUseCase usecase.CompensationUseCase
+	txManager           transaction.Manager
+}
+
+func NewCompensationHandler(compensationUseCase usecase.CompensationUseCase, txManager transaction.Manager) *CompensationHandler {
+	return &CompensationHandler{
+		compensationUseCase: compensationUseCase,
+		txManager:           txManager,
+	}
+}
+
+func (h *CompensationHandler) GetAllowances(c *gin.Context) {
+	allowances, err := h.compensationUseCase.GetAllowances(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, allowances.Allowances)
+}
+
+func (h *CompensationHandler) CreateAllowance(c *gin.Context) {
+	var req request.CreateAllowanceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var allowance *dto.AllowanceResponse
+	err := h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		allowance, err = h.compensationUseCase.CreateAllowance(ctx, &req)
+		return err
+	})
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, allowance)
+}
+
+func (h *CompensationHandler) UpdateAllowance(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid allowance ID"})
+		return
+	}
+
+	var req request.UpdateAllowanceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var allowance *dto.AllowanceResponse
+	err = h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		allowance, err = h.compensationUseCase.UpdateAllowance(ctx, id, &req)
+		return err
+	})
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, allowance)
+}
+
+func (h *CompensationHandler) DeleteAllowance(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid allowance ID"})
+		return
+	}
+
+	err = h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		return h.compensationUseCase.DeleteAllowance(ctx, id)
+	})
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *CompensationHandler) GetUserAllowances(c *gin.Context) {
+	userID, err := strconv.Atoi(c.Param("userId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	userAllowances, err := h.compensationUseCase.GetUserAllowances(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, userAllowances.UserAllowances)
+}
+
+func (h *CompensationHandler) AssignAllowance(c *gin.Context) {
+	userID, err := strconv.Atoi(c.Param("userId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	var req request.AssignAllowanceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var userAllowance *dto.UserAllowanceResponse
+	err = h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		userAllowance, err = h.compensationUseCase.AssignAllowance(ctx, userID, &req)
+		return err
+	})
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, userAllowance)
+}
+
+func (h *CompensationHandler) UpdateUserAllowance(c *gin.Context) {
+	userID, err := strconv.Atoi(c.Param("userId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user allowance ID"})
+		return
+	}
+
+	var req request.UpdateUserAllowanceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var userAllowance *dto.UserAllowanceResponse
+	err = h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		userAllowance, err = h.compensationUseCase.UpdateUserAllowance(ctx, userID, id, &req)
+		return err
+	})
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, userAllowance)
+}
+
+func (h *CompensationHandler) DeleteUserAllowance(c *gin.Context) {
+	userID, err := strconv.Atoi(c.Param("userId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user allowance ID"})
+		return
+	}
+
+	err = h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		return h.compensationUseCase.DeleteUserAllowance(ctx, userID, id)
+	})
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *CompensationHandler) GetBonuses(c *gin.Context) {
+	var userIDPtr *int
+	if userIDStr := c.Query("user_id"); userIDStr != "" {
+		userID, err := strconv.Atoi(userIDStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+			return
+		}
+		userIDPtr = &userID
+	}
+
+	bonuses, err := h.compensationUseCase.GetBonuses(c.Request.Context(), userIDPtr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, bonuses.Bonuses)
+}
+
+func (h *CompensationHandler) CreateBonus(c *gin.Context) {
+	var req request.CreateBonusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var bonus *dto.BonusResponse
+	err := h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		bonus, err = h.compensationUseCase.CreateBonus(ctx, &req)
+		return err
+	})
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, bonus)
+}
+
+func (h *CompensationHandler) DeleteBonus(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid bonus ID"})
+		return
+	}
+
+	err = h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		return h.compensationUseCase.DeleteBonus(ctx, id)
+	})
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -1,28 +1,378 @@
 package handler
 
 import (
+	"context"
+	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/application/transaction"
 	"github.com/attendance_report_app/backend/internal/application/usecase"
+	"github.com/attendance_report_app/backend/internal/domain"
 )
 
 type DailyReportHandler struct {
 	dailyReportUseCase usecase.DailyReportUseCase
+	txManager          transaction.Manager
 }
 
-func NewDailyReportHandler(dailyReportUseCase usecase.DailyReportUseCase) *DailyReportHandler {
+func NewDailyReportHandler(dailyReportUseCase usecase.DailyReportUseCase, txManager transaction.Manager) *DailyReportHandler {
 	return &DailyReportHandler{
 		dailyReportUseCase: dailyReportUseCase,
+		txManager:          txManager,
 	}
 }
 
+// GetAllDailyReports returns a page of reports with the caller's unread count.
+// Query parameters: page, per_page, user_id, department, from, to (YYYY-MM-DD), keyword, unread (true/false), tag
 func (h *DailyReportHandler) GetAllDailyReports(c *gin.Context) {
-	reports, err := h.dailyReportUseCase.GetAllDailyReports(c.Request.Context())
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req request.GetDailyReportsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters"})
+		return
+	}
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	reports, err := h.dailyReportUseCase.GetAllDailyReports(c.Request.Context(), userID.(int), &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, reports)
+}
+
+// GetTagCloud returns hashtag usage counts.
+// Query parameters: from, to (YYYY-MM-DD), department, limit
+func (h *DailyReportHandler) GetTagCloud(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req request.GetTagCloudRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters"})
+		return
+	}
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	tags, err := h.dailyReportUseCase.GetTagCloud(c.Request.Context(), userID.(int), &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, tags)
+}
+
+// SearchDailyReports runs a full-text search over report text.
+// Query parameters: q (required), page, per_page
+func (h *DailyReportHandler) SearchDailyReports(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req request.SearchDailyReportsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters"})
+		return
+	}
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	results, err := h.dailyReportUseCase.SearchDailyReports(c.Request.Context(), userID.(int), &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, results)
+}
+
+// RebuildSearchIndex rebuilds this process's search index (admin only)
+func (h *DailyReportHandler) RebuildSearchIndex(c *gin.Context) {
+	result, err := h.dailyReportUseCase.RebuildSearchIndex(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetMyDailyReports returns a page of the user's own reports, drafts included.
+// Query parameters: as for GetAllDailyReports, plus status (DRAFT or PUBLISHED)
+func (h *DailyReportHandler) GetMyDailyReports(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req request.GetDailyReportsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters"})
+		return
+	}
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	reports, err := h.dailyReportUseCase.GetMyDailyReports(c.Request.Context(), userID.(int), &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, reports)
+}
+
+func (h *DailyReportHandler) GetDailyReport(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid report ID"})
+		return
+	}
+
+	report, err := h.dailyReportUseCase.GetDailyReport(c.Request.Context(), id, userID.(int))
+	if err != nil {
+		c.JSON(reportErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// GetDailyReportHistory returns a report together with its earlier versions, newest first
+func (h *DailyReportHandler) GetDailyReportHistory(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid report ID"})
+		return
+	}
+
+	history, err := h.dailyReportUseCase.GetDailyReportHistory(c.Request.Context(), id, userID.(int))
+	if err != nil {
+		c.JSON(reportErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, history)
+}
+
+func (h *DailyReportHandler) CreateDailyReport(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req request.CreateDailyReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var report *dto.DailyReportResponse
+	err := h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		report, err = h.dailyReportUseCase.CreateDailyReport(ctx, userID.(int), &req)
+		return err
+	})
+
+	if err != nil {
+		c.JSON(reportErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, report)
+}
+
+func (h *DailyReportHandler) UpdateDailyReport(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid report ID"})
+		return
+	}
+
+	var req request.UpdateDailyReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var report *dto.DailyReportResponse
+	err = h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		report, err = h.dailyReportUseCase.UpdateDailyReport(ctx, id, userID.(int), &req)
+		return err
+	})
+
+	if err != nil {
+		c.JSON(reportErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+func (h *DailyReportHandler) DeleteDailyReport(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid report ID"})
+		return
+	}
+
+	err = h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		return h.dailyReportUseCase.DeleteDailyReport(ctx, id, userID.(int))
+	})
+
+	if err != nil {
+		c.JSON(reportErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// MarkDailyReportRead marks a report as read by the caller
+func (h *DailyReportHandler) MarkDailyReportRead(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid report ID"})
+		return
+	}
+
+	err = h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		return h.dailyReportUseCase.MarkDailyReportRead(ctx, id, userID.(int))
+	})
+
+	if err != nil {
+		c.JSON(reportErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// MarkAllDailyReportsRead marks every published report by others as read by the caller
+func (h *DailyReportHandler) MarkAllDailyReportsRead(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var result *dto.MarkAllReadResponse
+	err := h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		result, err = h.dailyReportUseCase.MarkAllDailyReportsRead(ctx, userID.(int))
+		return err
+	})
+
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, reports.Reports)
-}
\ No newline at end of file
+	c.JSON(http.StatusOK, result)
+}
+
+// GetDailyReportReaders lists who has read one of the caller's own reports
+func (h *DailyReportHandler) GetDailyReportReaders(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid report ID"})
+		return
+	}
+
+	readers, err := h.dailyReportUseCase.GetDailyReportReaders(c.Request.Context(), id, userID.(int))
+	if err != nil {
+		c.JSON(reportErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, readers)
+}
+
+// reportErrorStatus maps daily report errors to their HTTP status
+func reportErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrDailyReportNotFound), errors.Is(err, domain.ErrReportCommentNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, domain.ErrDailyReportExists):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
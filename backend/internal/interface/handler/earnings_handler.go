@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/application/usecase"
+)
+
+type EarningsHandler struct {
+	earningsUseCase usecase.EarningsUseCase
+}
+
+func NewEarningsHandler(earningsUseCase usecase.EarningsUseCase) *EarningsHandler {
+	return &EarningsHandler{
+		earningsUseCase: earningsUseCase,
+	}
+}
+
+// GetMyEarnings only ever returns the authenticated user's own figures
+func (h *EarningsHandler) GetMyEarnings(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req request.GetEarningsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters"})
+		return
+	}
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	earnings, err := h.earningsUseCase.GetMyEarnings(c.Request.Context(), userID.(int), &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, earnings)
+}
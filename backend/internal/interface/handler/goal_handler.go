@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/application/transaction"
+	"github.com/attendance_report_app/backend/internal/application/usecase"
+)
+
+type GoalHandler struct {
+	goalUseCase usecase.GoalUseCase
+	txManager   transaction.Manager
+}
+
+func NewGoalHandler(goalUseCase usecase.GoalUseCase, txManager transaction.Manager) *GoalHandler {
+	return &GoalHandler{
+		goalUseCase: goalUseCase,
+		txManager:   txManager,
+	}
+}
+
+func (h *GoalHandler) GetMyGoals(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	goals, err := h.goalUseCase.GetMyGoals(c.Request.Context(), userID.(int))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, goals)
+}
+
+func (h *GoalHandler) SetGoal(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req request.SetGoalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var goal *dto.GoalResponse
+	err := h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		goal, err = h.goalUseCase.SetGoal(ctx, userID.(int), &req)
+		return err
+	})
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, goal)
+}
+
+func (h *GoalHandler) GetGoalProgress(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	date := c.Query("date")
+	var datePtr *string
+	if date != "" {
+		datePtr = &date
+	}
+
+	progress, err := h.goalUseCase.GetGoalProgress(c.Request.Context(), userID.(int), datePtr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, progress)
+}
@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/attendance_report_app/backend/internal/application/usecase"
+	"github.com/attendance_report_app/backend/internal/domain"
+)
+
+type JobHandler struct {
+	jobUseCase usecase.JobUseCase
+}
+
+func NewJobHandler(jobUseCase usecase.JobUseCase) *JobHandler {
+	return &JobHandler{
+		jobUseCase: jobUseCase,
+	}
+}
+
+func (h *JobHandler) GetJobs(c *gin.Context) {
+	jobs, err := h.jobUseCase.GetJobs(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, jobs)
+}
+
+// TriggerJob starts a job now; the run continues in the background, poll its runs for the result
+func (h *JobHandler) TriggerJob(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	run, err := h.jobUseCase.TriggerJob(c.Request.Context(), c.Param("name"), userID.(int))
+	if err != nil {
+		c.JSON(jobErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, run)
+}
+
+// GetJobRuns returns a job's run history.
+// Query parameters: limit (default 20, max 100)
+func (h *JobHandler) GetJobRuns(c *gin.Context) {
+	limit := 0
+	if limitStr := c.Query("limit"); limitStr != "" {
+		var err error
+		if limit, err = strconv.Atoi(limitStr); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit"})
+			return
+		}
+	}
+
+	runs, err := h.jobUseCase.GetJobRuns(c.Request.Context(), c.Param("name"), limit)
+	if err != nil {
+		c.JSON(jobErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, runs)
+}
+
+func jobErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrJobNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrJobRunning):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,237 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/application/transaction"
+	"github.com/attendance_report_app/backend/internal/application/usecase"
+	"github.com/attendance_report_app/backend/internal/domain"
+)
+
+type NotificationHandler struct {
+	notificationUseCase usecase.NotificationUseCase
+	txManager           transaction.Manager
+}
+
+func NewNotificationHandler(notificationUseCase usecase.NotificationUseCase, txManager transaction.Manager) *NotificationHandler {
+	return &NotificationHandler{
+		notificationUseCase: notificationUseCase,
+		txManager:           txManager,
+	}
+}
+
+// GetEvents lists the notification events and the channels each is routed to
+func (h *NotificationHandler) GetEvents(c *gin.Context) {
+	events, err := h.notificationUseCase.GetEvents(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, events)
+}
+
+// SetRoutes replaces the channels an event is routed to
+func (h *NotificationHandler) SetRoutes(c *gin.Context) {
+	var req request.SetNotificationRoutesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var event *dto.NotificationEventResponse
+	err := h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		event, err = h.notificationUseCase.SetRoutes(ctx, c.Param("event"), &req)
+		return err
+	})
+
+	if err != nil {
+		c.JSON(notificationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, event)
+}
+
+func (h *NotificationHandler) GetChannels(c *gin.Context) {
+	channels, err := h.notificationUseCase.GetChannels(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, channels)
+}
+
+func (h *NotificationHandler) CreateChannel(c *gin.Context) {
+	var req request.CreateNotificationChannelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var channel *dto.NotificationChannelResponse
+	err := h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		channel, err = h.notificationUseCase.CreateChannel(ctx, &req)
+		return err
+	})
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, channel)
+}
+
+func (h *NotificationHandler) UpdateChannel(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid channel ID"})
+		return
+	}
+
+	var req request.UpdateNotificationChannelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var channel *dto.NotificationChannelResponse
+	err = h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		channel, err = h.notificationUseCase.UpdateChannel(ctx, id, &req)
+		return err
+	})
+
+	if err != nil {
+		c.JSON(notificationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, channel)
+}
+
+func (h *NotificationHandler) DeleteChannel(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid channel ID"})
+		return
+	}
+
+	err = h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		return h.notificationUseCase.DeleteChannel(ctx, id)
+	})
+
+	if err != nil {
+		c.JSON(notificationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// TestChannel sends a test notification to the channel and reports whether it was delivered
+func (h *NotificationHandler) TestChannel(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid channel ID"})
+		return
+	}
+
+	if err := h.notificationUseCase.TestChannel(c.Request.Context(), id, userID.(int)); err != nil {
+		c.JSON(notificationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetMyPreferences lists how the current user is notified about each event concerning them
+func (h *NotificationHandler) GetMyPreferences(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	preferences, err := h.notificationUseCase.GetMyPreferences(c.Request.Context(), userID.(int))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, preferences)
+}
+
+// UpdateMyPreference replaces the current user's setting for an event
+func (h *NotificationHandler) UpdateMyPreference(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req request.UpdateNotificationPreferenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var preference *dto.NotificationPreferenceResponse
+	err := h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		preference, err = h.notificationUseCase.UpdateMyPreference(ctx, userID.(int), c.Param("event"), &req)
+		return err
+	})
+
+	if err != nil {
+		c.JSON(notificationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, preference)
+}
+
+func notificationErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrNotificationChannelNotFound), errors.Is(err, domain.ErrNotificationEventNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
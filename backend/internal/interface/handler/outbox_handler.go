@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/application/transaction"
+	"github.com/attendance_report_app/backend/internal/application/usecase"
+	"github.com/attendance_report_app/backend/internal/domain"
+)
+
+type OutboxHandler struct {
+	outboxUseCase usecase.OutboxUseCase
+	txManager     transaction.Manager
+}
+
+func NewOutboxHandler(outboxUseCase usecase.OutboxUseCase, txManager transaction.Manager) *OutboxHandler {
+	return &OutboxHandler{
+		outboxUseCase: outboxUseCase,
+		txManager:     txManager,
+	}
+}
+
+// GetMessages lists queued notifications.
+// Query parameters: status (PENDING, SENT or DEAD; default DEAD), page, per_page
+func (h *OutboxHandler) GetMessages(c *gin.Context) {
+	var req request.GetOutboxMessagesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters"})
+		return
+	}
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	messages, err := h.outboxUseCase.GetMessages(c.Request.Context(), &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, messages)
+}
+
+// ResendMessage queues a dead-lettered message again
+func (h *OutboxHandler) ResendMessage(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid message ID"})
+		return
+	}
+
+	var message *dto.OutboxMessageResponse
+	err = h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		message, err = h.outboxUseCase.ResendMessage(ctx, id)
+		return err
+	})
+
+	if err != nil {
+		c.JSON(outboxErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, message)
+}
+
+func outboxErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrOutboxMessageNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrOutboxNotResendable):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/application/usecase"
+)
+
+type ReminderHandler struct {
+	reminderUseCase usecase.ReminderUseCase
+}
+
+func NewReminderHandler(reminderUseCase usecase.ReminderUseCase) *ReminderHandler {
+	return &ReminderHandler{
+		reminderUseCase: reminderUseCase,
+	}
+}
+
+// GetMissingEntries lists who has not logged attendance or a report yet.
+// Query parameters: date (YYYY-MM-DD), cutoff (HH:MM)
+func (h *ReminderHandler) GetMissingEntries(c *gin.Context) {
+	var req request.RemindersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters"})
+		return
+	}
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	entries, err := h.reminderUseCase.GetMissingEntries(c.Request.Context(), &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, entries)
+}
+
+// SendReminders reminds the missing users now and posts the admin summary
+func (h *ReminderHandler) SendReminders(c *gin.Context) {
+	var req request.RemindersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.reminderUseCase.SendReminders(c.Request.Context(), &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/application/usecase"
+)
+
+type ReportDigestHandler struct {
+	reportDigestUseCase usecase.ReportDigestUseCase
+}
+
+func NewReportDigestHandler(reportDigestUseCase usecase.ReportDigestUseCase) *ReportDigestHandler {
+	return &ReportDigestHandler{
+		reportDigestUseCase: reportDigestUseCase,
+	}
+}
+
+// GetMyDigest returns the caller's weekly or monthly digest.
+// Query parameters: period (week, month), date (YYYY-MM-DD), format (json, markdown, html).
+// The html format is a frame around the reports, whose Markdown is shown as escaped source.
+func (h *ReportDigestHandler) GetMyDigest(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req request.GetReportDigestRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters"})
+		return
+	}
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	digest, err := h.reportDigestUseCase.GetMyDigest(c.Request.Context(), userID.(int), &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	writeDigest(c, req.Format, digest)
+}
+
+// GetUserDigest returns another user's digest with the reports the admin may read.
+// Query parameters as for GetMyDigest.
+func (h *ReportDigestHandler) GetUserDigest(c *gin.Context) {
+	viewerID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	userID, err := strconv.Atoi(c.Param("userId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	var req request.GetReportDigestRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters"})
+		return
+	}
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	digest, err := h.reportDigestUseCase.GetUserDigest(c.Request.Context(), userID, viewerID.(int), &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	writeDigest(c, req.Format, digest)
+}
+
+// PostDigests posts the digests of a period to Slack now, e.g. to resend a scheduled post
+func (h *ReportDigestHandler) PostDigests(c *gin.Context) {
+	var req request.PostReportDigestsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.reportDigestUseCase.PostDigests(c.Request.Context(), &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// writeDigest sends the rendered document for the markdown and html formats, JSON otherwise
+func writeDigest(c *gin.Context, format string, digest *dto.ReportDigestResponse) {
+	switch format {
+	case "markdown":
+		c.Data(http.StatusOK, "text/markdown; charset=utf-8", []byte(digest.Rendered))
+	case "html":
+		c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(digest.Rendered))
+	default:
+		c.JSON(http.StatusOK, digest)
+	}
+}
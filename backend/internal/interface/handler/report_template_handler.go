@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/application/transaction"
+	"github.com/attendance_report_app/backend/internal/application/usecase"
+)
+
+type ReportTemplateHandler struct {
+	reportTemplateUseCase usecase.ReportTemplateUseCase
+	txManager             transaction.Manager
+}
+
+func NewReportTemplateHandler(reportTemplateUseCase usecase.ReportTemplateUseCase, txManager transaction.Manager) *ReportTemplateHandler {
+	return &ReportTemplateHandler{
+		reportTemplateUseCase: reportTemplateUseCase,
+		txManager:             txManager,
+	}
+}
+
+// GetReportTemplates returns the active templates; admins may pass all=true to include retired ones
+func (h *ReportTemplateHandler) GetReportTemplates(c *gin.Context) {
+	userRole, _ := c.Get("userRole")
+	includeInactive := c.Query("all") == "true" && userRole == "ADMIN"
+
+	templates, err := h.reportTemplateUseCase.GetReportTemplates(c.Request.Context(), includeInactive)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, templates)
+}
+
+func (h *ReportTemplateHandler) CreateReportTemplate(c *gin.Context) {
+	var req request.CreateReportTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var template *dto.ReportTemplateResponse
+	err := h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		template, err = h.reportTemplateUseCase.CreateReportTemplate(ctx, &req)
+		return err
+	})
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, template)
+}
+
+func (h *ReportTemplateHandler) UpdateReportTemplate(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid template ID"})
+		return
+	}
+
+	var req request.UpdateReportTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var template *dto.ReportTemplateResponse
+	err = h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		template, err = h.reportTemplateUseCase.UpdateReportTemplate(ctx, id, &req)
+		return err
+	})
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, template)
+}
+
+func (h *ReportTemplateHandler) DeleteReportTemplate(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid template ID"})
+		return
+	}
+
+	err = h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		return h.reportTemplateUseCase.DeleteReportTemplate(ctx, id)
+	})
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
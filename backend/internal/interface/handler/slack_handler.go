@@ -0,0 +1,217 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/application/transaction"
+	"github.com/attendance_report_app/backend/internal/application/usecase"
+	"github.com/attendance_report_app/backend/internal/domain"
+	"github.com/attendance_report_app/backend/internal/infrastructure/slack"
+)
+
+type SlackHandler struct {
+	slackCommandUseCase usecase.SlackCommandUseCase
+	txManager           transaction.Manager
+	signingSecret       string
+}
+
+// NewSlackHandler creates the handler; slash commands are refused while signingSecret is empty
+func NewSlackHandler(slackCommandUseCase usecase.SlackCommandUseCase, txManager transaction.Manager, signingSecret string) *SlackHandler {
+	return &SlackHandler{
+		slackCommandUseCase: slackCommandUseCase,
+		txManager:           txManager,
+		signingSecret:       signingSecret,
+	}
+}
+
+// HandleCommand runs a slash command posted by Slack. Slack shows any reply other than 200 as
+// a failure of the app, so problems with the command are answered with an ephemeral message.
+func (h *SlackHandler) HandleCommand(c *gin.Context) {
+	if h.signingSecret == "" {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Slack integration is not configured"})
+		return
+	}
+
+	// The signature covers the raw body, so it is read before the form is parsed
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+	err = slack.VerifySignature(h.signingSecret, c.GetHeader(slack.TimestampHeader), c.GetHeader(slack.SignatureHeader), body, time.Now())
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	var req request.SlackCommandRequest
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var response *dto.SlackCommandResponse
+	err = h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		response, err = h.slackCommandUseCase.HandleCommand(ctx, &req)
+		return err
+	})
+
+	if err != nil {
+		log.Printf("Slack command %q from %s failed: %v", req.Text, req.UserId, err)
+		c.JSON(http.StatusOK, dto.NewEphemeralSlackResponse(slackErrorMessage(err)))
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// slackErrorMessage is the reply to a failed command. Domain errors the user can act on are
+// replied as they are; anything else may carry internal details and gets a generic message.
+func slackErrorMessage(err error) string {
+	switch {
+	case errors.Is(err, domain.ErrPayrollPeriodLocked), errors.Is(err, domain.ErrDailyReportExists):
+		return err.Error()
+	default:
+		return "エラーが発生しました。しばらくしてからもう一度お試しください。"
+	}
+}
+
+// GetMySlackAccount tells whether the current user has linked a Slack account
+func (h *SlackHandler) GetMySlackAccount(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	account, err := h.slackCommandUseCase.GetMySlackAccount(c.Request.Context(), userID.(int))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, account)
+}
+
+// LinkSlackAccount links the current user to the Slack user a "/kintai link" code was issued to
+func (h *SlackHandler) LinkSlackAccount(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req request.LinkSlackAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var account *dto.SlackAccountResponse
+	err := h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		account, err = h.slackCommandUseCase.LinkSlackAccount(ctx, userID.(int), &req)
+		return err
+	})
+
+	if err != nil {
+		if errors.Is(err, domain.ErrSlackLinkCodeInvalid) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, account)
+}
+
+func (h *SlackHandler) UnlinkSlackAccount(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	if err := h.slackCommandUseCase.UnlinkSlackAccount(c.Request.Context(), userID.(int)); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SetUserSlackAccount links a user to a Slack member so that notifications can @-mention them
+func (h *SlackHandler) SetUserSlackAccount(c *gin.Context) {
+	userID, err := strconv.Atoi(c.Param("userId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	var req request.SetSlackAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var account *dto.SlackAccountResponse
+	err = h.txManager.ExecuteInTx(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		account, err = h.slackCommandUseCase.SetUserSlackAccount(ctx, userID, &req)
+		return err
+	})
+
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, account)
+}
+
+func (h *SlackHandler) DeleteUserSlackAccount(c *gin.Context) {
+	userID, err := strconv.Atoi(c.Param("userId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	if err := h.slackCommandUseCase.UnlinkSlackAccount(c.Request.Context(), userID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
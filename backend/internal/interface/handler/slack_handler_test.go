@@ -0,0 +1,193 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/attendance_report_app/backend/internal/application/dto"
+	"github.com/attendance_report_app/backend/internal/application/dto/request"
+	"github.com/attendance_report_app/backend/internal/application/usecase"
+	"github.com/attendance_report_app/backend/internal/domain"
+	"github.com/attendance_report_app/backend/internal/infrastructure/slack"
+	"github.com/attendance_report_app/backend/internal/interface/handler"
+)
+
+const testSigningSecret = "test-signing-secret"
+
+// The payloads cmd/slackreplay posts, recorded from Slack
+const slackReplayTestdata = "../../../cmd/slackreplay/testdata"
+
+// stubSlackCommandUseCase records the commands it is given and echoes them, or fails with err
+type stubSlackCommandUseCase struct {
+	usecase.SlackCommandUseCase
+	commands []*request.SlackCommandRequest
+	err      error
+}
+
+func (s *stubSlackCommandUseCase) HandleCommand(ctx context.Context, req *request.SlackCommandRequest) (*dto.SlackCommandResponse, error) {
+	s.commands = append(s.commands, req)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return dto.NewEphemeralSlackResponse("ran " + req.Text), nil
+}
+
+// stubTxManager runs the function without a transaction
+type stubTxManager struct{}
+
+func (stubTxManager) ExecuteInTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func newSlackCommandRouter(slackCommandUseCase usecase.SlackCommandUseCase) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/api/slack/commands", handler.NewSlackHandler(slackCommandUseCase, stubTxManager{}, testSigningSecret).HandleCommand)
+	return router
+}
+
+// newSlackCommandRequest posts body signed with secret at signedAt, as Slack does
+func newSlackCommandRequest(body []byte, secret string, signedAt time.Time) *http.Request {
+	timestamp := strconv.FormatInt(signedAt.Unix(), 10)
+	req := httptest.NewRequest(http.MethodPost, "/api/slack/commands", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set(slack.TimestampHeader, timestamp)
+	req.Header.Set(slack.SignatureHeader, slack.Sign(secret, timestamp, body))
+	return req
+}
+
+func readSlackPayload(t *testing.T, name string) []byte {
+	t.Helper()
+	recorded, err := os.ReadFile(filepath.Join(slackReplayTestdata, name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bytes.TrimSpace(recorded)
+}
+
+func decodeSlackReply(t *testing.T, recorder *httptest.ResponseRecorder) *dto.SlackCommandResponse {
+	t.Helper()
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", recorder.Code, http.StatusOK, recorder.Body)
+	}
+	var reply dto.SlackCommandResponse
+	if err := json.Unmarshal(recorder.Body.Bytes(), &reply); err != nil {
+		t.Fatalf("invalid reply %q: %v", recorder.Body, err)
+	}
+	return &reply
+}
+
+func TestHandleCommandReplaysRecordedPayloads(t *testing.T) {
+	payloads, err := filepath.Glob(filepath.Join(slackReplayTestdata, "*.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(payloads) == 0 {
+		t.Fatalf("no payloads in %s", slackReplayTestdata)
+	}
+
+	for _, payload := range payloads {
+		name := filepath.Base(payload)
+		t.Run(name, func(t *testing.T) {
+			body := readSlackPayload(t, name)
+			form, err := url.ParseQuery(string(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			slackCommandUseCase := &stubSlackCommandUseCase{}
+			recorder := httptest.NewRecorder()
+			newSlackCommandRouter(slackCommandUseCase).ServeHTTP(recorder, newSlackCommandRequest(body, testSigningSecret, time.Now()))
+
+			reply := decodeSlackReply(t, recorder)
+			if reply.ResponseType != "ephemeral" || reply.Text != "ran "+form.Get("text") {
+				t.Errorf("reply = %+v, want the stub's ephemeral reply", reply)
+			}
+			if len(slackCommandUseCase.commands) != 1 {
+				t.Fatalf("use case ran %d commands, want 1", len(slackCommandUseCase.commands))
+			}
+			got := slackCommandUseCase.commands[0]
+			want := request.SlackCommandRequest{
+				TeamId:   form.Get("team_id"),
+				UserId:   form.Get("user_id"),
+				UserName: form.Get("user_name"),
+				Command:  form.Get("command"),
+				Text:     form.Get("text"),
+			}
+			if *got != want {
+				t.Errorf("command = %+v, want %+v", *got, want)
+			}
+		})
+	}
+}
+
+func TestHandleCommandRejectsUnverifiedRequests(t *testing.T) {
+	body := readSlackPayload(t, "kintai_in.txt")
+
+	tests := []struct {
+		name string
+		req  *http.Request
+	}{
+		{"wrong secret", newSlackCommandRequest(body, "another-secret", time.Now())},
+		{"expired", newSlackCommandRequest(body, testSigningSecret, time.Now().Add(-slack.MaxRequestAge-time.Minute))},
+		{"tampered body", func() *http.Request {
+			req := newSlackCommandRequest(body, testSigningSecret, time.Now())
+			req.Body = http.NoBody
+			return req
+		}()},
+		{"unsigned", httptest.NewRequest(http.MethodPost, "/api/slack/commands", bytes.NewReader(body))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slackCommandUseCase := &stubSlackCommandUseCase{}
+			recorder := httptest.NewRecorder()
+			newSlackCommandRouter(slackCommandUseCase).ServeHTTP(recorder, tt.req)
+
+			if recorder.Code != http.StatusUnauthorized {
+				t.Errorf("status = %d, want %d", recorder.Code, http.StatusUnauthorized)
+			}
+			if len(slackCommandUseCase.commands) != 0 {
+				t.Errorf("use case ran %d commands, want none", len(slackCommandUseCase.commands))
+			}
+		})
+	}
+}
+
+func TestHandleCommandRepliesToFailures(t *testing.T) {
+	body := readSlackPayload(t, "kintai_out.txt")
+
+	t.Run("internal error", func(t *testing.T) {
+		slackCommandUseCase := &stubSlackCommandUseCase{err: errors.New("failed to create attendance: dial tcp 10.0.0.5:3306: connection refused")}
+		recorder := httptest.NewRecorder()
+		newSlackCommandRouter(slackCommandUseCase).ServeHTTP(recorder, newSlackCommandRequest(body, testSigningSecret, time.Now()))
+
+		reply := decodeSlackReply(t, recorder)
+		if strings.Contains(reply.Text, "10.0.0.5") || strings.Contains(reply.Text, "failed to") {
+			t.Errorf("reply %q leaks the internal error", reply.Text)
+		}
+	})
+
+	t.Run("domain error", func(t *testing.T) {
+		slackCommandUseCase := &stubSlackCommandUseCase{err: domain.ErrPayrollPeriodLocked}
+		recorder := httptest.NewRecorder()
+		newSlackCommandRouter(slackCommandUseCase).ServeHTTP(recorder, newSlackCommandRequest(body, testSigningSecret, time.Now()))
+
+		if reply := decodeSlackReply(t, recorder); reply.Text != domain.ErrPayrollPeriodLocked.Error() {
+			t.Errorf("reply = %q, want %q", reply.Text, domain.ErrPayrollPeriodLocked.Error())
+		}
+	})
+}
@@ -110,7 +110,7 @@ func (h *UserHandler) UpdateMyProfile(c *gin.Context) {
 
 	// For profile updates, only allow goal updates for now
 	// You can extend this to allow name updates etc. if needed
-	if req.Name != nil || req.Email != nil || req.Role != nil || req.PayType != nil || req.PayRate != nil {
+	if req.Name != nil || req.Email != nil || req.Role != nil || req.PayType != nil || req.PayRate != nil || req.FixedOvertimeHours != nil || req.Department != nil || req.HasEmploymentChanges() {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Only goal updates are allowed"})
 		return
 	}
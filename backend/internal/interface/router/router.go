@@ -12,6 +12,20 @@ type Router struct {
 	attendanceHandler *handler.AttendanceHandler
 	dailyReportHandler *handler.DailyReportHandler
 	adminHandler      *handler.AdminHandler
+	payrollHandler    *handler.PayrollHandler
+	calendarHandler   *handler.CalendarHandler
+	compensationHandler *handler.CompensationHandler
+	earningsHandler   *handler.EarningsHandler
+	goalHandler       *handler.GoalHandler
+	reportTemplateHandler *handler.ReportTemplateHandler
+	reportCommentHandler *handler.ReportCommentHandler
+	reportDigestHandler *handler.ReportDigestHandler
+	reminderHandler   *handler.ReminderHandler
+	jobHandler        *handler.JobHandler
+	outboxHandler     *handler.OutboxHandler
+	notificationHandler *handler.NotificationHandler
+	slackHandler      *handler.SlackHandler
+	webhookHandler    *handler.WebhookHandler
 	authMiddleware    middleware.AuthMiddleware
 }
 
@@ -21,6 +35,20 @@ func NewRouter(
 	attendanceHandler *handler.AttendanceHandler,
 	dailyReportHandler *handler.DailyReportHandler,
 	adminHandler *handler.AdminHandler,
+	payrollHandler *handler.PayrollHandler,
+	calendarHandler *handler.CalendarHandler,
+	compensationHandler *handler.CompensationHandler,
+	earningsHandler *handler.EarningsHandler,
+	goalHandler *handler.GoalHandler,
+	reportTemplateHandler *handler.ReportTemplateHandler,
+	reportCommentHandler *handler.ReportCommentHandler,
+	reportDigestHandler *handler.ReportDigestHandler,
+	reminderHandler *handler.ReminderHandler,
+	jobHandler *handler.JobHandler,
+	outboxHandler *handler.OutboxHandler,
+	notificationHandler *handler.NotificationHandler,
+	slackHandler *handler.SlackHandler,
+	webhookHandler *handler.WebhookHandler,
 	authMiddleware middleware.AuthMiddleware,
 ) *Router {
 	return &Router{
@@ -29,6 +57,20 @@ func NewRouter(
 		attendanceHandler: attendanceHandler,
 		dailyReportHandler: dailyReportHandler,
 		adminHandler:      adminHandler,
+		payrollHandler:    payrollHandler,
+		calendarHandler:   calendarHandler,
+		compensationHandler: compensationHandler,
+		earningsHandler:   earningsHandler,
+		goalHandler:       goalHandler,
+		reportTemplateHandler: reportTemplateHandler,
+		reportCommentHandler: reportCommentHandler,
+		reportDigestHandler: reportDigestHandler,
+		reminderHandler:   reminderHandler,
+		jobHandler:        jobHandler,
+		outboxHandler:     outboxHandler,
+		notificationHandler: notificationHandler,
+		slackHandler:      slackHandler,
+		webhookHandler:    webhookHandler,
 		authMiddleware:    authMiddleware,
 	}
 }
@@ -42,6 +84,9 @@ func (r *Router) Setup(engine *gin.Engine) {
 		auth.POST("/logout", r.authMiddleware.RequireAuth(), r.authHandler.Logout)
 	}
 
+	// Slack slash commands are authenticated by Slack's request signature instead of a token
+	api.POST("/slack/commands", r.slackHandler.HandleCommand)
+
 	users := api.Group("/users")
 	users.Use(r.authMiddleware.RequireAuth(), r.authMiddleware.RequireAdmin())
 	{
@@ -57,6 +102,25 @@ func (r *Router) Setup(engine *gin.Engine) {
 	{
 		profile.PUT("", r.userHandler.UpdateMyProfile)
 		profile.POST("/change-password", r.userHandler.ChangePassword)
+		// How the user is notified about events concerning them
+		profile.GET("/notifications", r.notificationHandler.GetMyPreferences)
+		profile.PUT("/notifications/:event", r.notificationHandler.UpdateMyPreference)
+		// Linking a Slack account lets the user clock in and out with /kintai
+		profile.GET("/slack", r.slackHandler.GetMySlackAccount)
+		profile.POST("/slack/link", r.slackHandler.LinkSlackAccount)
+		profile.DELETE("/slack", r.slackHandler.UnlinkSlackAccount)
+	}
+
+	// Self-service endpoints scoped to the authenticated user
+	me := api.Group("/me")
+	me.Use(r.authMiddleware.RequireAuth())
+	{
+		me.GET("/earnings", r.earningsHandler.GetMyEarnings)
+		me.GET("/goals", r.goalHandler.GetMyGoals)
+		me.POST("/goals", r.goalHandler.SetGoal)
+		me.GET("/goals/progress", r.goalHandler.GetGoalProgress)
+		me.GET("/reports", r.dailyReportHandler.GetMyDailyReports)
+		me.GET("/reports/digest", r.reportDigestHandler.GetMyDigest)
 	}
 
 	attendance := api.Group("/attendance")
@@ -71,6 +135,42 @@ func (r *Router) Setup(engine *gin.Engine) {
 	reports.Use(r.authMiddleware.RequireAuth())
 	{
 		reports.GET("", r.dailyReportHandler.GetAllDailyReports)
+		reports.GET("/search", r.dailyReportHandler.SearchDailyReports)
+		reports.GET("/tags", r.dailyReportHandler.GetTagCloud)
+		reports.POST("", r.dailyReportHandler.CreateDailyReport)
+		reports.POST("/read-all", r.dailyReportHandler.MarkAllDailyReportsRead)
+		reports.GET("/:id", r.dailyReportHandler.GetDailyReport)
+		reports.GET("/:id/history", r.dailyReportHandler.GetDailyReportHistory)
+		reports.POST("/:id/read", r.dailyReportHandler.MarkDailyReportRead)
+		reports.GET("/:id/readers", r.dailyReportHandler.GetDailyReportReaders)
+		reports.PUT("/:id", r.dailyReportHandler.UpdateDailyReport)
+		reports.DELETE("/:id", r.dailyReportHandler.DeleteDailyReport)
+
+		// Feedback: threaded comments and emoji reactions
+		reports.GET("/:id/comments", r.reportCommentHandler.GetComments)
+		reports.POST("/:id/comments", r.reportCommentHandler.CreateComment)
+		reports.PUT("/:id/comments/:commentId", r.reportCommentHandler.UpdateComment)
+		reports.DELETE("/:id/comments/:commentId", r.reportCommentHandler.DeleteComment)
+		reports.GET("/:id/reactions", r.reportCommentHandler.GetReactions)
+		reports.POST("/:id/reactions", r.reportCommentHandler.AddReaction)
+		reports.DELETE("/:id/reactions/:emoji", r.reportCommentHandler.RemoveReaction)
+	}
+
+	reportTemplates := api.Group("/report-templates")
+	reportTemplates.Use(r.authMiddleware.RequireAuth())
+	{
+		reportTemplates.GET("", r.reportTemplateHandler.GetReportTemplates)
+		reportTemplates.POST("", r.authMiddleware.RequireAdmin(), r.reportTemplateHandler.CreateReportTemplate)
+		reportTemplates.PUT("/:id", r.authMiddleware.RequireAdmin(), r.reportTemplateHandler.UpdateReportTemplate)
+		reportTemplates.DELETE("/:id", r.authMiddleware.RequireAdmin(), r.reportTemplateHandler.DeleteReportTemplate)
+	}
+
+	calendar := api.Group("/calendar")
+	calendar.Use(r.authMiddleware.RequireAuth())
+	{
+		calendar.GET("/holidays", r.calendarHandler.GetHolidays)
+		calendar.POST("/holidays", r.authMiddleware.RequireAdmin(), r.calendarHandler.CreateHoliday)
+		calendar.DELETE("/holidays/:id", r.authMiddleware.RequireAdmin(), r.calendarHandler.DeleteHoliday)
 	}
 
 	admin := api.Group("/admin")
@@ -79,5 +179,66 @@ func (r *Router) Setup(engine *gin.Engine) {
 		admin.GET("/dashboard", r.adminHandler.GetDashboard)
 		admin.GET("/payroll", r.adminHandler.GetPayroll)
 		admin.GET("/users/:userId/attendances", r.adminHandler.GetUserAttendances)
+		admin.GET("/users/:userId/reports/digest", r.reportDigestHandler.GetUserDigest)
+
+		// Payroll runs (month closing)
+		admin.GET("/payroll/runs", r.payrollHandler.GetPayrollRuns)
+		admin.POST("/payroll/runs", r.payrollHandler.CreatePayrollRun)
+		admin.GET("/payroll/runs/:id", r.payrollHandler.GetPayrollRun)
+		admin.POST("/payroll/runs/:id/finalize", r.payrollHandler.FinalizePayrollRun)
+		admin.POST("/payroll/runs/:id/reopen", r.payrollHandler.ReopenPayrollRun)
+		admin.GET("/payroll/runs/:id/payslips/:userId", r.payrollHandler.GetPayslip)
+
+		// Allowances (手当) and bonuses (賞与)
+		admin.GET("/allowances", r.compensationHandler.GetAllowances)
+		admin.POST("/allowances", r.compensationHandler.CreateAllowance)
+		admin.PUT("/allowances/:id", r.compensationHandler.UpdateAllowance)
+		admin.DELETE("/allowances/:id", r.compensationHandler.DeleteAllowance)
+		admin.GET("/users/:userId/allowances", r.compensationHandler.GetUserAllowances)
+		admin.POST("/users/:userId/allowances", r.compensationHandler.AssignAllowance)
+		admin.PUT("/users/:userId/allowances/:id", r.compensationHandler.UpdateUserAllowance)
+		admin.DELETE("/users/:userId/allowances/:id", r.compensationHandler.DeleteUserAllowance)
+		// Slack member IDs, used to @-mention users in notifications
+		admin.PUT("/users/:userId/slack-account", r.slackHandler.SetUserSlackAccount)
+		admin.DELETE("/users/:userId/slack-account", r.slackHandler.DeleteUserSlackAccount)
+		admin.GET("/bonuses", r.compensationHandler.GetBonuses)
+		admin.POST("/bonuses", r.compensationHandler.CreateBonus)
+		admin.DELETE("/bonuses/:id", r.compensationHandler.DeleteBonus)
+
+		// Full-text search index of this process
+		admin.POST("/reports/search/rebuild", r.dailyReportHandler.RebuildSearchIndex)
+
+		// Weekly and monthly digests posted to Slack, see cmd/digest for the schedule
+		admin.POST("/reports/digests/slack", r.reportDigestHandler.PostDigests)
+
+		// Missing attendance and report reminders, see cmd/remind for the schedule
+		admin.GET("/reminders/missing", r.reminderHandler.GetMissingEntries)
+		admin.POST("/reminders/send", r.reminderHandler.SendReminders)
+
+		// Background jobs and their run history
+		admin.GET("/jobs", r.jobHandler.GetJobs)
+		admin.POST("/jobs/:name/run", r.jobHandler.TriggerJob)
+		admin.GET("/jobs/:name/runs", r.jobHandler.GetJobRuns)
+
+		// Queued notifications; dead-lettered ones can be resent
+		admin.GET("/outbox", r.outboxHandler.GetMessages)
+		admin.POST("/outbox/:id/resend", r.outboxHandler.ResendMessage)
+
+		// Notification channels (Slack, Teams, Discord, email) and the events routed to them
+		admin.GET("/notifications/events", r.notificationHandler.GetEvents)
+		admin.PUT("/notifications/events/:event/channels", r.notificationHandler.SetRoutes)
+		admin.GET("/notifications/channels", r.notificationHandler.GetChannels)
+		admin.POST("/notifications/channels", r.notificationHandler.CreateChannel)
+		admin.PUT("/notifications/channels/:id", r.notificationHandler.UpdateChannel)
+		admin.DELETE("/notifications/channels/:id", r.notificationHandler.DeleteChannel)
+		admin.POST("/notifications/channels/:id/test", r.notificationHandler.TestChannel)
+
+		// Webhook subscriptions of internal tools to attendance and user events, with their delivery log
+		admin.GET("/webhooks", r.webhookHandler.GetSubscriptions)
+		admin.POST("/webhooks", r.webhookHandler.CreateSubscription)
+		admin.PUT("/webhooks/:id", r.webhookHandler.UpdateSubscription)
+		admin.DELETE("/webhooks/:id", r.webhookHandler.DeleteSubscription)
+		admin.GET("/webhooks/:id/deliveries", r.webhookHandler.GetDeliveries)
+		admin.POST("/webhooks/:id/test", r.webhookHandler.TestSubscription)
 	}
 }
\ No newline at end of file
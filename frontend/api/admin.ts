@@ -30,6 +30,14 @@ export interface DailyReport {
   report: string;
 }
 
+export interface PaginatedResponse<T> {
+  data: T[];
+  total: number;
+  page: number;
+  per_page: number;
+  total_pages: number;
+}
+
 export const adminAPI = {
   async getDashboard(): Promise<DashboardData> {
     const response = await fetch(`${API_BASE_URL}${API_ENDPOINTS.ADMIN_DASHBOARD}`, {
@@ -55,8 +63,9 @@ export const adminAPI = {
     return response.json();
   },
 
-  async getDailyReports(): Promise<DailyReport[]> {
-    const response = await fetch(`${API_BASE_URL}${API_ENDPOINTS.REPORTS}`, {
+  async getDailyReports(page = 1, perPage = 20): Promise<PaginatedResponse<DailyReport>> {
+    const params = new URLSearchParams({ page: String(page), per_page: String(perPage) });
+    const response = await fetch(`${API_BASE_URL}${API_ENDPOINTS.REPORTS}?${params}`, {
       headers: getAuthHeaders(),
     });
 
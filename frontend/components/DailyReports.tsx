@@ -17,7 +17,9 @@ import {
   Download,
   Grid3X3,
   List,
-  FileText
+  FileText,
+  ChevronLeft,
+  ChevronRight
 } from 'lucide-react';
 import { format, parseISO, isValid } from 'date-fns';
 import { ja } from 'date-fns/locale';
@@ -31,7 +33,7 @@ interface DailyReport {
 }
 
 const DailyReports: React.FC = () => {
-  const { dailyReports, fetchDailyReports, loading, error } = useContext(DataContext);
+  const { dailyReports, dailyReportsPage, fetchDailyReports, loading, error } = useContext(DataContext);
   const { user: currentUser } = useContext(AuthContext);
   
   // フィルタリング・ソート状態
@@ -173,7 +175,7 @@ const DailyReports: React.FC = () => {
         <div>
           <h1 className="text-3xl font-bold text-foreground">日報一覧</h1>
           <p className="text-muted-foreground mt-1">
-            {filteredAndSortedReports.length}件の日報が見つかりました
+            全{dailyReportsPage.total}件中 このページの{filteredAndSortedReports.length}件を表示しています
           </p>
         </div>
         
@@ -302,7 +304,7 @@ const DailyReports: React.FC = () => {
           title="日報の読み込みに失敗しました"
           description="ネットワーク接続を確認して、もう一度お試しください。"
           error={error}
-          onRetry={fetchDailyReports}
+          onRetry={() => fetchDailyReports(dailyReportsPage.page)}
         />
       ) : loading ? (
         <div className="space-y-4">
@@ -389,6 +391,33 @@ const DailyReports: React.FC = () => {
           })}
         </div>
       )}
+
+      {/* ページ送り（フィルター・並び替えは表示中のページに適用されます） */}
+      {!error && dailyReportsPage.totalPages > 1 && (
+        <div className="flex items-center justify-center gap-4 mt-8">
+          <Button
+            variant="outline"
+            size="sm"
+            onClick={() => fetchDailyReports(dailyReportsPage.page - 1)}
+            disabled={loading || dailyReportsPage.page <= 1}
+          >
+            <ChevronLeft className="h-4 w-4 mr-1" />
+            前へ
+          </Button>
+          <span className="text-sm text-muted-foreground">
+            {dailyReportsPage.page} / {dailyReportsPage.totalPages} ページ
+          </span>
+          <Button
+            variant="outline"
+            size="sm"
+            onClick={() => fetchDailyReports(dailyReportsPage.page + 1)}
+            disabled={loading || dailyReportsPage.page >= dailyReportsPage.totalPages}
+          >
+            次へ
+            <ChevronRight className="h-4 w-4 ml-1" />
+          </Button>
+        </div>
+      )}
     </div>
   );
 };
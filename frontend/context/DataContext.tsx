@@ -35,9 +35,16 @@ interface DataContextType {
   // Payroll data
   fetchPayrollData: (month: string) => Promise<any>;
   
-  // Daily reports
+  // Daily reports (one page at a time)
   dailyReports: any[];
-  fetchDailyReports: () => Promise<void>;
+  dailyReportsPage: DailyReportsPage;
+  fetchDailyReports: (page?: number) => Promise<void>;
+}
+
+export interface DailyReportsPage {
+  page: number;
+  totalPages: number;
+  total: number;
 }
 
 export const DataContext = createContext<DataContextType>({
@@ -58,6 +65,7 @@ export const DataContext = createContext<DataContextType>({
   fetchDashboardData: async () => {},
   fetchPayrollData: async () => null,
   dailyReports: [],
+  dailyReportsPage: { page: 1, totalPages: 0, total: 0 },
   fetchDailyReports: async () => {},
 });
 
@@ -73,6 +81,7 @@ export const DataProvider: React.FC<DataProviderProps> = ({ children }) => {
   const [error, setError] = useState<string | null>(null);
   const [dashboardData, setDashboardData] = useState<any>(null);
   const [dailyReports, setDailyReports] = useState<any[]>([]);
+  const [dailyReportsPage, setDailyReportsPage] = useState<DailyReportsPage>({ page: 1, totalPages: 0, total: 0 });
 
   // Fetch users (admin only)
   const fetchUsers = useCallback(async () => {
@@ -330,15 +339,16 @@ export const DataProvider: React.FC<DataProviderProps> = ({ children }) => {
   }, [user]);
 
   // Fetch daily reports
-  const fetchDailyReports = useCallback(async () => {
+  const fetchDailyReports = useCallback(async (page: number = 1) => {
     if (!user) return;
     
     setLoading(true);
     setError(null);
     
     try {
-      const reports = await adminAPI.getDailyReports();
-      setDailyReports(reports);
+      const body = await adminAPI.getDailyReports(page);
+      setDailyReports(body.data);
+      setDailyReportsPage({ page: body.page, totalPages: body.total_pages, total: body.total });
     } catch (err) {
       setError(err instanceof Error ? err.message : 'Failed to fetch daily reports');
     } finally {
@@ -375,6 +385,7 @@ export const DataProvider: React.FC<DataProviderProps> = ({ children }) => {
     fetchDashboardData,
     fetchPayrollData,
     dailyReports,
+    dailyReportsPage,
     fetchDailyReports,
   };
 